@@ -13,13 +13,19 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 
 	_ "waya/docs" // IMPORTANT: This triggers the Swagger init
+	"waya/internal/adapters/auth"
 	betaworkos "waya/internal/adapters/external/Betaworkos"
 	wayaHandler "waya/internal/adapters/handlers/http"
 	"waya/internal/adapters/handlers/http/middlewares"
+	"waya/internal/adapters/logging"
 	"waya/internal/adapters/payments/afriex"
+	"waya/internal/adapters/registry"
+	"waya/internal/adapters/screening"
 	wayaDB "waya/internal/adapters/storage/db"
 	"waya/internal/config"
+	"waya/internal/core/domain"
 	"waya/internal/core/services"
+	"waya/pkg/wayasign"
 )
 
 // @title Waya API (Afriex Orchestrator)
@@ -36,11 +42,9 @@ import (
 // @host localhost:8080
 // @BasePath /api/v1
 func main() {
-	// 1. Setup Structured Logging (JSON)
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelDebug,
-	}))
-	slog.SetDefault(logger)
+	// 1. Setup Structured Logging (JSON), with recipient details masked
+	level := new(slog.LevelVar) // Info until the config is read
+	slog.SetDefault(logging.NewLogger(os.Stdout, level, logging.DefaultFields))
 
 	// 2. Load Config
 	cfg, err := config.LoadConfig(".")
@@ -48,6 +52,11 @@ func main() {
 		slog.Error("Failed to load config", "error", err)
 		os.Exit(1)
 	}
+	if err := level.UnmarshalText([]byte(cfg.Log.Level)); err != nil {
+		slog.Error("Invalid LOG_LEVEL", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logging.NewLogger(os.Stdout, level, logging.Fields(cfg.Log.RedactFields)))
 
 	// 3. Init Database
 	db, err := wayaDB.NewDatabase(cfg.Database)
@@ -57,19 +66,153 @@ func main() {
 	}
 	defer db.Conn.Close()
 
+	// Recipient details are encrypted at rest once a master key is set
+	masterKeys, err := wayaDB.LoadMasterKeys(cfg.PII)
+	if err != nil {
+		slog.Error("Invalid PII master key", "error", err)
+		os.Exit(1)
+	}
+	var pii *wayaDB.PIICipher
+	if len(masterKeys) > 0 {
+		if pii, err = wayaDB.NewPIICipher(context.Background(), db, masterKeys); err != nil {
+			slog.Error("Failed to load PII encryption keys", "error", err)
+			os.Exit(1)
+		}
+		slog.Info("🔐 Recipient details encrypted", "data_key", pii.ActiveKeyID())
+	} else {
+		slog.Warn("⚠️ PII_MASTER_KEY not set: recipient details are stored in plaintext")
+	}
+
 	// 1. Init Adapters
-    repo := wayaDB.NewRepository(db)
+    repo := wayaDB.NewRepository(db, pii)
     afriexClient := afriex.NewClient(cfg.Afriex)
 
+	corridors, err := registry.LoadCorridors(cfg.Registry.CorridorsFile)
+	if err != nil {
+		slog.Error("Failed to load corridor registry", "error", err)
+		os.Exit(1)
+	}
+	banks, err := registry.LoadBanks(cfg.Registry.BanksFile)
+	if err != nil {
+		slog.Error("Failed to load bank directory", "error", err)
+		os.Exit(1)
+	}
+	limits, err := registry.LoadLimits(cfg.Registry.LimitsFile)
+	if err != nil {
+		slog.Error("Failed to load payout limits", "error", err)
+		os.Exit(1)
+	}
+	fees, err := registry.LoadFees(cfg.Registry.FeesFile)
+	if err != nil {
+		slog.Error("Failed to load fee schedules", "error", err)
+		os.Exit(1)
+	}
+	limitEngine := services.NewLimitEngine(limits, repo)
+	pricing := services.NewPricingEngine(fees)
+	billingSvc := services.NewBillingService(repo)
+	auditSvc := services.NewAuditService(repo, slog.Default())
+	ledgerSvc := services.NewLedgerService(repo, cfg.Ledger.PrefundingRequired, auditSvc, slog.Default())
+	if !cfg.Ledger.PrefundingRequired {
+		slog.Warn("⚠️ PREFUNDING_REQUIRED=false: batches are accepted beyond tenants' available balances")
+	}
+	tenantSvc := services.NewTenantService(repo, auditSvc, slog.Default())
+	keySvc := services.NewAPIKeyService(repo, repo, auditSvc, slog.Default())
+	if err := keySvc.EnsureOperatorKey(context.Background(), cfg.Waya.APIKey); err != nil {
+		slog.Error("Failed to set the operator API key", "error", err)
+		os.Exit(1)
+	}
+
+	signatureSvc := services.NewSignatureService(repo, repo, keySvc, cfg.Waya.SignatureMaxSkew, slog.Default())
+	userSvc := services.NewUserService(repo, repo, auth.NewJWT([]byte(cfg.Auth.JWTSecret), "waya"), cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL, auditSvc, slog.Default())
+	if cfg.Auth.JWTSecret == "" {
+		slog.Warn("⚠️ JWT_SECRET not set: users cannot sign in, only API keys work")
+	}
+	rateLimiter := services.NewRateLimiter(repo, defaultRateLimits(cfg.Rate), cfg.Rate.DailyPayoutQuota, auditSvc, slog.Default())
+
 	// --- Init Notifier ---
-    notifier := betaworkos.NewNotifier(cfg.Waya)
+    notifier := betaworkos.NewNotifier(cfg.Waya, repo)
 
     // 2. Init Service
     // Note: We pass the standard Logger
-    svc := services.NewPayoutService(repo, afriexClient, notifier, slog.Default())
+	payoutOpts := []services.PayoutOption{
+		services.WithCorridors(corridors),
+		services.WithBanks(banks),
+		services.WithReviews(repo),
+		services.WithDuplicateCheck(domain.DuplicatePolicy{Mode: cfg.Dupes.Policy, Window: cfg.Dupes.Window}),
+		services.WithLimits(limitEngine),
+		services.WithLedger(ledgerSvc),
+		services.WithPricing(pricing),
+		services.WithQuotas(rateLimiter),
+		services.WithAudit(auditSvc),
+	}
+	if cfg.Checks.SanctionsListsDir != "" {
+		lists, err := screening.LoadDir(cfg.Checks.SanctionsListsDir)
+		if err != nil {
+			slog.Error("Failed to load sanctions lists", "error", err)
+			os.Exit(1)
+		}
+		index := screening.NewIndex(lists, cfg.Checks.SanctionsMatchThreshold)
+		slog.Info("✅ Sanctions lists loaded", "version", index.Version(), "names", index.Size())
+		payoutOpts = append(payoutOpts, services.WithScreening(index))
+	} else {
+		slog.Warn("⚠️ SANCTIONS_LISTS_DIR not set: recipients are NOT screened")
+	}
+	if cfg.Approval.Enabled {
+		thresholds, err := domain.ParseApprovalThresholds(cfg.Approval.Thresholds)
+		if err != nil {
+			slog.Error("Invalid APPROVAL_THRESHOLDS", "error", err)
+			os.Exit(1)
+		}
+		payoutOpts = append(payoutOpts, services.WithApproval(domain.ApprovalPolicy{
+			Enabled:    true,
+			Thresholds: thresholds,
+			Approvers:  domain.ParseApprovers(cfg.Approval.Approvers),
+		}), services.WithApprovalIdentities(services.NewApprovalIdentities(repo, repo)))
+	}
+	if cfg.Checks.NameEnquiryEnabled {
+		payoutOpts = append(payoutOpts, services.WithNameEnquiry(services.NewNameVerifier(afriexClient, cfg.Checks.NameMatchThreshold)))
+	}
+    svc := services.NewPayoutService(repo, afriexClient, notifier, slog.Default(), payoutOpts...)
+	corridorSvc := services.NewCorridorService(corridors, afriexClient, pricing)
+	bankSvc := services.NewBankService(banks, corridors, afriexClient, slog.Default())
+	reviewSvc := services.NewReviewService(repo, svc, auditSvc, slog.Default())
+	reconSvc := services.NewReconciliationService(repo, afriex.ParseExport, svc, slog.Default())
+	retentionSvc := services.NewRetentionService(repo, cfg.PII.Retention, auditSvc, slog.Default())
+
+	// Background jobs stop when the server shuts down
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.Registry.BanksRefreshInterval > 0 {
+		go bankSvc.RefreshEvery(jobsCtx, cfg.Registry.BanksRefreshInterval)
+	}
+	if cfg.Recon.Dir != "" {
+		go reconSvc.WatchDir(jobsCtx, cfg.Recon.Dir, cfg.Recon.Interval)
+	}
+	if cfg.PII.Retention > 0 {
+		go retentionSvc.RunEvery(jobsCtx, cfg.PII.RetentionInterval)
+	}
+	if pii != nil {
+		// Picks up a data key rotated in by the pii command
+		go pii.RefreshEvery(jobsCtx, time.Minute)
+	}
 
     // 3. Init Handler
     payoutHandler := wayaHandler.NewPayoutHandler(svc)
+	corridorHandler := wayaHandler.NewCorridorHandler(corridorSvc)
+	bankHandler := wayaHandler.NewBankHandler(bankSvc)
+	reviewHandler := wayaHandler.NewReviewHandler(reviewSvc)
+	limitHandler := wayaHandler.NewLimitHandler(limitEngine)
+	ledgerHandler := wayaHandler.NewLedgerHandler(ledgerSvc, tenantSvc)
+	billingHandler := wayaHandler.NewBillingHandler(pricing, billingSvc)
+	reconHandler := wayaHandler.NewReconciliationHandler(reconSvc)
+	recipientHandler := wayaHandler.NewRecipientHandler(retentionSvc)
+	keyHandler := wayaHandler.NewAPIKeyHandler(keySvc)
+	userHandler := wayaHandler.NewUserHandler(userSvc)
+	auditHandler := wayaHandler.NewAuditHandler(auditSvc)
+	webhookHandler := wayaHandler.NewWebhookHandler(svc, cfg.Afriex.WebhookKey)
+	if cfg.Afriex.WebhookKey == "" {
+		slog.Warn("⚠️ AFRIEX_WEBHOOK_SECRET not set: Afriex webhooks are refused")
+	}
 
 	// 4. Init Echo
 	e := echo.New()
@@ -82,8 +225,9 @@ func main() {
 	// FIX: Configure CORS to allow your frontend port (3000)
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000"}, // Allow Next.js frontend
-		AllowMethods:     []string{http.MethodGet, http.MethodPost},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, "x-api-key"}, // Allow custom header
+		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodDelete},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, "x-api-key", echo.HeaderAuthorization, wayasign.HeaderKey, wayasign.HeaderTimestamp, wayasign.HeaderNonce, wayasign.HeaderSignature}, // Allow custom headers
+		ExposeHeaders:    []string{echo.HeaderRetryAfter, "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"},
 		AllowCredentials: true,
 	}))
 	e.Use(middleware.RequestID()) 
@@ -105,22 +249,98 @@ func main() {
 	}))
 
 	// 6. Routes
+	// Afriex signs its webhooks instead of sending our API key
+	e.POST("/api/v1/webhooks/afriex", webhookHandler.HandleAfriexWebhook, middlewares.AuditSource)
+
+	// Signing in needs no credentials beyond the password, so guess rates are capped per IP
+	if cfg.Auth.JWTSecret != "" {
+		login := middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+			Store: middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{Rate: 0.2, Burst: 10, ExpiresIn: 10 * time.Minute}),
+			DenyHandler: func(c echo.Context, _ string, _ error) error {
+				return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "Too many sign-in attempts; try again later"})
+			},
+		})
+		e.POST("/api/v1/auth/login", userHandler.Login, login)
+		e.POST("/api/v1/auth/refresh", userHandler.Refresh, login)
+		e.POST("/api/v1/auth/logout", userHandler.Logout)
+	}
+
 	api := e.Group("/api/v1")
-	// Apply the authentication middleware to the whole API group
+	// Apply the authentication middleware to the whole API group; it resolves the caller from a signed
+	// request, a user's bearer token or, failing those, the x-api-key header
 	api.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
-		return middlewares.APIKeyAuth(next, cfg.Waya.APIKey)
+		next = middlewares.APIKeyAuth(next, keySvc.Authenticate)
+		if cfg.Auth.JWTSecret != "" {
+			next = middlewares.BearerAuth(next, userSvc.Authenticate)
+		}
+		return middlewares.SignatureAuth(next, signatureSvc.Authenticate)
 	})
+	api.Use(middlewares.RateLimit(rateLimiter.Allow))
+	api.Use(middlewares.AuditSource)
+	read := middlewares.Require(domain.PermRead)
+	submit := middlewares.Require(domain.PermSubmit)
+	approve := middlewares.Require(domain.PermApprove)
 	
     // Health Check
 	api.GET("/health", func(c echo.Context) error {
 		return c.JSON(http.StatusOK, map[string]string{"status": "ok", "db": "connected"})
 	})
 
-	api.POST("/payouts", payoutHandler.HandleBulkPayout)
-	api.GET("/payouts/:batch_id", payoutHandler.GetBatchStatus)
-	api.GET("/payouts/all", payoutHandler.HandleListAllPayouts)
-	// WEBHOOK ROUTE (The new feature)
-// api.POST("/webhooks/afriex", payoutHandler.HandleAfriexWebhook)
+	api.POST("/payouts", payoutHandler.HandleBulkPayout, submit)
+	api.GET("/payouts/:batch_id", payoutHandler.GetBatchStatus, read)
+	api.GET("/payouts/all", payoutHandler.HandleListAllPayouts, read)
+	api.GET("/payouts/search", payoutHandler.SearchPayouts, read)
+	api.GET("/payouts/:batch_id/costs", payoutHandler.GetBatchCosts, read)
+	api.POST("/payouts/:batch_id/approve", payoutHandler.ApproveBatch, approve)
+	api.POST("/payouts/:batch_id/reject", payoutHandler.RejectBatch, approve)
+	api.POST("/payouts/:id/reverse", payoutHandler.ReversePayout, middlewares.OperatorOnly)
+	api.POST("/payouts/:id/reissue", payoutHandler.ReissuePayout, submit)
+
+	api.GET("/corridors", corridorHandler.ListCorridors)
+	api.GET("/quotes", corridorHandler.GetQuote)
+	api.GET("/banks", bankHandler.ListBanks)
+
+	// Erasure requests from recipients (NDPR/GDPR)
+	api.DELETE("/recipients/:id/pii", recipientHandler.ErasePII, middlewares.Require(domain.PermAdmin))
+
+	api.GET("/reviews", reviewHandler.ListReviews, read)
+	api.POST("/reviews/:id/approve", reviewHandler.Approve, approve)
+	api.POST("/reviews/:id/reject", reviewHandler.Reject, approve)
+
+	// Each tenant sees its own usage; the operator may pass tenant_id
+	api.GET("/limits/usage", limitHandler.GetUsage, read)
+
+	api.POST("/funding", ledgerHandler.RecordFunding, middlewares.OperatorOnly)
+	api.GET("/balance", ledgerHandler.GetBalance, read)
+	api.GET("/balance/statement", ledgerHandler.GetStatement, read)
+	api.GET("/fees", billingHandler.GetFeeSchedule, read)
+	api.GET("/invoices/:month", billingHandler.GetInvoice, read)
+
+	// Reconciliation covers the whole Afriex account
+	recon := api.Group("/reconciliations", middlewares.OperatorOnly)
+	recon.POST("", reconHandler.ImportExport)
+	recon.GET("", reconHandler.ListRuns)
+	recon.GET("/:id", reconHandler.GetRun)
+	recon.GET("/:id/exceptions", reconHandler.ListExceptions)
+
+	keys := api.Group("/keys", middlewares.Require(domain.PermAdmin))
+	keys.POST("", keyHandler.CreateKey)
+	keys.GET("", keyHandler.ListKeys)
+	keys.POST("/:id/rotate", keyHandler.RotateKey)
+	keys.POST("/:id/revoke", keyHandler.RevokeKey)
+
+	api.GET("/me", userHandler.Me)
+	api.POST("/me/password", userHandler.ChangePassword)
+
+	users := api.Group("/users", middlewares.Require(domain.PermAdmin))
+	users.POST("", userHandler.CreateUser)
+	users.GET("", userHandler.ListUsers)
+	users.POST("/:id/role", userHandler.SetRole)
+	users.POST("/:id/disable", userHandler.DisableUser)
+	users.POST("/:id/password", userHandler.SetPassword)
+
+	api.GET("/audit", auditHandler.ListEntries, middlewares.Require(domain.PermAdmin))
+	api.GET("/audit/verify", auditHandler.Verify, middlewares.OperatorOnly)
 
 	// Swagger Endpoint
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
@@ -142,4 +362,23 @@ func main() {
 	if err := e.Shutdown(ctx); err != nil {
 		e.Logger.Fatal(err)
 	}
-}
\ No newline at end of file
+}
+
+// defaultRateLimits turns the configured limits into the rules tenants and
+// keys without their own fall back to.
+func defaultRateLimits(cfg config.RateLimitConfig) []domain.RateLimitRule {
+	var rules []domain.RateLimitRule
+	if cfg.PerMinute > 0 {
+		rules = append(rules, domain.RateLimitRule{
+			Route:     domain.RouteAny,
+			RateLimit: domain.RateLimit{PerMinute: cfg.PerMinute, Burst: cfg.Burst},
+		})
+	}
+	if cfg.PayoutsPerMinute > 0 {
+		rules = append(rules, domain.RateLimitRule{
+			Route:     http.MethodPost + " /api/v1/payouts",
+			RateLimit: domain.RateLimit{PerMinute: cfg.PayoutsPerMinute, Burst: cfg.PayoutsBurst},
+		})
+	}
+	return rules
+}
@@ -0,0 +1,101 @@
+// Command audit checks and reads the audit log.
+//
+//	go run ./cmd/audit verify [-head HASH]
+//	go run ./cmd/audit list [-tenant payroll-ng] [-actor EMAIL] [-action batch.approved] [-target-type batch] [-target-id ID] [-limit 50]
+//
+// verify recomputes the hash chain and exits 1 at the first altered or
+// missing entry. Keep the head hash it prints somewhere the database's
+// owners cannot write; passing it back with -head later also catches
+// entries cut from the end of the log.
+//
+// It reads the same app.env as the API server.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	wayaDB "waya/internal/adapters/storage/db"
+	"waya/internal/config"
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		fail("load config: %v", err)
+	}
+	db, err := wayaDB.NewDatabase(cfg.Database)
+	if err != nil {
+		fail("open database: %v", err)
+	}
+	defer db.Conn.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := wayaDB.NewRepository(db, nil) // Reads no recipient details
+	audit := services.NewAuditService(repo, logger)
+	ctx := context.Background()
+
+	cmd, args := os.Args[1], os.Args[2:]
+	switch cmd {
+	case "verify":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		head := fs.String("head", "", "a head hash printed by an earlier run; it must still be in the chain")
+		fs.Parse(args)
+
+		v, err := audit.Verify(ctx, *head)
+		if err != nil {
+			fail("verify audit log: %v", err)
+		}
+		if v.BrokenAt != 0 {
+			fail("Audit log BROKEN at entry %d: %s (%d entries before it are intact)", v.BrokenAt, v.Problem, v.Checked)
+		}
+		fmt.Printf("Audit log intact: %d entries\n", v.Checked)
+		fmt.Printf("Head: %d %s\n", v.HeadID, v.HeadHash)
+
+	case "list":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		var f domain.AuditFilter
+		fs.StringVar(&f.TenantID, "tenant", "", "tenant ID; empty for all")
+		fs.StringVar(&f.Actor, "actor", "", "who acted")
+		fs.StringVar(&f.Action, "action", "", "e.g. batch.approved")
+		fs.StringVar(&f.TargetType, "target-type", "", "e.g. batch, api_key")
+		fs.StringVar(&f.TargetID, "target-id", "", "the target's ID")
+		fs.IntVar(&f.Limit, "limit", 50, "number of entries, newest first")
+		fs.Parse(args)
+
+		entries, err := audit.Query(ctx, f)
+		if err != nil {
+			fail("list audit log: %v", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tAT\tTENANT\tACTOR\tACTION\tTARGET\tIP")
+		for _, e := range entries {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s %s\t%s\n", e.ID, e.At.Format("2006-01-02 15:04:05"), e.TenantID, e.Actor, e.Action, e.TargetType, e.TargetID, e.IP)
+		}
+		w.Flush()
+
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: audit verify [-head HASH] |\n"+
+		"       list [-tenant ID] [-actor ACTOR] [-action ACTION] [-target-type TYPE] [-target-id ID] [-limit N]")
+	os.Exit(2)
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
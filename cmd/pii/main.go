@@ -0,0 +1,140 @@
+// Command pii manages the encryption of recipient details at rest.
+//
+//	go run ./cmd/pii genkey
+//	go run ./cmd/pii status
+//	go run ./cmd/pii reencrypt
+//	go run ./cmd/pii rotate
+//	go run ./cmd/pii rewrap
+//
+// genkey prints a new master key for PII_MASTER_KEY or PII_MASTER_KEY_FILE.
+//
+// reencrypt encrypts rows stored before encryption was enabled and moves
+// rows and API key signing secrets still under a retired data key onto the
+// current one. It can be stopped and run again.
+//
+// rotate starts a new data key and re-encrypts every row under it. A
+// running API server switches to the new key within a minute; run
+// reencrypt again afterwards to move what it wrote in between.
+//
+// rewrap replaces the master key: list the new key first and the old one
+// after it, run rewrap, then remove the old key. The data keys are re-sealed;
+// rows are untouched.
+//
+// It reads the same app.env as the API server.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	wayaDB "waya/internal/adapters/storage/db"
+	"waya/internal/config"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	if os.Args[1] == "genkey" {
+		key, err := wayaDB.GenerateMasterKey()
+		if err != nil {
+			fail("generate key: %v", err)
+		}
+		fmt.Println(key)
+		return
+	}
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		fail("load config: %v", err)
+	}
+	masters, err := wayaDB.LoadMasterKeys(cfg.PII)
+	if err != nil {
+		fail("load master key: %v", err)
+	}
+	if len(masters) == 0 {
+		fail("PII_MASTER_KEY or PII_MASTER_KEY_FILE must be set; run \"pii genkey\" for a new key")
+	}
+	db, err := wayaDB.NewDatabase(cfg.Database)
+	if err != nil {
+		fail("open database: %v", err)
+	}
+	defer db.Conn.Close()
+
+	ctx := context.Background()
+	pii, err := wayaDB.NewPIICipher(ctx, db, masters)
+	if err != nil {
+		fail("load PII keys: %v", err)
+	}
+	repo := wayaDB.NewRepository(db, pii)
+
+	switch os.Args[1] {
+	case "status":
+		keys, plaintext, err := repo.PIIUsage(ctx)
+		if err != nil {
+			fail("read key usage: %v", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "KEY\tPURPOSE\tMASTER\tCREATED\tRETIRED\tVALUES")
+		for _, k := range keys {
+			retired, values := "", fmt.Sprint(k.Values)
+			if !k.RetiredAt.IsZero() {
+				retired = k.RetiredAt.Format("2006-01-02 15:04")
+			}
+			if k.ID == pii.ActiveKeyID() {
+				retired = "active"
+			}
+			if k.Purpose != "DATA" {
+				values = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", k.ID, k.Purpose, k.MasterKeyID, k.CreatedAt.Format("2006-01-02 15:04"), retired, values)
+		}
+		w.Flush()
+		fmt.Printf("Plaintext values: %d\n", plaintext)
+
+	case "reencrypt":
+		reencrypt(ctx, repo, pii.ActiveKeyID())
+
+	case "rotate":
+		id, err := pii.Rotate(ctx)
+		if err != nil {
+			fail("rotate data key: %v", err)
+		}
+		fmt.Printf("New data key %s\n", id)
+		reencrypt(ctx, repo, id)
+
+	case "rewrap":
+		n, err := pii.Rewrap(ctx)
+		if err != nil {
+			fail("re-seal keys: %v", err)
+		}
+		fmt.Printf("Re-sealed %d keys with master %s; older master keys can be removed\n", n, masters[0].ID)
+
+	default:
+		usage()
+	}
+}
+
+func reencrypt(ctx context.Context, repo *wayaDB.SQLiteRepo, keyID string) {
+	payouts, reviews, err := repo.ReencryptPII(ctx)
+	if err != nil {
+		fail("re-encrypt (%d payouts and %d reviews done, run reencrypt to resume): %v", payouts, reviews, err)
+	}
+	secrets, err := repo.ReencryptSigningSecrets(ctx)
+	if err != nil {
+		fail("re-encrypt API key signing secrets (payouts and reviews done): %v", err)
+	}
+	fmt.Printf("Re-encrypted %d payouts, %d reviews and %d API key signing secrets under %s\n", payouts, reviews, secrets, keyID)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: pii genkey | status | reencrypt | rotate | rewrap")
+	os.Exit(2)
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,232 @@
+// Command tenants manages the business units sharing a Waya deployment.
+//
+//	go run ./cmd/tenants create -id payroll-ng -name "Payroll Nigeria" [-webhook URL]
+//	go run ./cmd/tenants list
+//	go run ./cmd/tenants webhook -id payroll-ng -url URL
+//	go run ./cmd/tenants ratelimit -id payroll-ng [-key KEY_ID] [-route "POST /api/v1/payouts"] -per-minute 60 -burst 10
+//	go run ./cmd/tenants quota -id payroll-ng [-key KEY_ID] -daily 5000
+//	go run ./cmd/tenants limits -id payroll-ng
+//	go run ./cmd/tenants user -id payroll-ng -email ngozi@acme.com -name "Ngozi Adeyemi" -role admin
+//
+// Without -key a rate limit applies to each of the tenant's keys and a quota
+// to the tenant as a whole. Zero removes a limit or quota. The API server
+// picks changes up within 30 seconds.
+//
+// user adds a dashboard user with a random password, printed once; admins
+// add further users on /api/v1/users.
+//
+// It reads the same app.env as the API server.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"waya/internal/adapters/auth"
+	wayaDB "waya/internal/adapters/storage/db"
+	"waya/internal/config"
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		fail("load config: %v", err)
+	}
+	db, err := wayaDB.NewDatabase(cfg.Database)
+	if err != nil {
+		fail("open database: %v", err)
+	}
+	defer db.Conn.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := wayaDB.NewRepository(db, nil) // Reads no recipient details
+	audit := services.NewAuditService(repo, logger)
+	tenants := services.NewTenantService(repo, audit, logger)
+	keys := services.NewAPIKeyService(repo, repo, audit, logger)
+	limiter := services.NewRateLimiter(repo, nil, cfg.Rate.DailyPayoutQuota, audit, logger)
+	users := services.NewUserService(repo, repo, auth.NewJWT([]byte(cfg.Auth.JWTSecret), "waya"), cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL, audit, logger)
+	ctx := domain.WithAuditSource(context.Background(), domain.AuditSource{Principal: "cli"})
+
+	cmd, args := os.Args[1], os.Args[2:]
+	switch cmd {
+	case "create":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID, a lowercase slug")
+		name := fs.String("name", "", "display name")
+		webhook := fs.String("webhook", "", "URL batch and payout events are sent to")
+		fs.Parse(args)
+
+		t, err := tenants.Create(ctx, *id, *name, *webhook)
+		if err != nil {
+			fail("create tenant: %v", err)
+		}
+		_, secret, err := keys.Create(ctx, t.ID, "Initial admin key", []string{domain.ScopeAdmin}, time.Time{}, false, "cli")
+		if err != nil {
+			fail("create api key: %v", err)
+		}
+		fmt.Printf("Created tenant %s (%s)\n", t.ID, t.Name)
+		fmt.Printf("Admin API key: %s\n", secret)
+		fmt.Println("Store the key now; it cannot be shown again. Use it on /api/v1/keys to issue narrower keys.")
+
+	case "list":
+		list, err := tenants.List(ctx)
+		if err != nil {
+			fail("list tenants: %v", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tWEBHOOK\tCREATED")
+		for _, t := range list {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.ID, t.Name, t.WebhookURL, t.CreatedAt.Format("2006-01-02"))
+		}
+		w.Flush()
+
+	case "webhook":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID")
+		url := fs.String("url", "", "new webhook URL; empty stops events")
+		fs.Parse(args)
+
+		if err := tenants.SetWebhook(ctx, *id, *url); err != nil {
+			fail("set webhook: %v", err)
+		}
+		fmt.Printf("Webhook of tenant %s updated\n", *id)
+
+	case "ratelimit":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID")
+		key := fs.String("key", "", "API key ID; empty limits each of the tenant's keys")
+		route := fs.String("route", domain.RouteAny, `method and route, e.g. "POST /api/v1/payouts"; * for every other route`)
+		perMinute := fs.Int("per-minute", 0, "requests refilled per minute; 0 with -burst 0 removes the limit")
+		burst := fs.Int("burst", 0, "requests allowed at once")
+		fs.Parse(args)
+
+		checkTarget(ctx, tenants, keys, *id, *key)
+		err := limiter.SetRateLimit(ctx, domain.RateLimitRule{
+			TenantID:  *id,
+			KeyID:     *key,
+			Route:     *route,
+			RateLimit: domain.RateLimit{PerMinute: *perMinute, Burst: *burst},
+		})
+		if err != nil {
+			fail("set rate limit: %v", err)
+		}
+		fmt.Printf("Rate limit of tenant %s updated\n", *id)
+
+	case "quota":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID")
+		key := fs.String("key", "", "API key ID; empty caps the whole tenant")
+		daily := fs.Int("daily", 0, "payout items per UTC day; 0 removes the quota")
+		fs.Parse(args)
+
+		checkTarget(ctx, tenants, keys, *id, *key)
+		if err := limiter.SetPayoutQuota(ctx, domain.PayoutQuota{TenantID: *id, KeyID: *key, DailyItems: *daily}); err != nil {
+			fail("set quota: %v", err)
+		}
+		fmt.Printf("Payout quota of tenant %s updated\n", *id)
+
+	case "limits":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID")
+		fs.Parse(args)
+
+		checkTarget(ctx, tenants, keys, *id, "")
+		rules, err := limiter.Rules(ctx, *id)
+		if err != nil {
+			fail("list rate limits: %v", err)
+		}
+		quotas, err := limiter.Quotas(ctx, *id)
+		if err != nil {
+			fail("list quotas: %v", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "KEY\tROUTE\tPER MINUTE\tBURST\tDAILY ITEMS")
+		for _, r := range rules {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t\n", keyOr(r.KeyID, "(each key)"), r.Route, r.PerMinute, r.Burst)
+		}
+		for _, q := range quotas {
+			fmt.Fprintf(w, "%s\t\t\t\t%d\n", keyOr(q.KeyID, "(tenant)"), q.DailyItems)
+		}
+		w.Flush()
+		if len(rules) == 0 && len(quotas) == 0 {
+			fmt.Println("No limits of its own; the RATE_LIMIT_* and DAILY_PAYOUT_QUOTA defaults apply")
+		}
+
+	case "user":
+		fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+		id := fs.String("id", "", "tenant ID")
+		email := fs.String("email", "", "email the user signs in with")
+		name := fs.String("name", "", "display name")
+		role := fs.String("role", domain.RoleAdmin, "viewer, operator, approver or admin")
+		fs.Parse(args)
+
+		b := make([]byte, 12)
+		if _, err := rand.Read(b); err != nil {
+			fail("generate password: %v", err)
+		}
+		password := hex.EncodeToString(b)
+		u, err := users.Create(ctx, *id, *email, *name, *role, password, "cli")
+		if err != nil {
+			fail("create user: %v", err)
+		}
+		fmt.Printf("Created %s user %s (%s) in tenant %s\n", u.Role, u.Email, u.ID, u.TenantID)
+		fmt.Printf("Password: %s\n", password)
+		fmt.Println("Pass it on securely; it cannot be shown again. The user can change it on /api/v1/me/password.")
+
+	default:
+		usage()
+	}
+}
+
+// checkTarget fails unless the tenant exists and, if given, the key is one
+// of its keys.
+func checkTarget(ctx context.Context, tenants *services.TenantService, keys *services.APIKeyService, tenantID, keyID string) {
+	if _, err := tenants.Get(ctx, tenantID); err != nil {
+		fail("tenant %q: %v", tenantID, err)
+	}
+	if keyID == "" {
+		return
+	}
+	list, err := keys.List(ctx, tenantID)
+	if err != nil {
+		fail("list api keys: %v", err)
+	}
+	for _, k := range list {
+		if k.ID == keyID {
+			return
+		}
+	}
+	fail("api key %q: %v", keyID, domain.ErrAPIKeyNotFound)
+}
+
+func keyOr(keyID, none string) string {
+	if keyID == "" {
+		return none
+	}
+	return keyID
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: tenants create -id ID -name NAME [-webhook URL] | list | webhook -id ID -url URL |\n"+
+		"       ratelimit -id ID [-key KEY_ID] [-route ROUTE] -per-minute N -burst N | quota -id ID [-key KEY_ID] -daily N | limits -id ID |\n"+
+		"       user -id ID -email EMAIL -name NAME [-role ROLE]")
+	os.Exit(2)
+}
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -23,39 +23,97 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/payouts": {
-            "post": {
-                "description": "Accepts a list of recipients, creates customers/payment methods on Afriex, and sends money.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/audit": {
+            "get": {
+                "description": "Audit entries, newest first: who did what to which target, from which request and IP, with the state before and after. Page with before_id set to the last id of the previous page. Admin scope required. The operator may pass tenant_id, or * for every tenant.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payouts"
+                    "Audit"
                 ],
-                "summary": "Trigger Bulk Payout",
+                "summary": "Query Audit Log",
                 "parameters": [
                     {
-                        "description": "The batch of payouts to process",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.BulkPayoutRequest"
-                        }
+                        "type": "string",
+                        "description": "Tenant whose entries to list (operator only); * for all",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Who acted, e.g. a user's email",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "e.g. batch.approved, api_key.rotated",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "e.g. batch, api_key, user",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The target's ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "X-Request-Id of the request that acted",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries at or after this date or RFC 3339 time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries before this date (inclusive) or RFC 3339 time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entries older than this id",
+                        "name": "before_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of entries (max 1000)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Batch accepted for background processing",
+                    "200": {
+                        "description": "Entries",
                         "schema": {
-                            "$ref": "#/definitions/http.BulkPayoutResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.AuditEntryResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON or payload",
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or another tenant's entries",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -66,34 +124,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/payouts/{batch_id}": {
+        "/audit/verify": {
             "get": {
-                "description": "Retrieves all payouts and status for a given batch ID.",
+                "description": "Recomputes the hash chain over the whole log and reports the first entry that was altered or removed. Keep head_hash somewhere else and pass it back as head later: if the chain no longer passes through it, entries were cut from the end. Operator only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payouts"
+                    "Audit"
                 ],
-                "summary": "Get Batch Status",
+                "summary": "Verify Audit Log",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Unique ID of the payout batch",
-                        "name": "batch_id",
-                        "in": "path",
-                        "required": true
+                        "description": "A head_hash from an earlier run; it must still be in the chain",
+                        "name": "head",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Returns batch details and list of payouts",
+                        "description": "Chain intact",
                         "schema": {
-                            "$ref": "#/definitions/domain.Batch"
+                            "$ref": "#/definitions/http.AuditVerificationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Batch ID not found",
+                    "409": {
+                        "description": "Chain broken",
+                        "schema": {
+                            "$ref": "#/definitions/http.AuditVerificationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Signs a user in with their email and password. Send the access token as \"Authorization: Bearer \u003ctoken\u003e\"; when it expires, exchange the refresh token at /auth/refresh. Attempts are rate limited per IP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Log In",
+                "parameters": [
+                    {
+                        "description": "Email and password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Access and refresh tokens",
+                        "schema": {
+                            "$ref": "#/definitions/http.SessionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Wrong email or password, or user disabled",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -101,8 +198,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "429": {
+                        "description": "Too many attempts",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -113,9 +210,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/webhooks/afriex": {
+        "/auth/logout": {
+            "post": {
+                "description": "Ends the session of a refresh token. Its access token stays valid until it expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Log Out",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Signed out"
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
             "post": {
-                "description": "Receives real-time transaction updates (e.g., SUCCESS/FAILED) from Afriex.",
+                "description": "Exchanges a refresh token for a new access token and a new refresh token. Each refresh token works once; presenting a used one again signs the user out of every session.",
                 "consumes": [
                     "application/json"
                 ],
@@ -123,196 +248,3776 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Webhooks"
+                    "Auth"
                 ],
-                "summary": "Afriex Webhook Listener",
+                "summary": "Refresh Session",
                 "parameters": [
                     {
-                        "description": "Afriex Webhook Payload",
+                        "description": "Refresh token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/http.RefreshRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "New access and refresh tokens",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/http.SessionResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid Signature or Payload",
+                    "401": {
+                        "description": "Refresh token invalid, expired or reused",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.Batch": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "payouts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.Payout"
+        },
+        "/balance": {
+            "get": {
+                "description": "Available, reserved and settled funds and fees charged per currency, summed from the ledger.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Get Balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only this currency",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balances",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.BalanceResponse"
+                            }
+                        }
                     }
-                },
-                "status": {
-                    "type": "string"
-                },
-                "totalAmount": {
-                    "type": "integer",
-                    "format": "int64"
-                },
-                "totalCount": {
-                    "type": "integer"
                 }
             }
         },
-        "domain.Payout": {
-            "type": "object",
-            "properties": {
-                "accountNumber": {
-                    "description": "\"2039...\"",
-                    "type": "string"
-                },
-                "amount": {
-                    "description": "Cents",
-                    "type": "integer",
-                    "format": "int64"
-                },
-                "bankCode": {
-                    "description": "\"033\" (UBA)",
-                    "type": "string"
-                },
-                "bankName": {
-                    "description": "\"United Bank for Africa\"",
-                    "type": "string"
-                },
-                "batchID": {
-                    "type": "string"
-                },
-                "countryCode": {
-                    "description": "\"NG\", \"GH\", \"KE\"",
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "currency": {
-                    "description": "\"NGN\"",
-                    "type": "string"
-                },
-                "errorMessage": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "recipientEmail": {
-                    "description": "\"john@example.com\"",
-                    "type": "string"
-                },
-                "recipientName": {
-                    "description": "\"John Doe\"",
-                    "type": "string"
-                },
-                "recipientPhone": {
-                    "description": "\"+234...\"",
-                    "type": "string"
-                },
-                "recipientTag": {
-                    "description": "Optional: If sending to Afriex Wallet directly",
-                    "type": "string"
-                },
-                "referenceID": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
+        "/balance/statement": {
+            "get": {
+                "description": "Lists every ledger entry in one currency between two dates, with opening and closing balances. Dates are YYYY-MM-DD (to is inclusive) or RFC 3339 timestamps; they default to the current month.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Balance Statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency, e.g. NGN",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date, e.g. 2026-10-01",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date, e.g. 2026-10-31",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statement",
+                        "schema": {
+                            "$ref": "#/definitions/http.StatementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid currency or dates",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "http.BulkPayoutRequest": {
+        "/banks": {
+            "get": {
+                "description": "Lists the banks and mobile money operators for a country, for UI dropdowns.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Banks"
+                ],
+                "summary": "List Banks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "NG",
+                        "description": "Destination country",
+                        "name": "country",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by BANK_ACCOUNT or MOBILE_MONEY",
+                        "name": "channel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Institutions ordered by name",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.BankResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or unsupported country",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/corridors": {
+            "get": {
+                "description": "Lists every destination country with its currencies, channels, amount limits and required fields.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Corridors"
+                ],
+                "summary": "List Corridors",
+                "responses": {
+                    "200": {
+                        "description": "Supported corridors",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.CorridorResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/fees": {
+            "get": {
+                "description": "The rules Waya prices each payout with. A payout uses the most specific rule: country and currency, then currency, then \"*\".",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get Fee Schedule",
+                "responses": {
+                    "200": {
+                        "description": "Fee rules",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.FeeRuleResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/funding": {
+            "post": {
+                "description": "Credits a tenant's prefunding top-up to its available balance. The reference is the external ID of the incoming transfer and can only be used once. Operator API key only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ledger"
+                ],
+                "summary": "Record Funding",
+                "parameters": [
+                    {
+                        "description": "Incoming funds",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.FundingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Funds credited",
+                        "schema": {
+                            "$ref": "#/definitions/http.FundingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing reference or invalid amount",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not the operator's API key",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Reference already recorded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{month}": {
+            "get": {
+                "description": "Fees charged on payouts that settled in a calendar month (UTC), per corridor and per payout. Add format=csv for a spreadsheet.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Billing"
+                ],
+                "summary": "Get Invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Billing month, e.g. 2026-10",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default) or csv",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice",
+                        "schema": {
+                            "$ref": "#/definitions/http.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/keys": {
+            "get": {
+                "description": "The tenant's keys, newest first, including revoked and expired ones. Secrets are never returned. Admin scope required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "List API Keys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant whose keys to list (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Keys",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.APIKeyResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin key, or another tenant's keys",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Issues an API key. The secret is in the response only; store it then. A signed_only key refuses the x-api-key header and works only on signed requests. Admin scope required. The operator may pass tenant_id to issue a key for another tenant.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Create API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant to issue the key for (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Name, scopes and expiry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "The key, with its secret",
+                        "schema": {
+                            "$ref": "#/definitions/http.IssuedAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing name, unknown scope or past expiry",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin key, or another tenant's keys",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/revoke": {
+            "post": {
+                "description": "Stops the key working immediately. Admin scope required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Revoke API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant that owns the key (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revoked key",
+                        "schema": {
+                            "$ref": "#/definitions/http.APIKeyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Key not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Key already revoked",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/keys/{id}/rotate": {
+            "post": {
+                "description": "Issues a successor with the same name, scopes, lifetime and signing requirement. The old key keeps working for the grace period (default 24h) so clients can switch. A key can be rotated once. Admin scope required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Rotate API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant that owns the key (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Grace period",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/http.RotateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "The new key, with its secret",
+                        "schema": {
+                            "$ref": "#/definitions/http.IssuedAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid grace",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Key not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Key is revoked or already rotated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/limits/usage": {
+            "get": {
+                "description": "Shows how much of each payout limit is used in its current window. Pass bank_code and account_number to include that recipient's daily usage.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Limits"
+                ],
+                "summary": "Limit Usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant whose usage to show (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Corridor country, e.g. NG (all when empty)",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Corridor currency, e.g. NGN (all when empty)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recipient bank code",
+                        "name": "bank_code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recipient account number",
+                        "name": "account_number",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Usage per limit",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.LimitUsageResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Incomplete recipient",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Another tenant's usage",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "description": "The signed-in user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Current User",
+                "responses": {
+                    "200": {
+                        "description": "User",
+                        "schema": {
+                            "$ref": "#/definitions/http.UserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/password": {
+            "post": {
+                "description": "Changes the signed-in user's password and ends all of their sessions, this one included: refresh tokens stop working, so they sign in again once the access token expires.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Change Own Password",
+                "parameters": [
+                    {
+                        "description": "Current and new password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Password changed"
+                    },
+                    "400": {
+                        "description": "New password too short or long",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Current password is wrong",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts": {
+            "post": {
+                "description": "Accepts a list of recipients, creates customers/payment methods on Afriex, and sends money.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Trigger Bulk Payout",
+                "parameters": [
+                    {
+                        "description": "The batch of payouts to process",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.BulkPayoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Batch accepted for background processing",
+                        "schema": {
+                            "$ref": "#/definitions/http.BulkPayoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or payload",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Batch exceeds the available balance",
+                        "schema": {
+                            "$ref": "#/definitions/http.InsufficientFundsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Blocked: likely duplicates of recent payouts",
+                        "schema": {
+                            "$ref": "#/definitions/http.DuplicateErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Over a per-payout, daily recipient or monthly corridor limit",
+                        "schema": {
+                            "$ref": "#/definitions/http.LimitErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Over the tenant's or API key's daily payout quota, or the rate limit",
+                        "schema": {
+                            "$ref": "#/definitions/http.QuotaErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/all": {
+            "get": {
+                "description": "Retrieves a complete, paginated list of all payout records for the Waya Admin Dashboard.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "List All Payouts",
+                "responses": {
+                    "200": {
+                        "description": "List of all payouts",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Payout"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/search": {
+            "get": {
+                "description": "The tenant's payouts to an account number or phone, newest first. Recipient details are stored encrypted and found through keyed hashes, so matching is exact: no partial numbers. A phone without its + country code needs country.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Search Payouts by Recipient",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Destination account number",
+                        "name": "account_number",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recipient phone, e.g. +2348012345678",
+                        "name": "phone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Country whose numbering plan reads a local phone, e.g. NG",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of payouts (max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching payouts",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Payout"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "No account number or phone, or an unreadable phone",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{batch_id}": {
+            "get": {
+                "description": "Retrieves all payouts and status for a given batch ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Get Batch Status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique ID of the payout batch",
+                        "name": "batch_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Returns batch details and list of payouts",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Batch"
+                        }
+                    },
+                    "404": {
+                        "description": "Batch ID not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{batch_id}/approve": {
+            "post": {
+                "description": "Maker-checker: a different, authorized caller releases a batch waiting for approval. The checker is the signed-in user or API key making the request and must not be the one that submitted the batch. Execution starts immediately.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Approve Batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique ID of the payout batch",
+                        "name": "batch_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional comment",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/http.BatchDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Approved batch, now processing",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Batch"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Submitter cannot approve, or user is not an approver",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Batch ID not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Batch is not awaiting approval",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{batch_id}/costs": {
+            "get": {
+                "description": "What Afriex actually debited for the batch's paid payouts, per destination currency and in total, fees included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Get Batch Costs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique ID of the payout batch",
+                        "name": "batch_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cost summary",
+                        "schema": {
+                            "$ref": "#/definitions/http.BatchCostResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Batch ID not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{batch_id}/reject": {
+            "post": {
+                "description": "Cancels a batch waiting for approval. None of its payouts are sent; they end as REJECTED.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Reject Batch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique ID of the payout batch",
+                        "name": "batch_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Who rejects it and why",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.BatchDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rejected batch",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Batch"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing comment",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an approver",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Batch ID not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Batch is not awaiting approval",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{id}/reissue": {
+            "post": {
+                "description": "Sends a REVERSED payout again to corrected bank details, as a new one-payout batch that goes through the usual checks, approval, limits and funding. The new payout's ReissueOf points back at the original. Each reversed payout can be re-issued once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Re-issue Reversed Payout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the reversed payout",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Corrected destination",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ReissuePayoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "New batch, processing or awaiting approval",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Batch"
+                        }
+                    },
+                    "400": {
+                        "description": "Corrected details are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Payout is not REVERSED, was already re-issued or its recipient was erased",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Over a daily payout quota",
+                        "schema": {
+                            "$ref": "#/definitions/http.QuotaErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payouts/{id}/reverse": {
+            "post": {
+                "description": "Marks a SUCCESS payout as REVERSED after the bank returned the funds, e.g. because the account is closed. The tenant is credited back, fee included, and the client receives a payout.reversed event. Reversing an already reversed payout returns it unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payouts"
+                ],
+                "summary": "Reverse Payout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payout ID (not the batch ID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Why it was reversed",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ReversePayoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reversed payout",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Payout"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Payout not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Payout is not SUCCESS",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/quotes": {
+            "get": {
+                "description": "Prices a payout into a corridor at the current Afriex rate, with Waya's fee from the tenant's schedule.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Corridors"
+                ],
+                "summary": "Get Quote",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "NG",
+                        "description": "Destination country",
+                        "name": "country",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "NGN",
+                        "description": "Destination currency",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "5000.00",
+                        "description": "Destination amount as a decimal string",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "BANK_ACCOUNT (default) or MOBILE_MONEY",
+                        "name": "channel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Indicative quote",
+                        "schema": {
+                            "$ref": "#/definitions/http.QuoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Amount or corridor not supported",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Rate provider unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recipients/{id}/pii": {
+            "delete": {
+                "description": "Honours an erasure request. Recipients have no ID of their own, so name any payout sent to them. Their name, phone, email, tag, account number, resolved account name and review details are blanked on that payout and on every other payout of the tenant to the same account (country, bank and account number) or phone. Payouts not yet finished (pending, held, awaiting approval or processing) keep the details they need and are listed in live_payout_ids; erase again once they finish. Amounts, statuses and references are kept. Erased payouts can no longer be re-issued. Each erasure is written to the audit log. Erasing again returns an empty payout_ids. Admin scope required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recipients"
+                ],
+                "summary": "Erase Recipient Details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of a payout to the recipient",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant that owns the payout (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payouts erased",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErasureResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or another tenant's payout",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Payout not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations": {
+            "get": {
+                "description": "Most recent reconciliation runs first, with their totals.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "List Reconciliation Runs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "How many runs (default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Runs",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.ReconciliationRunResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Reconciles an Afriex transaction export (CSV or JSON) against our payouts, by transaction ID, reference and amount. Send the file as multipart field \"file\", or as the raw body with a text/csv or application/json content type. The window defaults to the days the export covers.",
+                "consumes": [
+                    "multipart/form-data",
+                    "text/csv",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Import Afriex Export",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Afriex export",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start, e.g. 2026-10-01",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end, e.g. 2026-10-31 (inclusive)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Run with its exceptions",
+                        "schema": {
+                            "$ref": "#/definitions/http.ReconciliationRunResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unreadable export or bad window",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations/{id}": {
+            "get": {
+                "description": "A run's totals and every exception it found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Get Reconciliation Run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Run",
+                        "schema": {
+                            "$ref": "#/definitions/http.ReconciliationRunResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Run not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliations/{id}/exceptions": {
+            "get": {
+                "description": "The exceptions of a run, optionally of one kind.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "List Reconciliation Exceptions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MISSING_OURS, MISSING_THEIRS or AMOUNT_MISMATCH",
+                        "name": "result",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exceptions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.ReconciliationItemResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Run not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews": {
+            "get": {
+                "description": "Lists payouts held by sanctions screening, name enquiry or the large amount check, oldest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "List Reviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PENDING (default), APPROVED or REJECTED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of reviews",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Review queue",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.ReviewResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/approve": {
+            "post": {
+                "description": "Clears the hold and sends the payout back into the execution pipeline.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Approve Review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Why it was approved",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ReviewDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Approved; the payout resumes in the background",
+                        "schema": {
+                            "$ref": "#/definitions/http.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Review already decided",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/reject": {
+            "post": {
+                "description": "Ends the held payout as REJECTED without sending any money.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Reject Review",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Why it was rejected",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ReviewDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rejected",
+                        "schema": {
+                            "$ref": "#/definitions/http.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Review already decided",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "The tenant's users by email, including disabled ones. Admin required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant whose users to list (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.UserResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or another tenant's users",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a user to the tenant. Roles: viewer reads; operator also submits and re-issues batches; approver reads and approves or rejects batches and reviews; admin does everything, including users and API keys. Admin required. The operator may pass tenant_id to add a user to another tenant.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant to add the user to (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Email, name, role and initial password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User",
+                        "schema": {
+                            "$ref": "#/definitions/http.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid email, role or password",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or another tenant's users",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Email already taken",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/disable": {
+            "post": {
+                "description": "Stops the user signing in and ends their sessions; their access tokens stop working at once. Users cannot disable themselves. Admin required.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Disable User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant of the user (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Disabled user",
+                        "schema": {
+                            "$ref": "#/definitions/http.UserResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or the caller themselves",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "User already disabled",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/password": {
+            "post": {
+                "description": "Sets a new password for the user and ends their sessions. Admin required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reset User Password",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant of the user (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "New password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Password reset"
+                    },
+                    "400": {
+                        "description": "Password too short or long",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/role": {
+            "post": {
+                "description": "Changes a user's role from their next request on. Users cannot change their own. Admin required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Set User Role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tenant of the user (operator only)",
+                        "name": "tenant_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "New role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SetRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User",
+                        "schema": {
+                            "$ref": "#/definitions/http.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown role",
+                        "schema": {
+                            "$ref": "#/definitions/http.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an admin, or the caller's own role",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/afriex": {
+            "post": {
+                "description": "Receives transaction updates from Afriex, signed with HMAC-SHA256 of the raw body in the x-webhook-signature header. A REVERSED, RETURNED or REFUNDED status reverses the matching SUCCESS payout. Unknown transactions are acknowledged so Afriex stops retrying.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Afriex Webhook Listener",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hex HMAC-SHA256 of the body",
+                        "name": "x-webhook-signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Afriex webhook payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.AfriexWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid payload",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "AFRIEX_WEBHOOK_SECRET is not configured",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.Batch": {
+            "type": "object",
+            "properties": {
+                "apikeyID": {
+                    "description": "Key that submitted it, counted against its quota",
+                    "type": "string"
+                },
+                "approvalReason": {
+                    "description": "Why the policy asked for approval",
+                    "type": "string"
+                },
+                "approvalStatus": {
+                    "description": "NOT_REQUIRED, PENDING, APPROVED, REJECTED",
+                    "type": "string"
+                },
+                "approvals": {
+                    "description": "Audit trail of who submitted, approved or rejected it",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BatchEvent"
+                    }
+                },
+                "costs": {
+                    "description": "Real source cost per destination currency",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CostSummary"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "duplicates": {
+                    "description": "Payouts that look like repeats of recent ones",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DuplicateMatch"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Payout"
+                    }
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "statusCounts": {
+                    "description": "Payouts per status, e.g. {\"SUCCESS\": 48, \"HELD_REVIEW\": 2}",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "submittedBy": {
+                    "description": "Maker-checker",
+                    "type": "string"
+                },
+                "tenantID": {
+                    "type": "string"
+                },
+                "totalAmount": {
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BatchEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "SUBMITTED, APPROVED, REJECTED",
+                    "type": "string"
+                },
+                "actor": {
+                    "description": "Who did it",
+                    "type": "string"
+                },
+                "batchID": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CostSummary": {
+            "type": "object",
+            "properties": {
+                "allInRate": {
+                    "description": "Delivered / TotalCost, i.e. net of fees",
+                    "type": "string"
+                },
+                "delivered": {
+                    "description": "What those payouts delivered",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Money"
+                        }
+                    ]
+                },
+                "destinationCurrency": {
+                    "type": "string"
+                },
+                "effectiveRate": {
+                    "description": "Delivered / Source",
+                    "type": "string"
+                },
+                "fees": {
+                    "$ref": "#/definitions/domain.Money"
+                },
+                "payouts": {
+                    "description": "Payouts with a recorded cost",
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/domain.Money"
+                },
+                "sourceCurrency": {
+                    "type": "string"
+                },
+                "totalCost": {
+                    "description": "Source + Fees",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "domain.DuplicateMatch": {
+            "type": "object",
+            "properties": {
+                "matchedAt": {
+                    "type": "string"
+                },
+                "matchedBatchID": {
+                    "description": "Same as the payout's own batch for repeats inside one file",
+                    "type": "string"
+                },
+                "matchedPayoutID": {
+                    "type": "string"
+                },
+                "payoutID": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Minor units",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "currency": {
+                    "description": "ISO 4217 code, e.g. \"NGN\"",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Payout": {
+            "type": "object",
+            "properties": {
+                "accountNumber": {
+                    "description": "\"2039...\"",
+                    "type": "string"
+                },
+                "amount": {
+                    "description": "Minor units of Currency (kobo, cents...)",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "bankCode": {
+                    "description": "\"033\" (UBA)",
+                    "type": "string"
+                },
+                "bankName": {
+                    "description": "\"United Bank for Africa\"",
+                    "type": "string"
+                },
+                "batchID": {
+                    "type": "string"
+                },
+                "channel": {
+                    "description": "BANK_ACCOUNT, MOBILE_MONEY",
+                    "type": "string"
+                },
+                "countryCode": {
+                    "description": "\"NG\", \"GH\", \"KE\"",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "\"NGN\"",
+                    "type": "string"
+                },
+                "duplicateOf": {
+                    "description": "Earlier payout this one looks like a copy of",
+                    "type": "string"
+                },
+                "effectiveRate": {
+                    "description": "Currency units delivered per SourceCurrency unit",
+                    "type": "string"
+                },
+                "errorMessage": {
+                    "type": "string"
+                },
+                "feeAmount": {
+                    "description": "Minor units of SourceCurrency charged on top",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "fingerprint": {
+                    "description": "Account + amount + currency hash used for duplicate detection",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nameMatchScore": {
+                    "description": "0..1 similarity with RecipientName",
+                    "type": "number",
+                    "format": "float64"
+                },
+                "piierasedAt": {
+                    "description": "Set when the recipient details were erased, on request or by the\nretention job; the recipient fields are then empty",
+                    "type": "string"
+                },
+                "recipientEmail": {
+                    "description": "\"john@example.com\"",
+                    "type": "string"
+                },
+                "recipientName": {
+                    "description": "\"John Doe\"",
+                    "type": "string"
+                },
+                "recipientPhone": {
+                    "description": "As sent by the client: \"0801...\", \"+234 801...\"",
+                    "type": "string"
+                },
+                "recipientPhoneE164": {
+                    "description": "Normalized: \"+2348012345678\"",
+                    "type": "string"
+                },
+                "recipientTag": {
+                    "description": "Optional: If sending to Afriex Wallet directly",
+                    "type": "string"
+                },
+                "referenceID": {
+                    "type": "string"
+                },
+                "reissueOf": {
+                    "description": "Reversed payout this one re-sends",
+                    "type": "string"
+                },
+                "reissuedAs": {
+                    "description": "Payout that re-sent this one after it was reversed",
+                    "type": "string"
+                },
+                "resolvedAccountName": {
+                    "description": "Name enquiry result (empty when the check is disabled)",
+                    "type": "string"
+                },
+                "reversalReason": {
+                    "type": "string"
+                },
+                "reversalSource": {
+                    "description": "Set when a SUCCESS payout comes back",
+                    "type": "string"
+                },
+                "reversedAt": {
+                    "type": "string"
+                },
+                "reversedBy": {
+                    "type": "string"
+                },
+                "screeningListVersion": {
+                    "description": "Sanctions list version the recipient was screened against",
+                    "type": "string"
+                },
+                "serviceFee": {
+                    "description": "Waya's fee in Currency minor units, priced at submission",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "sourceAmount": {
+                    "description": "Minor units of SourceCurrency debited for the conversion",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "sourceCurrency": {
+                    "description": "\"USD\"",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tenantID": {
+                    "type": "string"
+                },
+                "transactionID": {
+                    "description": "What Afriex actually charged, recorded when the payout succeeds",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ValidationError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "key:wk_77d0e2aa"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Payroll export job"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "wk_3f9a01bc"
+                },
+                "replaced_by": {
+                    "description": "Key issued by rotating this one",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "revoked_by": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "payouts:read",
+                        "payouts:write"
+                    ]
+                },
+                "signed_only": {
+                    "type": "boolean"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.AfriexWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "properties": {
+                        "reason": {
+                            "type": "string",
+                            "example": "Beneficiary account closed"
+                        },
+                        "status": {
+                            "type": "string",
+                            "example": "REVERSED"
+                        },
+                        "transactionId": {
+                            "type": "string",
+                            "example": "tx_8f2a"
+                        }
+                    }
+                },
+                "event": {
+                    "type": "string",
+                    "example": "TRANSACTION.UPDATED"
+                }
+            }
+        },
+        "http.AuditEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "batch.approved"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "ngozi@acme.com"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "prev_hash": {
+                    "type": "string"
+                },
+                "principal": {
+                    "description": "The credential used: a user's email or key:\u003cprefix\u003e",
+                    "type": "string",
+                    "example": "ngozi@acme.com"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string",
+                    "example": "batch"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.AuditVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "broken_at": {
+                    "type": "integer"
+                },
+                "checked": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "head_hash": {
+                    "description": "Record it elsewhere; a later head that no longer contains it means entries were cut",
+                    "type": "string"
+                },
+                "head_id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "intact": {
+                    "type": "boolean"
+                },
+                "problem": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.BalanceResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Free to pay out",
+                    "type": "string",
+                    "example": "2750000.00"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "fees": {
+                    "description": "Charged by Waya on settled payouts",
+                    "type": "string",
+                    "example": "45000.00"
+                },
+                "funded": {
+                    "description": "Total ever topped up",
+                    "type": "string",
+                    "example": "12000000.00"
+                },
+                "reserved": {
+                    "description": "Held for payouts in flight or awaiting a decision",
+                    "type": "string",
+                    "example": "250000.00"
+                },
+                "settled": {
+                    "description": "Paid out to recipients",
+                    "type": "string",
+                    "example": "9000000.00"
+                }
+            }
+        },
+        "http.BankResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "example": "BANK_ACCOUNT"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "058"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Guaranty Trust Bank"
+                }
+            }
+        },
+        "http.BatchCostResponse": {
+            "type": "object",
+            "properties": {
+                "batch_id": {
+                    "type": "string"
+                },
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.CurrencyCostResponse"
+                    }
+                },
+                "pending": {
+                    "description": "Payouts without a recorded cost yet",
+                    "type": "integer"
+                },
+                "source_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "total_cost": {
+                    "description": "Source plus fees",
+                    "type": "string",
+                    "example": "3345.84"
+                },
+                "total_fees": {
+                    "type": "string",
+                    "example": "12.50"
+                },
+                "total_source": {
+                    "description": "Debited for conversions",
+                    "type": "string",
+                    "example": "3333.34"
+                }
+            }
+        },
+        "http.BatchDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Required when rejecting",
+                    "type": "string",
+                    "example": "Matches January payroll sign-off"
+                }
+            }
+        },
+        "http.BulkPayoutRequest": {
+            "type": "object",
+            "properties": {
+                "batch_reference": {
+                    "type": "string",
+                    "example": "JAN_SALARY_2025"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.PayoutItem"
+                    }
+                }
+            }
+        },
+        "http.BulkPayoutResponse": {
+            "type": "object",
+            "properties": {
+                "approval_reason": {
+                    "description": "Why the batch is AWAITING_APPROVAL",
+                    "type": "string"
+                },
+                "batch_id": {
+                    "type": "string"
+                },
+                "duplicates": {
+                    "description": "Items that look like repeats of recent payouts",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.DuplicateResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.CorridorChannelResponse": {
+            "type": "object",
+            "properties": {
+                "required_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "bank_code",
+                        "account_number"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "example": "BANK_ACCOUNT"
+                }
+            }
+        },
+        "http.CorridorCurrencyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "max_amount": {
+                    "type": "string",
+                    "example": "50000000.00"
+                },
+                "min_amount": {
+                    "type": "string",
+                    "example": "100.00"
+                }
+            }
+        },
+        "http.CorridorResponse": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.CorridorChannelResponse"
+                    }
+                },
+                "country": {
+                    "type": "string",
+                    "example": "NG"
+                },
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.CorridorCurrencyResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Nigeria"
+                },
+                "required_fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "recipient_name",
+                        "recipient_phone"
+                    ]
+                }
+            }
+        },
+        "http.CreateAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "Never expires when omitted",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Payroll export job"
+                },
+                "scopes": {
+                    "description": "payouts:read, payouts:write, payouts:approve, admin",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "payouts:read",
+                        "payouts:write"
+                    ]
+                },
+                "signed_only": {
+                    "description": "Refuse x-api-key; requests must be signed",
+                    "type": "boolean"
+                }
+            }
+        },
+        "http.CreateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "ngozi@acme.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ngozi Adeyemi"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery staple"
+                },
+                "role": {
+                    "description": "viewer, operator, approver, admin",
+                    "type": "string",
+                    "example": "approver"
+                }
+            }
+        },
+        "http.CurrencyCostResponse": {
+            "type": "object",
+            "properties": {
+                "all_in_rate": {
+                    "description": "Delivered per source unit, fees included",
+                    "type": "string",
+                    "example": "1494.393000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "delivered": {
+                    "type": "string",
+                    "example": "5000000.00"
+                },
+                "effective_rate": {
+                    "description": "Delivered per source unit",
+                    "type": "string",
+                    "example": "1499.998500"
+                },
+                "fees": {
+                    "type": "string",
+                    "example": "12.50"
+                },
+                "payouts": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "source": {
+                    "type": "string",
+                    "example": "3333.34"
+                },
+                "total_cost": {
+                    "type": "string",
+                    "example": "3345.84"
+                }
+            }
+        },
+        "http.DuplicateErrorResponse": {
+            "type": "object",
+            "properties": {
+                "duplicates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.DuplicateResponse"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "batch contains likely duplicate payouts: 2 match(es)"
+                }
+            }
+        },
+        "http.DuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "Index into the request items",
+                    "type": "integer",
+                    "example": 3
+                },
+                "matched_at": {
+                    "type": "string"
+                },
+                "matched_batch_id": {
+                    "type": "string"
+                },
+                "matched_payout_id": {
+                    "type": "string"
+                },
+                "payout_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.ErasureResponse": {
+            "type": "object",
+            "properties": {
+                "erased_at": {
+                    "description": "When the named payout was erased",
+                    "type": "string"
+                },
+                "live_payout_ids": {
+                    "description": "Payouts to the recipient still pending, held, awaiting approval or\nprocessing. They keep the details they need; erase again once they finish.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "payout_id": {
+                    "description": "The payout named in the request",
+                    "type": "string"
+                },
+                "payout_ids": {
+                    "description": "Erased now; empty when all were already erased",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.FeeChargeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "500000.00"
+                },
+                "batch_id": {
+                    "type": "string"
+                },
+                "charged_at": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "NG"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "fee": {
+                    "type": "string",
+                    "example": "2550.00"
+                },
+                "payout_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.FeeRuleResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "description": "Empty matches every corridor in the currency",
+                    "type": "string",
+                    "example": "NG"
+                },
+                "currency": {
+                    "description": "\"*\" matches every currency",
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "flat": {
+                    "type": "string",
+                    "example": "50.00"
+                },
+                "max": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "min": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "percent": {
+                    "type": "string",
+                    "example": "0.5"
+                }
+            }
+        },
+        "http.FundingRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "1500000.00"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "October payroll top-up"
+                },
+                "reference": {
+                    "description": "External ID of the incoming transfer",
+                    "type": "string",
+                    "example": "GTB-TRF-20261018-0042"
+                },
+                "tenant_id": {
+                    "description": "Tenant to credit; the operator's own when empty",
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.FundingResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "1500000.00"
+                },
+                "available": {
+                    "description": "Available balance after the top-up",
+                    "type": "string",
+                    "example": "2750000.00"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "entry_id": {
+                    "type": "string"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "GTB-TRF-20261018-0042"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.InsufficientFundsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "insufficient funds: NGN 1500000.00 required, 1200000.00 available"
+                },
+                "shortfalls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.ShortfallResponse"
+                    }
+                }
+            }
+        },
+        "http.InvoiceLineResponse": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "NG"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "fees": {
+                    "type": "string",
+                    "example": "306000.00"
+                },
+                "payouts": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "volume": {
+                    "type": "string",
+                    "example": "60000000.00"
+                }
+            }
+        },
+        "http.InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "charges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.FeeChargeResponse"
+                    }
+                },
+                "issued_at": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.InvoiceLineResponse"
+                    }
+                },
+                "number": {
+                    "type": "string",
+                    "example": "WAYA-DEFAULT-202610"
+                },
+                "period_end": {
+                    "description": "Exclusive",
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.InvoiceTotalResponse"
+                    }
+                }
+            }
+        },
+        "http.InvoiceTotalResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "306000.00"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                }
+            }
+        },
+        "http.IssuedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "key:wk_77d0e2aa"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "wk_3f9a01bc_5e0c..."
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Payroll export job"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "wk_3f9a01bc"
+                },
+                "replaced_by": {
+                    "description": "Key issued by rotating this one",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "revoked_by": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "payouts:read",
+                        "payouts:write"
+                    ]
+                },
+                "signed_only": {
+                    "type": "boolean"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.LimitErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "limit exceeded: DAILY_PER_RECIPIENT NG/058/0123456789: 5000000.00 requested, 22000000.00 used of 25000000.00"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.LimitViolationResponse"
+                    }
+                }
+            }
+        },
+        "http.LimitUsageResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "MONTHLY_PER_CORRIDOR"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "2000000000.00"
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "1847000000.00"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "NG/NGN"
+                },
+                "used": {
+                    "type": "string",
+                    "example": "153000000.00"
+                },
+                "window_end": {
+                    "type": "string"
+                },
+                "window_start": {
+                    "description": "Absent for per-payout limits",
+                    "type": "string"
+                }
+            }
+        },
+        "http.LimitViolationResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "item": {
+                    "description": "Index into the request items",
+                    "type": "integer",
+                    "example": 0
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "DAILY_PER_RECIPIENT"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "25000000.00"
+                },
+                "requested": {
+                    "type": "string",
+                    "example": "5000000.00"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "NG/058/0123456789"
+                },
+                "used": {
+                    "description": "Already committed in the window, excluding this item",
+                    "type": "string",
+                    "example": "22000000.00"
+                }
+            }
+        },
+        "http.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "ngozi@acme.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery staple"
+                }
+            }
+        },
+        "http.PayoutItem": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "2000012345"
+                },
+                "amount": {
+                    "description": "Transaction Details (Step 3 of Afriex Flow)",
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "bank_code": {
+                    "description": "Bank Details (Step 2 of Afriex Flow)",
+                    "type": "string",
+                    "example": "033"
+                },
+                "channel": {
+                    "description": "BANK_ACCOUNT (default) or MOBILE_MONEY",
+                    "type": "string",
+                    "example": "BANK_ACCOUNT"
+                },
+                "country_code": {
+                    "type": "string",
+                    "example": "NG"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "recipient_email": {
+                    "type": "string",
+                    "example": "emeka@example.com"
+                },
+                "recipient_name": {
+                    "description": "User Details (Step 1 of Afriex Flow)",
+                    "type": "string",
+                    "example": "Emeka Okonkwo"
+                },
+                "recipient_phone": {
+                    "type": "string",
+                    "example": "+2348012345678"
+                }
+            }
+        },
+        "http.QuotaErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "daily payout quota exceeded: tenant quota is 5000 items, 4990 used today, 20 requested"
+                },
+                "quota": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "requested": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "resets_at": {
+                    "type": "string",
+                    "example": "2026-10-19T00:00:00Z"
+                },
+                "scope": {
+                    "description": "tenant or api_key",
+                    "type": "string",
+                    "example": "tenant"
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 4990
+                }
+            }
+        },
+        "http.QuoteResponse": {
             "type": "object",
             "properties": {
-                "batch_reference": {
+                "country": {
                     "type": "string",
-                    "example": "JAN_SALARY_2025"
+                    "example": "NG"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/http.PayoutItem"
-                    }
+                "destination_amount": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "destination_currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "fee": {
+                    "description": "Waya's fee, in the destination currency",
+                    "type": "string",
+                    "example": "125.00"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "1500.25"
+                },
+                "source_amount": {
+                    "type": "string",
+                    "example": "3.34"
+                },
+                "source_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "total_debit": {
+                    "description": "Taken from the balance: amount plus fee",
+                    "type": "string",
+                    "example": "5125.00"
                 }
             }
         },
-        "http.BulkPayoutResponse": {
+        "http.ReconciliationItemResponse": {
             "type": "object",
             "properties": {
-                "batch_id": {
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "we paid 50000.00 NGN, Afriex settled 5000.00 NGN"
+                },
+                "id": {
                     "type": "string"
                 },
-                "message": {
+                "our_amount": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "payout_id": {
                     "type": "string"
                 },
-                "status": {
+                "reference": {
+                    "type": "string"
+                },
+                "result": {
+                    "description": "MISSING_OURS, MISSING_THEIRS, AMOUNT_MISMATCH, REVERSED",
+                    "type": "string",
+                    "example": "AMOUNT_MISMATCH"
+                },
+                "their_amount": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "transaction_id": {
                     "type": "string"
                 }
             }
         },
-        "http.PayoutItem": {
+        "http.ReconciliationRunResponse": {
             "type": "object",
             "properties": {
-                "account_number": {
+                "amount_mismatches": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.ReconciliationItemResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "matched": {
+                    "type": "integer",
+                    "example": 410
+                },
+                "missing_ours": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "missing_theirs": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "records": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "reversed": {
+                    "description": "Payouts reversed by this import",
+                    "type": "integer",
+                    "example": 0
+                },
+                "source": {
                     "type": "string",
-                    "example": "2000012345"
+                    "example": "afriex-2026-10-17.csv"
                 },
-                "amount": {
-                    "description": "Transaction Details (Step 3 of Afriex Flow)",
-                    "type": "number",
-                    "example": 5000
+                "to": {
+                    "description": "Exclusive",
+                    "type": "string"
+                }
+            }
+        },
+        "http.RefreshRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "wr_9c1f..."
+                }
+            }
+        },
+        "http.ReissuePayoutRequest": {
+            "type": "object",
+            "properties": {
+                "account_number": {
+                    "type": "string",
+                    "example": "0123456789"
                 },
                 "bank_code": {
-                    "description": "Bank Details (Step 2 of Afriex Flow)",
                     "type": "string",
-                    "example": "033"
+                    "example": "044"
+                },
+                "channel": {
+                    "type": "string",
+                    "example": "BANK_ACCOUNT"
+                },
+                "recipient_name": {
+                    "type": "string",
+                    "example": "Emeka Okafor"
+                }
+            }
+        },
+        "http.ReversePayoutRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Account closed, funds returned by GTBank"
+                }
+            }
+        },
+        "http.ReviewDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Confirmed recipient identity with HR"
+                }
+            }
+        },
+        "http.ReviewResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "batch_id": {
+                    "type": "string"
                 },
                 "country_code": {
                     "type": "string",
                     "example": "NG"
                 },
+                "created_at": {
+                    "type": "string"
+                },
                 "currency": {
                     "type": "string",
                     "example": "NGN"
                 },
-                "recipient_email": {
+                "decided_at": {
+                    "type": "string"
+                },
+                "decision_reason": {
+                    "type": "string"
+                },
+                "detail": {
                     "type": "string",
-                    "example": "emeka@example.com"
+                    "example": "name mismatch: bank has \"EMEKA OKAFOR\" for \"Emeka Okonkwo\" (score 0.71)"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payout_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "SANCTIONS, NAME_MISMATCH, LARGE_AMOUNT",
+                    "type": "string",
+                    "example": "NAME_MISMATCH"
                 },
                 "recipient_name": {
-                    "description": "User Details (Step 1 of Afriex Flow)",
                     "type": "string",
                     "example": "Emeka Okonkwo"
                 },
-                "recipient_phone": {
+                "reviewer": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string",
-                    "example": "+2348012345678"
+                    "example": "PENDING"
+                }
+            }
+        },
+        "http.RotateAPIKeyRequest": {
+            "type": "object",
+            "properties": {
+                "grace": {
+                    "description": "Go duration; default 24h, \"0s\" ends the old key now",
+                    "type": "string",
+                    "example": "24h"
+                }
+            }
+        },
+        "http.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "expires_at": {
+                    "description": "Of the access token",
+                    "type": "string"
+                },
+                "refresh_expires_at": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "wr_9c1f..."
+                },
+                "token_type": {
+                    "type": "string",
+                    "example": "Bearer"
+                },
+                "user": {
+                    "$ref": "#/definitions/http.UserResponse"
+                }
+            }
+        },
+        "http.SetPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "current_password": {
+                    "description": "Required on /me/password",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery staple"
+                }
+            }
+        },
+        "http.SetRoleRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "operator"
+                }
+            }
+        },
+        "http.ShortfallResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "string",
+                    "example": "1200000.00"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "required": {
+                    "type": "string",
+                    "example": "1500000.00"
+                }
+            }
+        },
+        "http.StatementLineResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "string",
+                    "example": "-50000.00"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "entry_id": {
+                    "type": "string"
+                },
+                "fees": {
+                    "type": "string",
+                    "example": "0.00"
+                },
+                "kind": {
+                    "description": "FUNDING, RESERVE, SETTLE, RELEASE",
+                    "type": "string",
+                    "example": "RESERVE"
+                },
+                "reference": {
+                    "description": "Payout ID or funding reference",
+                    "type": "string"
+                },
+                "reserved": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "settled": {
+                    "type": "string",
+                    "example": "0.00"
+                }
+            }
+        },
+        "http.StatementResponse": {
+            "type": "object",
+            "properties": {
+                "closing": {
+                    "$ref": "#/definitions/http.BalanceResponse"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "NGN"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.StatementLineResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "opening": {
+                    "$ref": "#/definitions/http.BalanceResponse"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "ada@waya.finance"
+                },
+                "disabled_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ngozi@acme.com"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_login_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ngozi Adeyemi"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "approver"
+                },
+                "tenant_id": {
+                    "type": "string",
+                    "example": "payroll-ng"
+                }
+            }
+        },
+        "http.ValidationErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ValidationError"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "validation failed"
                 }
             }
         }
@@ -11,26 +11,51 @@ import (
 
 	"waya/internal/config"
 	"waya/internal/core/domain" // Use domain model for the payload
+	"waya/internal/core/ports"
 )
 
+// Notifier posts events to the webhook of the tenant they belong to. Only
+// the DefaultTenant falls back to BETAWORKOS_WEBHOOK_URL, so one business
+// unit's payroll never reaches another's system.
 type Notifier struct {
 	webhookURL string
+	tenants    ports.TenantStore
 	httpClient *http.Client
 }
 
-func NewNotifier(cfg config.WayaConfig) *Notifier {
+func NewNotifier(cfg config.WayaConfig, tenants ports.TenantStore) *Notifier {
 	return &Notifier{
 		webhookURL: cfg.BETAWORKOSWebhookURL,
+		tenants:    tenants,
 		httpClient: &http.Client{Timeout: 5 * time.Second},
 	}
 }
 
-// NotifyBatchCompletion sends the final batch status to the client's webhook URL
-func (n *Notifier) NotifyBatchCompletion(ctx context.Context, batchID string, payouts []domain.Payout) error {
-	slog.Info("🔔 Attempting to notify client system (BetaWorkOS)", "batch_id", batchID, "url", n.webhookURL)
+// urlFor returns the tenant's webhook URL, or "" when it has none
+func (n *Notifier) urlFor(ctx context.Context, tenantID string) string {
+	if n.tenants != nil {
+		t, err := n.tenants.GetTenant(ctx, tenantID)
+		if err != nil {
+			slog.Error("Failed to load tenant for client notification", "tenant_id", tenantID, "err", err)
+			return ""
+		}
+		if t.WebhookURL != "" {
+			return t.WebhookURL
+		}
+	}
+	if tenantID == domain.DefaultTenant {
+		return n.webhookURL
+	}
+	return ""
+}
+
+// NotifyBatchCompletion sends the final batch status to the tenant's webhook URL
+func (n *Notifier) NotifyBatchCompletion(ctx context.Context, tenantID, batchID string, payouts []domain.Payout) error {
+	url := n.urlFor(ctx, tenantID)
+	slog.Info("🔔 Attempting to notify client system (BetaWorkOS)", "batch_id", batchID, "tenant_id", tenantID, "url", url)
 	
-	if n.webhookURL == "" {
-		slog.Warn("Skipping client notification: CLIENT_WEBHOOK_URL is not set.")
+	if url == "" {
+		slog.Warn("Skipping client notification: tenant has no webhook URL.", "tenant_id", tenantID)
 		return nil
 	}
 
@@ -45,13 +70,47 @@ func (n *Notifier) NotifyBatchCompletion(ctx context.Context, batchID string, pa
 		},
 	}
 
+	return n.post(ctx, url, payload)
+}
+
+// NotifyPayoutReversed tells the client a payout that showed SUCCESS was
+// returned by the bank, so they can correct the bank details and re-issue it.
+func (n *Notifier) NotifyPayoutReversed(ctx context.Context, payout domain.Payout) error {
+	url := n.urlFor(ctx, payout.TenantID)
+	slog.Info("🔔 Notifying client of reversed payout", "payout_id", payout.ID, "tenant_id", payout.TenantID, "url", url)
+
+	if url == "" {
+		slog.Warn("Skipping client notification: tenant has no webhook URL.", "tenant_id", payout.TenantID)
+		return nil
+	}
+
+	payload := map[string]any{
+		"event":     "payout.reversed",
+		"batch_id":  payout.BatchID,
+		"timestamp": time.Now().UTC(),
+		"data": map[string]any{
+			"payout_id":       payout.ID,
+			"reference_id":    payout.ReferenceID,
+			"transaction_id":  payout.TransactionID,
+			"amount":          payout.Money().String(),
+			"currency":        payout.Currency,
+			"reversal_source": payout.ReversalSource,
+			"reason":          payout.ReversalReason,
+			"reversed_at":     payout.ReversedAt,
+		},
+	}
+	return n.post(ctx, url, payload)
+}
+
+// post delivers one event to the client's webhook URL
+func (n *Notifier) post(ctx context.Context, url string, payload map[string]any) error {
 	jsonBytes, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal notification payload: %w", err)
 	}
 
 	// 2. Make the HTTP Call
-	req, err := http.NewRequestWithContext(ctx, "POST", n.webhookURL, bytes.NewBuffer(jsonBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBytes))
 	if err != nil {
 		return fmt.Errorf("failed to create client notification request: %w", err)
 	}
@@ -60,7 +119,7 @@ func (n *Notifier) NotifyBatchCompletion(ctx context.Context, batchID string, pa
 
 	resp, err := n.httpClient.Do(req)
 	if err != nil {
-		slog.Error("❌ Client notification FAILED (Client system down?)", "err", err, "url", n.webhookURL)
+		slog.Error("❌ Client notification FAILED (Client system down?)", "err", err, "url", url)
 		// For a real system, you would retry this later.
 		return err
 	}
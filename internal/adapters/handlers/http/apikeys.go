@@ -0,0 +1,211 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/adapters/handlers/http/middlewares"
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+// defaultRotationGrace is how long a rotated key keeps working by default
+const defaultRotationGrace = 24 * time.Hour
+
+type APIKeyHandler struct {
+	keys *services.APIKeyService
+}
+
+func NewAPIKeyHandler(keys *services.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{keys: keys}
+}
+
+// @Summary Create API Key
+// @Description Issues an API key. The secret is in the response only; store it then. A signed_only key refuses the x-api-key header and works only on signed requests. Admin scope required. The operator may pass tenant_id to issue a key for another tenant.
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Param tenant_id query string false "Tenant to issue the key for (operator only)"
+// @Param request body CreateAPIKeyRequest true "Name, scopes and expiry"
+// @Success 201 {object} IssuedAPIKeyResponse "The key, with its secret"
+// @Failure 400 {object} ValidationErrorResponse "Missing name, unknown scope or past expiry"
+// @Failure 403 {object} map[string]string "Not an admin key, or another tenant's keys"
+// @Failure 404 {object} map[string]string "Tenant not found"
+// @Router /keys [post]
+func (h *APIKeyHandler) CreateKey(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	var req CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	k, secret, err := h.keys.Create(c.Request().Context(), tenant, req.Name, req.Scopes, expiresAt, req.SignedOnly, actor(c))
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+	return c.JSON(http.StatusCreated, IssuedAPIKeyResponse{APIKeyResponse: toAPIKeyResponse(*k), Key: secret})
+}
+
+// @Summary List API Keys
+// @Description The tenant's keys, newest first, including revoked and expired ones. Secrets are never returned. Admin scope required.
+// @Tags API Keys
+// @Produce json
+// @Param tenant_id query string false "Tenant whose keys to list (operator only)"
+// @Success 200 {object} []APIKeyResponse "Keys"
+// @Failure 403 {object} map[string]string "Not an admin key, or another tenant's keys"
+// @Router /keys [get]
+func (h *APIKeyHandler) ListKeys(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	keys, err := h.keys.List(c.Request().Context(), tenant)
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+	resp := make([]APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, toAPIKeyResponse(k))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Rotate API Key
+// @Description Issues a successor with the same name, scopes, lifetime and signing requirement. The old key keeps working for the grace period (default 24h) so clients can switch. A key can be rotated once. Admin scope required.
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Param tenant_id query string false "Tenant that owns the key (operator only)"
+// @Param request body RotateAPIKeyRequest false "Grace period"
+// @Success 201 {object} IssuedAPIKeyResponse "The new key, with its secret"
+// @Failure 400 {object} ValidationErrorResponse "Invalid grace"
+// @Failure 404 {object} map[string]string "Key not found"
+// @Failure 409 {object} map[string]string "Key is revoked or already rotated"
+// @Router /keys/{id}/rotate [post]
+func (h *APIKeyHandler) RotateKey(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	var req RotateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	grace := defaultRotationGrace
+	if raw := strings.TrimSpace(req.Grace); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			var errs domain.ValidationErrors
+			errs.Add("grace", "must be a duration such as 24h or 0s")
+			return validationFailed(c, errs)
+		}
+		grace = d
+	}
+
+	k, secret, err := h.keys.Rotate(c.Request().Context(), tenant, c.Param("id"), grace, actor(c))
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+	return c.JSON(http.StatusCreated, IssuedAPIKeyResponse{APIKeyResponse: toAPIKeyResponse(*k), Key: secret})
+}
+
+// @Summary Revoke API Key
+// @Description Stops the key working immediately. Admin scope required.
+// @Tags API Keys
+// @Produce json
+// @Param id path string true "API key ID"
+// @Param tenant_id query string false "Tenant that owns the key (operator only)"
+// @Success 200 {object} APIKeyResponse "Revoked key"
+// @Failure 404 {object} map[string]string "Key not found"
+// @Failure 409 {object} map[string]string "Key already revoked"
+// @Router /keys/{id}/revoke [post]
+func (h *APIKeyHandler) RevokeKey(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	k, err := h.keys.Revoke(c.Request().Context(), tenant, c.Param("id"), actor(c))
+	if err != nil {
+		return apiKeyError(c, err)
+	}
+	return c.JSON(http.StatusOK, toAPIKeyResponse(*k))
+}
+
+// managedTenant is the tenant whose keys or users the request manages: the
+// caller's own, or tenant_id when the caller is the operator.
+func managedTenant(c echo.Context) (string, bool) {
+	own := tenantID(c)
+	requested := strings.TrimSpace(c.QueryParam("tenant_id"))
+	if requested == "" || requested == own {
+		return own, true
+	}
+	return requested, own == domain.DefaultTenant
+}
+
+func forbidOtherTenant(c echo.Context) error {
+	return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden: only the operator manages other tenants"})
+}
+
+// actor names the caller in audit fields
+func actor(c echo.Context) string {
+	if p := middlewares.CurrentPrincipal(c); p != nil {
+		return p.Actor()
+	}
+	return "api-key"
+}
+
+func apiKeyError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		return validationFailed(c, verrs)
+	case errors.Is(err, domain.ErrAPIKeyNotFound), errors.Is(err, domain.ErrTenantNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrAPIKeyRevoked), errors.Is(err, domain.ErrAPIKeyReplaced):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		slog.Error("API key request failed", "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "API key request failed"})
+	}
+}
+
+func toAPIKeyResponse(k domain.APIKey) APIKeyResponse {
+	resp := APIKeyResponse{
+		ID:         k.ID,
+		TenantID:   k.TenantID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		RevokedBy:  k.RevokedBy,
+		ReplacedBy: k.ReplacedBy,
+		SignedOnly: k.SignedOnly,
+	}
+	if !k.ExpiresAt.IsZero() {
+		t := k.ExpiresAt
+		resp.ExpiresAt = &t
+	}
+	if !k.LastUsedAt.IsZero() {
+		t := k.LastUsedAt
+		resp.LastUsedAt = &t
+	}
+	if !k.RevokedAt.IsZero() {
+		t := k.RevokedAt
+		resp.RevokedAt = &t
+	}
+	return resp
+}
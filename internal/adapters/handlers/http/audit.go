@@ -0,0 +1,161 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+// allTenants is the tenant_id with which the operator reads every tenant's entries
+const allTenants = "*"
+
+type AuditHandler struct {
+	audit *services.AuditService
+}
+
+func NewAuditHandler(audit *services.AuditService) *AuditHandler {
+	return &AuditHandler{audit: audit}
+}
+
+// @Summary Query Audit Log
+// @Description Audit entries, newest first: who did what to which target, from which request and IP, with the state before and after. Page with before_id set to the last id of the previous page. Admin scope required. The operator may pass tenant_id, or * for every tenant.
+// @Tags Audit
+// @Produce json
+// @Param tenant_id query string false "Tenant whose entries to list (operator only); * for all"
+// @Param actor query string false "Who acted, e.g. a user's email"
+// @Param action query string false "e.g. batch.approved, api_key.rotated"
+// @Param target_type query string false "e.g. batch, api_key, user"
+// @Param target_id query string false "The target's ID"
+// @Param request_id query string false "X-Request-Id of the request that acted"
+// @Param from query string false "Entries at or after this date or RFC 3339 time"
+// @Param to query string false "Entries before this date (inclusive) or RFC 3339 time"
+// @Param before_id query int false "Entries older than this id"
+// @Param limit query int false "Maximum number of entries (max 1000)" default(100)
+// @Success 200 {object} []AuditEntryResponse "Entries"
+// @Failure 400 {object} ValidationErrorResponse "Invalid filter"
+// @Failure 403 {object} map[string]string "Not an admin, or another tenant's entries"
+// @Router /audit [get]
+func (h *AuditHandler) ListEntries(c echo.Context) error {
+	f := domain.AuditFilter{
+		Actor:      strings.TrimSpace(c.QueryParam("actor")),
+		Action:     strings.TrimSpace(c.QueryParam("action")),
+		TargetType: strings.TrimSpace(c.QueryParam("target_type")),
+		TargetID:   strings.TrimSpace(c.QueryParam("target_id")),
+		RequestID:  strings.TrimSpace(c.QueryParam("request_id")),
+	}
+	if strings.TrimSpace(c.QueryParam("tenant_id")) == allTenants {
+		if tenantID(c) != domain.DefaultTenant {
+			return forbidOtherTenant(c)
+		}
+	} else {
+		tenant, ok := managedTenant(c)
+		if !ok {
+			return forbidOtherTenant(c)
+		}
+		f.TenantID = tenant
+	}
+
+	var errs domain.ValidationErrors
+	var err error
+	if raw := c.QueryParam("from"); raw != "" {
+		if f.From, err = parseStatementTime(raw, false); err != nil {
+			errs.Add("from", "must be a date (YYYY-MM-DD) or RFC 3339 time")
+		}
+	}
+	if raw := c.QueryParam("to"); raw != "" {
+		if f.To, err = parseStatementTime(raw, true); err != nil {
+			errs.Add("to", "must be a date (YYYY-MM-DD) or RFC 3339 time")
+		}
+	}
+	if raw := c.QueryParam("before_id"); raw != "" {
+		if f.BeforeID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			errs.Add("before_id", "must be an integer")
+		}
+	}
+	if raw := c.QueryParam("limit"); raw != "" {
+		if f.Limit, err = strconv.Atoi(raw); err != nil {
+			errs.Add("limit", "must be an integer")
+		}
+	}
+	if len(errs) > 0 {
+		return validationFailed(c, errs)
+	}
+
+	entries, err := h.audit.Query(c.Request().Context(), f)
+	if err != nil {
+		return auditError(c, err)
+	}
+	resp := make([]AuditEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, toAuditEntryResponse(e))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Verify Audit Log
+// @Description Recomputes the hash chain over the whole log and reports the first entry that was altered or removed. Keep head_hash somewhere else and pass it back as head later: if the chain no longer passes through it, entries were cut from the end. Operator only.
+// @Tags Audit
+// @Produce json
+// @Param head query string false "A head_hash from an earlier run; it must still be in the chain"
+// @Success 200 {object} AuditVerificationResponse "Chain intact"
+// @Failure 409 {object} AuditVerificationResponse "Chain broken"
+// @Router /audit/verify [get]
+func (h *AuditHandler) Verify(c echo.Context) error {
+	v, err := h.audit.Verify(c.Request().Context(), strings.TrimSpace(c.QueryParam("head")))
+	if err != nil {
+		return auditError(c, err)
+	}
+	resp := AuditVerificationResponse{
+		Intact:   v.BrokenAt == 0,
+		Checked:  v.Checked,
+		HeadID:   v.HeadID,
+		HeadHash: v.HeadHash,
+		BrokenAt: v.BrokenAt,
+		Problem:  v.Problem,
+	}
+	if !resp.Intact {
+		return c.JSON(http.StatusConflict, resp)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func auditError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	if errors.As(err, &verrs) {
+		return validationFailed(c, verrs)
+	}
+	slog.Error("Audit log request failed", "err", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Audit log request failed"})
+}
+
+func toAuditEntryResponse(e domain.AuditEntry) AuditEntryResponse {
+	resp := AuditEntryResponse{
+		ID:         e.ID,
+		At:         e.At,
+		TenantID:   e.TenantID,
+		Actor:      e.Actor,
+		Principal:  e.Principal,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		IP:         e.IP,
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+	if e.Before != "" {
+		resp.Before = json.RawMessage(e.Before)
+	}
+	if e.After != "" {
+		resp.After = json.RawMessage(e.After)
+	}
+	return resp
+}
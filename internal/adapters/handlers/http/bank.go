@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type BankHandler struct {
+	service *services.BankService
+}
+
+func NewBankHandler(service *services.BankService) *BankHandler {
+	return &BankHandler{service: service}
+}
+
+// @Summary List Banks
+// @Description Lists the banks and mobile money operators for a country, for UI dropdowns.
+// @Tags Banks
+// @Produce json
+// @Param country query string true "Destination country" example(NG)
+// @Param channel query string false "Filter by BANK_ACCOUNT or MOBILE_MONEY"
+// @Success 200 {object} []BankResponse "Institutions ordered by name"
+// @Failure 400 {object} map[string]string "Missing or unsupported country"
+// @Router /banks [get]
+func (h *BankHandler) ListBanks(c echo.Context) error {
+	country := strings.ToUpper(strings.TrimSpace(c.QueryParam("country")))
+	channel := strings.ToUpper(strings.TrimSpace(c.QueryParam("channel")))
+	if country == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "country is required"})
+	}
+
+	banks, err := h.service.ListBanks(country, channel)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnsupportedCorridor) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list banks"})
+	}
+
+	resp := make([]BankResponse, 0, len(banks))
+	for _, b := range banks {
+		resp = append(resp, BankResponse{Code: b.Code, Name: b.Name, Channel: b.Channel})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
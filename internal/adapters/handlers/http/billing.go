@@ -0,0 +1,124 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type BillingHandler struct {
+	pricing *services.PricingEngine
+	billing *services.BillingService
+}
+
+func NewBillingHandler(pricing *services.PricingEngine, billing *services.BillingService) *BillingHandler {
+	return &BillingHandler{pricing: pricing, billing: billing}
+}
+
+// @Summary Get Fee Schedule
+// @Description The rules Waya prices each payout with. A payout uses the most specific rule: country and currency, then currency, then "*".
+// @Tags Billing
+// @Produce json
+// @Success 200 {object} []FeeRuleResponse "Fee rules"
+// @Router /fees [get]
+func (h *BillingHandler) GetFeeSchedule(c echo.Context) error {
+	schedule := h.pricing.Schedule(tenantID(c))
+	resp := make([]FeeRuleResponse, 0, len(schedule.Rules))
+	for _, r := range schedule.Rules {
+		rule := FeeRuleResponse{Country: r.Country, Currency: r.Currency, Percent: r.Percent}
+		if r.Currency != domain.FeeAnyCurrency {
+			rule.Flat, rule.Min, rule.Max = r.Flat.String(), r.Min.String(), r.Max.String()
+		}
+		resp = append(resp, rule)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get Invoice
+// @Description Fees charged on payouts that settled in a calendar month (UTC), per corridor and per payout. Add format=csv for a spreadsheet.
+// @Tags Billing
+// @Produce json
+// @Produce text/csv
+// @Param month path string true "Billing month, e.g. 2026-10"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} InvoiceResponse "Invoice"
+// @Failure 400 {object} ValidationErrorResponse "Invalid month"
+// @Router /invoices/{month} [get]
+func (h *BillingHandler) GetInvoice(c echo.Context) error {
+	inv, err := h.billing.Invoice(c.Request().Context(), tenantID(c), c.Param("month"))
+	if err != nil {
+		return ledgerError(c, err)
+	}
+
+	if strings.EqualFold(c.QueryParam("format"), "csv") {
+		body, err := invoiceCSV(inv)
+		if err != nil {
+			return ledgerError(c, err)
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", inv.Number+".csv"))
+		return c.Blob(http.StatusOK, "text/csv; charset=utf-8", body)
+	}
+
+	resp := InvoiceResponse{
+		Number:      inv.Number,
+		TenantID:    inv.TenantID,
+		PeriodStart: inv.PeriodStart,
+		PeriodEnd:   inv.PeriodEnd,
+		IssuedAt:    inv.IssuedAt,
+		Lines:       make([]InvoiceLineResponse, 0, len(inv.Lines)),
+		Totals:      make([]InvoiceTotalResponse, 0, len(inv.Totals)),
+		Charges:     make([]FeeChargeResponse, 0, len(inv.Charges)),
+	}
+	for _, l := range inv.Lines {
+		resp.Lines = append(resp.Lines, InvoiceLineResponse{
+			Country:  l.Country,
+			Currency: l.Currency,
+			Payouts:  l.Payouts,
+			Volume:   l.Volume.String(),
+			Fees:     l.Fees.String(),
+		})
+	}
+	for _, t := range inv.Totals {
+		resp.Totals = append(resp.Totals, InvoiceTotalResponse{Currency: t.Currency, Amount: t.String()})
+	}
+	for _, ch := range inv.Charges {
+		resp.Charges = append(resp.Charges, FeeChargeResponse{
+			PayoutID:  ch.PayoutID,
+			BatchID:   ch.BatchID,
+			Country:   ch.Country,
+			Currency:  ch.Fee.Currency,
+			Amount:    ch.Amount.String(),
+			Fee:       ch.Fee.String(),
+			ChargedAt: ch.ChargedAt,
+		})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// invoiceCSV lists one row per charged payout, then the totals per currency.
+func invoiceCSV(inv *domain.Invoice) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"invoice", "charged_at", "payout_id", "batch_id", "country", "currency", "amount", "fee"})
+	for _, ch := range inv.Charges {
+		w.Write([]string{inv.Number, ch.ChargedAt.UTC().Format(time.RFC3339), ch.PayoutID, ch.BatchID, ch.Country,
+			ch.Fee.Currency, ch.Amount.String(), ch.Fee.String()})
+	}
+	for _, l := range inv.Lines {
+		w.Write([]string{inv.Number, "", "SUBTOTAL", strconv.Itoa(l.Payouts) + " payouts", l.Country, l.Currency, l.Volume.String(), l.Fees.String()})
+	}
+	for _, t := range inv.Totals {
+		w.Write([]string{inv.Number, "", "TOTAL", "", "", t.Currency, "", t.String()})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
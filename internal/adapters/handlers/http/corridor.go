@@ -0,0 +1,106 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type CorridorHandler struct {
+	service *services.CorridorService
+}
+
+func NewCorridorHandler(service *services.CorridorService) *CorridorHandler {
+	return &CorridorHandler{service: service}
+}
+
+// @Summary List Corridors
+// @Description Lists every destination country with its currencies, channels, amount limits and required fields.
+// @Tags Corridors
+// @Produce json
+// @Success 200 {object} []CorridorResponse "Supported corridors"
+// @Router /corridors [get]
+func (h *CorridorHandler) ListCorridors(c echo.Context) error {
+	corridors := h.service.ListCorridors()
+
+	resp := make([]CorridorResponse, 0, len(corridors))
+	for _, cor := range corridors {
+		item := CorridorResponse{
+			Country:        cor.Country,
+			Name:           cor.Name,
+			RequiredFields: cor.RequiredFields,
+		}
+		for _, cur := range cor.Currencies {
+			max := ""
+			if cur.MaxAmount.IsPositive() {
+				max = cur.MaxAmount.String()
+			}
+			item.Currencies = append(item.Currencies, CorridorCurrencyResponse{
+				Code:      cur.Code,
+				MinAmount: cur.MinAmount.String(),
+				MaxAmount: max,
+			})
+		}
+		for _, ch := range cor.Channels {
+			item.Channels = append(item.Channels, CorridorChannelResponse{
+				Type:           ch.Type,
+				RequiredFields: ch.RequiredFields,
+			})
+		}
+		resp = append(resp, item)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get Quote
+// @Description Prices a payout into a corridor at the current Afriex rate, with Waya's fee from the tenant's schedule.
+// @Tags Corridors
+// @Produce json
+// @Param country query string true "Destination country" example(NG)
+// @Param currency query string true "Destination currency" example(NGN)
+// @Param amount query string true "Destination amount as a decimal string" example(5000.00)
+// @Param channel query string false "BANK_ACCOUNT (default) or MOBILE_MONEY"
+// @Success 200 {object} QuoteResponse "Indicative quote"
+// @Failure 400 {object} ValidationErrorResponse "Amount or corridor not supported"
+// @Failure 502 {object} map[string]string "Rate provider unavailable"
+// @Router /quotes [get]
+func (h *CorridorHandler) GetQuote(c echo.Context) error {
+	country := strings.ToUpper(strings.TrimSpace(c.QueryParam("country")))
+	currency := strings.ToUpper(strings.TrimSpace(c.QueryParam("currency")))
+	channel := strings.ToUpper(strings.TrimSpace(c.QueryParam("channel")))
+
+	amount, err := domain.ParseMoney(c.QueryParam("amount"), currency)
+	if err != nil {
+		var errs domain.ValidationErrors
+		errs.Add("amount", "%v", err)
+		return validationFailed(c, errs)
+	}
+
+	quote, err := h.service.Quote(c.Request().Context(), tenantID(c), country, channel, amount)
+	if err != nil {
+		var verrs domain.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationFailed(c, verrs)
+		}
+		slog.Error("Failed to quote payout", "country", country, "err", err)
+		return c.JSON(http.StatusBadGateway, map[string]string{"error": "Failed to fetch rate"})
+	}
+
+	return c.JSON(http.StatusOK, QuoteResponse{
+		Country:             quote.Country,
+		DestinationAmount:   quote.Destination.String(),
+		DestinationCurrency: quote.Destination.Currency,
+		SourceAmount:        quote.Source.String(),
+		SourceCurrency:      quote.Source.Currency,
+		Rate:                quote.Rate,
+		Fee:                 quote.Fee.String(),
+		TotalDebit:          domain.NewMoney(quote.Destination.Amount+quote.Fee.Amount, quote.Fee.Currency).String(),
+	})
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type LedgerHandler struct {
+	service *services.LedgerService
+	tenants *services.TenantService
+}
+
+func NewLedgerHandler(service *services.LedgerService, tenants *services.TenantService) *LedgerHandler {
+	return &LedgerHandler{service: service, tenants: tenants}
+}
+
+// @Summary Record Funding
+// @Description Credits a tenant's prefunding top-up to its available balance. The reference is the external ID of the incoming transfer and can only be used once. Operator API key only.
+// @Tags Ledger
+// @Accept json
+// @Produce json
+// @Param request body FundingRequest true "Incoming funds"
+// @Success 201 {object} FundingResponse "Funds credited"
+// @Failure 400 {object} ValidationErrorResponse "Missing reference or invalid amount"
+// @Failure 403 {object} map[string]string "Not the operator's API key"
+// @Failure 404 {object} map[string]string "Tenant not found"
+// @Failure 409 {object} map[string]string "Reference already recorded"
+// @Router /funding [post]
+func (h *LedgerHandler) RecordFunding(c echo.Context) error {
+	var req FundingRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	amount, err := domain.ParseMoney(req.Amount, currency)
+	if err != nil {
+		var errs domain.ValidationErrors
+		errs.Add("amount", "%v", err)
+		return validationFailed(c, errs)
+	}
+
+	ctx := c.Request().Context()
+	tenant := strings.TrimSpace(req.TenantID)
+	if tenant == "" {
+		tenant = tenantID(c)
+	}
+	if _, err := h.tenants.Get(ctx, tenant); err != nil {
+		return ledgerError(c, err)
+	}
+	entry, err := h.service.Fund(ctx, tenant, req.Reference, amount, req.Description)
+	if err != nil {
+		return ledgerError(c, err)
+	}
+
+	resp := FundingResponse{
+		EntryID:   entry.ID,
+		TenantID:  tenant,
+		Reference: entry.Reference,
+		Amount:    amount.String(),
+		Currency:  currency,
+		CreatedAt: entry.CreatedAt,
+	}
+	if balances, err := h.service.Balances(ctx, tenant); err == nil {
+		for _, b := range balances {
+			if b.Currency == currency {
+				resp.Available = b.Available.String()
+			}
+		}
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// @Summary Get Balance
+// @Description Available, reserved and settled funds and fees charged per currency, summed from the ledger.
+// @Tags Ledger
+// @Produce json
+// @Param currency query string false "Only this currency"
+// @Success 200 {object} []BalanceResponse "Balances"
+// @Router /balance [get]
+func (h *LedgerHandler) GetBalance(c echo.Context) error {
+	balances, err := h.service.Balances(c.Request().Context(), tenantID(c))
+	if err != nil {
+		return ledgerError(c, err)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(c.QueryParam("currency")))
+	resp := make([]BalanceResponse, 0, len(balances))
+	for _, b := range balances {
+		if currency != "" && b.Currency != currency {
+			continue
+		}
+		resp = append(resp, toBalanceResponse(b))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Balance Statement
+// @Description Lists every ledger entry in one currency between two dates, with opening and closing balances. Dates are YYYY-MM-DD (to is inclusive) or RFC 3339 timestamps; they default to the current month.
+// @Tags Ledger
+// @Produce json
+// @Param currency query string true "Currency, e.g. NGN"
+// @Param from query string false "Start date, e.g. 2026-10-01"
+// @Param to query string false "End date, e.g. 2026-10-31"
+// @Success 200 {object} StatementResponse "Statement"
+// @Failure 400 {object} ValidationErrorResponse "Invalid currency or dates"
+// @Router /balance/statement [get]
+func (h *LedgerHandler) GetStatement(c echo.Context) error {
+	from, to := domain.MonthWindow(time.Now())
+	var errs domain.ValidationErrors
+	if raw := c.QueryParam("from"); raw != "" {
+		t, err := parseStatementTime(raw, false)
+		if err != nil {
+			errs.Add("from", "must be YYYY-MM-DD or an RFC 3339 timestamp")
+		}
+		from = t
+	}
+	if raw := c.QueryParam("to"); raw != "" {
+		t, err := parseStatementTime(raw, true)
+		if err != nil {
+			errs.Add("to", "must be YYYY-MM-DD or an RFC 3339 timestamp")
+		}
+		to = t
+	}
+	if len(errs) > 0 {
+		return validationFailed(c, errs)
+	}
+
+	st, err := h.service.Statement(c.Request().Context(), tenantID(c), c.QueryParam("currency"), from, to)
+	if err != nil {
+		return ledgerError(c, err)
+	}
+
+	resp := StatementResponse{
+		Currency: st.Currency,
+		From:     st.From,
+		To:       st.To,
+		Opening:  toBalanceResponse(st.Opening),
+		Closing:  toBalanceResponse(st.Closing),
+		Entries:  make([]StatementLineResponse, 0, len(st.Lines)),
+	}
+	for _, l := range st.Lines {
+		resp.Entries = append(resp.Entries, StatementLineResponse{
+			EntryID:     l.EntryID,
+			Kind:        l.Kind,
+			Reference:   l.Reference,
+			Description: l.Description,
+			CreatedAt:   l.CreatedAt,
+			Available:   l.Available.String(),
+			Reserved:    l.Reserved.String(),
+			Settled:     l.Settled.String(),
+			Fees:        l.Fees.String(),
+		})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// parseStatementTime accepts a date or an RFC 3339 timestamp. A date used as
+// the end of a range covers that whole day.
+func parseStatementTime(raw string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+func toBalanceResponse(b domain.Balance) BalanceResponse {
+	return BalanceResponse{
+		Currency:  b.Currency,
+		Available: b.Available.String(),
+		Reserved:  b.Reserved.String(),
+		Settled:   b.Settled.String(),
+		Funded:    b.Funded.String(),
+		Fees:      b.Fees.String(),
+	}
+}
+
+func ledgerError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	if errors.As(err, &verrs) {
+		return validationFailed(c, verrs)
+	}
+	if errors.Is(err, domain.ErrTenantNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	if errors.Is(err, domain.ErrDuplicateEntry) {
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	}
+	slog.Error("Ledger request failed", "err", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Ledger request failed"})
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type LimitHandler struct {
+	engine *services.LimitEngine
+}
+
+func NewLimitHandler(engine *services.LimitEngine) *LimitHandler {
+	return &LimitHandler{engine: engine}
+}
+
+// @Summary Limit Usage
+// @Description Shows how much of each payout limit is used in its current window. Pass bank_code and account_number to include that recipient's daily usage.
+// @Tags Limits
+// @Produce json
+// @Param tenant_id query string false "Tenant whose usage to show (operator only)"
+// @Param country query string false "Corridor country, e.g. NG (all when empty)"
+// @Param currency query string false "Corridor currency, e.g. NGN (all when empty)"
+// @Param bank_code query string false "Recipient bank code"
+// @Param account_number query string false "Recipient account number"
+// @Success 200 {object} []LimitUsageResponse "Usage per limit"
+// @Failure 400 {object} ValidationErrorResponse "Incomplete recipient"
+// @Failure 403 {object} map[string]string "Another tenant's usage"
+// @Router /limits/usage [get]
+func (h *LimitHandler) GetUsage(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	usage, err := h.engine.Usage(c.Request().Context(), services.UsageQuery{
+		TenantID:      tenant,
+		Country:       c.QueryParam("country"),
+		Currency:      c.QueryParam("currency"),
+		BankCode:      c.QueryParam("bank_code"),
+		AccountNumber: c.QueryParam("account_number"),
+	})
+	if err != nil {
+		var verrs domain.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationFailed(c, verrs)
+		}
+		slog.Error("Failed to read limit usage", "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to read limit usage"})
+	}
+
+	resp := make([]LimitUsageResponse, 0, len(usage))
+	for _, u := range usage {
+		item := LimitUsageResponse{
+			Kind:      u.Kind,
+			Scope:     u.Scope,
+			Currency:  u.Limit.Currency,
+			Limit:     u.Limit.String(),
+			Used:      u.Used.String(),
+			Remaining: u.Remaining.String(),
+		}
+		if !u.WindowStart.IsZero() {
+			start, end := u.WindowStart, u.WindowEnd
+			item.WindowStart, item.WindowEnd = &start, &end
+		}
+		resp = append(resp, item)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func toLimitViolationResponses(violations []domain.LimitViolation, payouts []domain.Payout) []LimitViolationResponse {
+	index := make(map[string]int, len(payouts))
+	for i, p := range payouts {
+		index[p.ID] = i
+	}
+	resp := make([]LimitViolationResponse, 0, len(violations))
+	for _, v := range violations {
+		resp = append(resp, LimitViolationResponse{
+			Item:      index[v.PayoutID],
+			Kind:      v.Kind,
+			Scope:     v.Scope,
+			Currency:  v.Limit.Currency,
+			Limit:     v.Limit.String(),
+			Used:      v.Used.String(),
+			Requested: v.Requested.String(),
+		})
+	}
+	return resp
+}
@@ -1,18 +1,84 @@
 package middlewares
 
 import (
+	"context"
+	"errors"
+	"log/slog"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
 )
 
-func APIKeyAuth(next echo.HandlerFunc, apiKey string) echo.HandlerFunc {
+// PrincipalKey is where the auth middlewares store the caller's *domain.Principal
+const PrincipalKey = "principal"
+
+// APIKeyAuth resolves the x-api-key header to the key and tenant it belongs
+// to. Everything the request reads or writes is scoped to that tenant.
+// Requests SignatureAuth already authenticated pass straight through.
+func APIKeyAuth(next echo.HandlerFunc, authenticate func(ctx context.Context, key string) (*domain.Principal, error)) echo.HandlerFunc {
 	return func(c echo.Context) error {
+		if CurrentPrincipal(c) != nil {
+			return next(c)
+		}
 		key := c.Request().Header.Get("x-api-key")
 
-		if key == "" || key != apiKey {
+		principal, err := authenticate(c.Request().Context(), key)
+		switch {
+		case errors.Is(err, domain.ErrAPIKeyNotFound):
 			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized: Invalid or missing x-api-key"})
+		case errors.Is(err, domain.ErrAPIKeyRevoked), errors.Is(err, domain.ErrAPIKeyExpired), errors.Is(err, domain.ErrSignatureRequired):
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized: " + err.Error()})
+		case err != nil:
+			slog.Error("Failed to authenticate API key", "err", err)
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Could not check API key"})
+		}
+		c.Set(PrincipalKey, principal)
+		return next(c)
+	}
+}
+
+// Require refuses callers without the permission: keys lacking the scope
+// that grants it, and users whose role does not include it.
+func Require(perm string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p := CurrentPrincipal(c)
+			switch {
+			case p == nil:
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden"})
+			case p.User != nil && !p.Allows(perm):
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden: role " + p.User.Role + " lacks permission " + perm})
+			case !p.Allows(perm):
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden: API key lacks the scope for " + perm})
+			}
+			return next(c)
+		}
+	}
+}
+
+// OperatorOnly refuses platform-wide routes (funding, reversals,
+// reconciliation) to everyone but the operator's admin keys and users.
+func OperatorOnly(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if p := CurrentPrincipal(c); p == nil || !p.Tenant.IsOperator() || !p.Allows(domain.PermAdmin) {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden: operator admin required"})
 		}
 		return next(c)
 	}
-}
\ No newline at end of file
+}
+
+// CurrentPrincipal returns who the request was authenticated as, or nil
+func CurrentPrincipal(c echo.Context) *domain.Principal {
+	p, _ := c.Get(PrincipalKey).(*domain.Principal)
+	return p
+}
+
+// CurrentTenant returns the tenant the request was authenticated for, or nil
+func CurrentTenant(c echo.Context) *domain.Tenant {
+	if p := CurrentPrincipal(c); p != nil {
+		return &p.Tenant
+	}
+	return nil
+}
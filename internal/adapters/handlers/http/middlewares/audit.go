@@ -0,0 +1,25 @@
+package middlewares
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+)
+
+// AuditSource puts who is calling, from which IP and under which request ID
+// into the request's context, where the audit log finds it. It runs after
+// authentication and echo's RequestID middleware.
+func AuditSource(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		src := domain.AuditSource{
+			RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+			IP:        c.RealIP(),
+		}
+		if p := CurrentPrincipal(c); p != nil {
+			src.Principal = p.Actor()
+		}
+		r := c.Request()
+		c.SetRequest(r.WithContext(domain.WithAuditSource(r.Context(), src)))
+		return next(c)
+	}
+}
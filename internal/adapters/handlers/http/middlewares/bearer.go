@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+)
+
+// BearerAuth authenticates signed-in users by the access token in
+// "Authorization: Bearer <token>". Requests without one go on to next,
+// normally APIKeyAuth.
+func BearerAuth(next echo.HandlerFunc, authenticate func(ctx context.Context, accessToken string) (*domain.Principal, error)) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if CurrentPrincipal(c) != nil {
+			return next(c)
+		}
+		scheme, token, ok := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			return next(c)
+		}
+
+		principal, err := authenticate(c.Request().Context(), strings.TrimSpace(token))
+		switch {
+		case errors.Is(err, domain.ErrAccessTokenInvalid), errors.Is(err, domain.ErrUserDisabled), errors.Is(err, domain.ErrTenantNotFound):
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized: " + err.Error()})
+		case err != nil:
+			slog.Error("Failed to authenticate access token", "err", err)
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Could not check access token"})
+		}
+		c.Set(PrincipalKey, principal)
+		return next(c)
+	}
+}
@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+)
+
+// RateLimit meters each API key's, or signed-in user's, requests per route;
+// it runs after authentication. Responses carry X-RateLimit-Limit (the burst),
+// X-RateLimit-Remaining and X-RateLimit-Reset (seconds until the bucket is
+// full again), and refused requests get 429 with Retry-After.
+func RateLimit(allow func(ctx context.Context, p *domain.Principal, route string) (domain.RateDecision, error)) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p := CurrentPrincipal(c)
+			if p == nil {
+				return next(c)
+			}
+			d, err := allow(c.Request().Context(), p, c.Request().Method+" "+c.Path())
+			if err != nil {
+				// Losing the limits must not take the API down with them
+				slog.Error("Failed to check rate limit", "subject", p.Subject(), "err", err)
+				return next(c)
+			}
+			if d.Limit.Burst > 0 {
+				h := c.Response().Header()
+				h.Set("X-RateLimit-Limit", strconv.Itoa(d.Limit.Burst))
+				h.Set("X-RateLimit-Remaining", strconv.Itoa(d.Remaining))
+				h.Set("X-RateLimit-Reset", Seconds(d.Reset))
+			}
+			if !d.Allowed {
+				c.Response().Header().Set("Retry-After", Seconds(d.RetryAfter))
+				return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "Too many requests: rate limit exceeded"})
+			}
+			return next(c)
+		}
+	}
+}
+
+// Seconds renders a wait for Retry-After and X-RateLimit-Reset, rounded up
+// so clients never retry early.
+func Seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -2,14 +2,18 @@ package http
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
-
-	// "time"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 
+	"waya/internal/adapters/handlers/http/middlewares"
 	"waya/internal/core/domain"
 	"waya/internal/core/services"
 )
@@ -29,7 +33,11 @@ func NewPayoutHandler(service *services.PayoutService) *PayoutHandler {
 // @Produce json
 // @Param request body BulkPayoutRequest true "The batch of payouts to process"
 // @Success 202 {object} BulkPayoutResponse "Batch accepted for background processing"
-// @Failure 400 {object} map[string]string "Invalid JSON or payload"
+// @Failure 400 {object} ValidationErrorResponse "Invalid JSON or payload"
+// @Failure 409 {object} DuplicateErrorResponse "Blocked: likely duplicates of recent payouts"
+// @Failure 422 {object} LimitErrorResponse "Over a per-payout, daily recipient or monthly corridor limit"
+// @Failure 402 {object} InsufficientFundsResponse "Batch exceeds the available balance"
+// @Failure 429 {object} QuotaErrorResponse "Over the tenant's or API key's daily payout quota, or the rate limit"
 // @Router /payouts [post]
 func (h *PayoutHandler) HandleBulkPayout(c echo.Context) error {
 	var req BulkPayoutRequest
@@ -42,7 +50,24 @@ func (h *PayoutHandler) HandleBulkPayout(c echo.Context) error {
 
 	// 2. Map DTO -> Domain Entities
 	var domainPayouts []domain.Payout
-	for _, item := range req.Items {
+	var invalid domain.ValidationErrors
+	for i, item := range req.Items {
+		currency := strings.ToUpper(strings.TrimSpace(item.Currency))
+		amount, err := domain.ParseMoney(string(item.Amount), currency)
+		if err != nil {
+			invalid.Add(fmt.Sprintf("items[%d].amount", i), "%v", err)
+			continue
+		}
+		if !amount.IsPositive() {
+			invalid.Add(fmt.Sprintf("items[%d].amount", i), "must be greater than zero")
+			continue
+		}
+
+		channel := strings.ToUpper(strings.TrimSpace(item.Channel))
+		if channel == "" {
+			channel = domain.ChannelBankAccount
+		}
+
 		domainPayouts = append(domainPayouts, domain.Payout{
 			ID:             uuid.New().String(), // We generate our own ID
 			BatchID:        batchID,
@@ -52,34 +77,145 @@ func (h *PayoutHandler) HandleBulkPayout(c echo.Context) error {
 			RecipientName:  item.RecipientName,
 			RecipientPhone: item.RecipientPhone,
 			RecipientEmail: item.RecipientEmail,
-			CountryCode:    item.CountryCode,
+			CountryCode:    strings.ToUpper(strings.TrimSpace(item.CountryCode)),
 			
 			// Bank Data
 			BankCode:       item.BankCode,
 			AccountNumber:  item.AccountNumber,
+			Channel:        channel,
 			
-			// Money (Convert Float to Cents/Kobo)
-			Amount:   int64(item.Amount * 100), 
-			Currency: item.Currency,
+			// Money (exact minor units, e.g. kobo)
+			Amount:   amount.Amount,
+			Currency: amount.Currency,
 		})
 	}
 
-	// 3. Call the Orchestrator (Async)
+	if len(invalid) > 0 {
+		return validationFailed(c, invalid)
+	}
+	if len(domainPayouts) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Batch has no items"})
+	}
+
+	// 3. Reject the whole batch up front if any row breaks corridor rules
+	if err := h.service.ValidateBatch(c.Request().Context(), domainPayouts); err != nil {
+		var verrs domain.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationFailed(c, verrs)
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to validate batch"})
+	}
+
+	// 4. Persist the batch; the approval policy decides whether it can run now
+	batch, err := h.service.SubmitBatch(c.Request().Context(), domain.Batch{
+		ID:          batchID,
+		TenantID:    tenantID(c),
+		Reference:   req.BatchReference,
+		SubmittedBy: actor(c),
+		APIKeyID:    apiKeyID(c),
+		Payouts:     domainPayouts,
+	})
+	if err != nil {
+		var verrs domain.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationFailed(c, verrs)
+		}
+		var dupErr *domain.DuplicateError
+		if errors.As(err, &dupErr) {
+			return c.JSON(http.StatusConflict, DuplicateErrorResponse{
+				Error:      err.Error(),
+				Duplicates: toDuplicateResponses(dupErr.Matches, domainPayouts),
+			})
+		}
+		var limitErr *domain.LimitError
+		if errors.As(err, &limitErr) {
+			return c.JSON(http.StatusUnprocessableEntity, LimitErrorResponse{
+				Error:      err.Error(),
+				Violations: toLimitViolationResponses(limitErr.Violations, domainPayouts),
+			})
+		}
+		var fundsErr *domain.InsufficientFundsError
+		if errors.As(err, &fundsErr) {
+			return c.JSON(http.StatusPaymentRequired, toInsufficientFundsResponse(fundsErr))
+		}
+		var quotaErr *domain.QuotaError
+		if errors.As(err, &quotaErr) {
+			return quotaExceeded(c, quotaErr)
+		}
+		slog.Error("Failed to save batch", "batch_id", batchID, "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to save batch"})
+	}
+	if batch.ApprovalStatus == domain.ApprovalPending {
+		return c.JSON(http.StatusAccepted, BulkPayoutResponse{
+			BatchID:        batchID,
+			Status:         domain.BatchAwaitingApproval,
+			Message:        "Batch saved. A second approver must call POST /payouts/" + batchID + "/approve before it is sent",
+			ApprovalReason: batch.ApprovalReason,
+			Duplicates:     toDuplicateResponses(batch.Duplicates, batch.Payouts),
+		})
+	}
+
+	// 5. Call the Orchestrator (Async)
 	// We use a goroutine here so the HTTP request returns immediately (202 Accepted)
 	// while the heavy lifting happens in the background.
 	go func() {
 		// Create a background context since the request context will cancel when we return
 		ctx := context.Background() 
-		_ = h.service.ExecuteBatch(ctx, batchID, domainPayouts)
+		_ = h.service.ExecuteBatch(ctx, batch.TenantID, batchID, batch.Payouts)
 	}()
 
 	return c.JSON(http.StatusAccepted, BulkPayoutResponse{
-		BatchID: batchID,
-		Status:  "PROCESSING",
-		Message: "Batch accepted. Check status via /payouts/status/" + batchID,
+		BatchID:    batchID,
+		Status:     "PROCESSING",
+		Message:    "Batch accepted. Check status via /payouts/status/" + batchID,
+		Duplicates: toDuplicateResponses(batch.Duplicates, batch.Payouts),
 	})
 }
 
+// quotaExceeded answers 429, retrying once the next day's quota starts
+func quotaExceeded(c echo.Context, err *domain.QuotaError) error {
+	c.Response().Header().Set("Retry-After", middlewares.Seconds(time.Until(err.ResetAt)))
+	return c.JSON(http.StatusTooManyRequests, QuotaErrorResponse{
+		Error:     err.Error(),
+		Scope:     err.Scope,
+		Quota:     err.Quota,
+		Used:      err.Used,
+		Requested: err.Requested,
+		ResetsAt:  err.ResetAt,
+	})
+}
+
+func toInsufficientFundsResponse(err *domain.InsufficientFundsError) InsufficientFundsResponse {
+	resp := InsufficientFundsResponse{Error: err.Error()}
+	for _, sf := range err.Shortfalls {
+		resp.Shortfalls = append(resp.Shortfalls, ShortfallResponse{
+			Currency:  sf.Currency,
+			Available: sf.Available.String(),
+			Required:  sf.Required.String(),
+		})
+	}
+	return resp
+}
+
+// toDuplicateResponses points each match back at the request item it came from.
+func toDuplicateResponses(matches []domain.DuplicateMatch, payouts []domain.Payout) []DuplicateResponse {
+	item := make(map[string]int, len(payouts))
+	for i, p := range payouts {
+		item[p.ID] = i
+	}
+	out := make([]DuplicateResponse, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, DuplicateResponse{
+			Item:            item[m.PayoutID],
+			PayoutID:        m.PayoutID,
+			MatchedPayoutID: m.MatchedPayoutID,
+			MatchedBatchID:  m.MatchedBatchID,
+			MatchedAt:       m.MatchedAt,
+		})
+	}
+	return out
+}
+
 // @Summary Get Batch Status
 // @Description Retrieves all payouts and status for a given batch ID.
 // @Tags Payouts
@@ -92,80 +228,219 @@ func (h *PayoutHandler) HandleBulkPayout(c echo.Context) error {
 func (h *PayoutHandler) GetBatchStatus(c echo.Context) error {
 	batchID := c.Param("batch_id")
 
-	// This is a simplified fetch for a hackathon.
-	// In production, we'd query a dedicated Batch table.
-	// For now, we query all payouts and filter by BatchID.
-	
 	ctx := c.Request().Context()
-	
-	// Since we don't have a ListByBatchID, we'll implement a mock-up/quick query
-	// by fetching all and filtering in the service (not ideal, but fast for now)
-	payouts, err := h.service.ListPayoutsByBatchID(ctx, batchID)
-	
+	batch, err := h.service.GetBatch(ctx, tenantID(c), batchID)
 	if err != nil {
-		if err.Error() == "not found" {
+		if errors.Is(err, domain.ErrBatchNotFound) {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "Batch ID not found"})
 		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to retrieve batch status"})
 	}
 
-	// Create a mock Batch response
-	totalAmount := int64(0)
-	for _, p := range payouts {
-		totalAmount += p.Amount
+	// Status is AWAITING_APPROVAL / AWAITING_REVIEW while a human still has to act
+	return c.JSON(http.StatusOK, batch)
+}
+
+// @Summary Get Batch Costs
+// @Description What Afriex actually debited for the batch's paid payouts, per destination currency and in total, fees included.
+// @Tags Payouts
+// @Produce json
+// @Param batch_id path string true "Unique ID of the payout batch"
+// @Success 200 {object} BatchCostResponse "Cost summary"
+// @Failure 404 {object} map[string]string "Batch ID not found"
+// @Router /payouts/{batch_id}/costs [get]
+func (h *PayoutHandler) GetBatchCosts(c echo.Context) error {
+	batch, err := h.service.GetBatch(c.Request().Context(), tenantID(c), c.Param("batch_id"))
+	if err != nil {
+		if errors.Is(err, domain.ErrBatchNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Batch ID not found"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to retrieve batch costs"})
 	}
 
-	response := domain.Batch{
-		ID: batchID,
-		TotalAmount: totalAmount,
-		TotalCount: len(payouts),
-		Status: "COMPLETED", // Simplified status for demo
-		Payouts: payouts,
+	source, fees := domain.NewMoney(0, services.SourceCurrency), domain.NewMoney(0, services.SourceCurrency)
+	resp := BatchCostResponse{
+		BatchID:        batch.ID,
+		SourceCurrency: services.SourceCurrency,
+		Currencies:     make([]CurrencyCostResponse, 0, len(batch.Costs)),
+	}
+	counted := 0
+	for _, cs := range batch.Costs {
+		counted += cs.Payouts
+		source.Amount += cs.Source.Amount
+		fees.Amount += cs.Fees.Amount
+		resp.Currencies = append(resp.Currencies, CurrencyCostResponse{
+			Currency:      cs.DestinationCurrency,
+			Payouts:       cs.Payouts,
+			Delivered:     cs.Delivered.String(),
+			Source:        cs.Source.String(),
+			Fees:          cs.Fees.String(),
+			TotalCost:     cs.TotalCost.String(),
+			EffectiveRate: cs.EffectiveRate,
+			AllInRate:     cs.AllInRate,
+		})
 	}
+	resp.TotalSource = source.String()
+	resp.TotalFees = fees.String()
+	resp.TotalCost = domain.NewMoney(source.Amount+fees.Amount, services.SourceCurrency).String()
+	resp.Pending = batch.TotalCount - counted
+	return c.JSON(http.StatusOK, resp)
+}
 
-	return c.JSON(http.StatusOK, response)
+// @Summary Approve Batch
+// @Description Maker-checker: a different, authorized caller releases a batch waiting for approval. The checker is the signed-in user or API key making the request and must not be the one that submitted the batch. Execution starts immediately.
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param batch_id path string true "Unique ID of the payout batch"
+// @Param request body BatchDecisionRequest false "Optional comment"
+// @Success 200 {object} domain.Batch "Approved batch, now processing"
+// @Failure 400 {object} ValidationErrorResponse "Invalid request"
+// @Failure 403 {object} map[string]string "Submitter cannot approve, or user is not an approver"
+// @Failure 404 {object} map[string]string "Batch ID not found"
+// @Failure 409 {object} map[string]string "Batch is not awaiting approval"
+// @Router /payouts/{batch_id}/approve [post]
+func (h *PayoutHandler) ApproveBatch(c echo.Context) error {
+	var req BatchDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	batch, err := h.service.ApproveBatch(c.Request().Context(), tenantID(c), c.Param("batch_id"), actor(c), apiKeyID(c), strings.TrimSpace(req.Comment))
+	if err != nil {
+		return batchDecisionError(c, err)
+	}
+	return c.JSON(http.StatusOK, batch)
+}
+
+// @Summary Reject Batch
+// @Description Cancels a batch waiting for approval. None of its payouts are sent; they end as REJECTED.
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param batch_id path string true "Unique ID of the payout batch"
+// @Param request body BatchDecisionRequest true "Who rejects it and why"
+// @Success 200 {object} domain.Batch "Rejected batch"
+// @Failure 400 {object} ValidationErrorResponse "Missing comment"
+// @Failure 403 {object} map[string]string "User is not an approver"
+// @Failure 404 {object} map[string]string "Batch ID not found"
+// @Failure 409 {object} map[string]string "Batch is not awaiting approval"
+// @Router /payouts/{batch_id}/reject [post]
+func (h *PayoutHandler) RejectBatch(c echo.Context) error {
+	var req BatchDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	batch, err := h.service.RejectBatch(c.Request().Context(), tenantID(c), c.Param("batch_id"), actor(c), strings.TrimSpace(req.Comment))
+	if err != nil {
+		return batchDecisionError(c, err)
+	}
+	return c.JSON(http.StatusOK, batch)
+}
+
+func batchDecisionError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		return validationFailed(c, verrs)
+	case errors.Is(err, domain.ErrBatchNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Batch ID not found"})
+	case errors.Is(err, domain.ErrSelfApproval), errors.Is(err, domain.ErrNotApprover):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrBatchNotAwaitingApproval):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		slog.Error("Batch decision failed", "batch_id", c.Param("batch_id"), "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update batch"})
+	}
+}
+
+// @Summary Reverse Payout
+// @Description Marks a SUCCESS payout as REVERSED after the bank returned the funds, e.g. because the account is closed. The tenant is credited back, fee included, and the client receives a payout.reversed event. Reversing an already reversed payout returns it unchanged.
+// @Tags Payouts
+// @Accept json
+// @Produce json
+// @Param id path string true "Payout ID (not the batch ID)"
+// @Param request body ReversePayoutRequest true "Why it was reversed"
+// @Success 200 {object} domain.Payout "Reversed payout"
+// @Failure 400 {object} ValidationErrorResponse "Invalid request"
+// @Failure 404 {object} map[string]string "Payout not found"
+// @Failure 409 {object} map[string]string "Payout is not SUCCESS"
+// @Router /payouts/{id}/reverse [post]
+func (h *PayoutHandler) ReversePayout(c echo.Context) error {
+	var req ReversePayoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	p, err := h.service.ReversePayout(c.Request().Context(), c.Param("id"), domain.Reversal{
+		Source: domain.ReversalSourceAdmin,
+		Reason: strings.TrimSpace(req.Reason),
+		Actor:  actor(c),
+	})
+	if err != nil {
+		return reversalError(c, err)
+	}
+	return c.JSON(http.StatusOK, p)
 }
 
-// HandleAfriexWebhook processes incoming transaction status updates from Afriex
-// @Summary Afriex Webhook Listener
-// @Description Receives real-time transaction updates (e.g., SUCCESS/FAILED) from Afriex.
-// @Tags Webhooks
+// @Summary Re-issue Reversed Payout
+// @Description Sends a REVERSED payout again to corrected bank details, as a new one-payout batch that goes through the usual checks, approval, limits and funding. The new payout's ReissueOf points back at the original. Each reversed payout can be re-issued once.
+// @Tags Payouts
 // @Accept json
 // @Produce json
-// @Param request body map[string]interface{} true "Afriex Webhook Payload"
-// @Success 200 {string} string "OK"
-// @Failure 400 {string} string "Invalid Signature or Payload"
-// @Router /webhooks/afriex [post]
-func (h *PayoutHandler) HandleAfriexWebhook(c echo.Context) error {
-	// 1. **CRITICAL:** SECURITY/Signature Verification (Mocked for Hackathon)
-	// You should verify the 'x-webhook-signature' header here.
-	
-	// 2. Decode Payload
-	var payload map[string]interface{}
-	if err := c.Bind(&payload); err != nil {
-		return c.String(http.StatusBadRequest, "Invalid Payload")
-	}
-
-	// 3. Process Event
-	event := payload["event"].(string)
-	data := payload["data"].(map[string]interface{})
-	
-	if event == "TRANSACTION.UPDATED" {
-		txID := data["transactionId"].(string)
-		status := data["status"].(string) // "SUCCESS", "FAILED"
-		
-		slog.Info("🔔 Webhook received", "tx_id", txID, "new_status", status)
-
-		// 4. Update the DB (This requires an ID lookup, which we can mock)
-		// We can't map Afriex TX ID to Waya Payout ID without a new DB column.
-		// For the demo: just log and show the concept.
-		
-		// In a real app, you would: 
-		// h.service.UpdateStatusByAfriexID(txID, status) 
-	}
-
-	// 5. Respond Immediately (200 OK)
-	return c.String(http.StatusOK, "OK")
+// @Param id path string true "ID of the reversed payout"
+// @Param request body ReissuePayoutRequest true "Corrected destination"
+// @Success 202 {object} domain.Batch "New batch, processing or awaiting approval"
+// @Failure 400 {object} ValidationErrorResponse "Corrected details are invalid"
+// @Failure 404 {object} map[string]string "Payout not found"
+// @Failure 409 {object} map[string]string "Payout is not REVERSED, was already re-issued or its recipient was erased"
+// @Failure 429 {object} QuotaErrorResponse "Over a daily payout quota"
+// @Router /payouts/{id}/reissue [post]
+func (h *PayoutHandler) ReissuePayout(c echo.Context) error {
+	var req ReissuePayoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	batch, err := h.service.ReissuePayout(c.Request().Context(), tenantID(c), c.Param("id"), domain.Reissue{
+		BankCode:      req.BankCode,
+		AccountNumber: req.AccountNumber,
+		Channel:       req.Channel,
+		RecipientName: req.RecipientName,
+		RequestedBy:   actor(c),
+		APIKeyID:      apiKeyID(c),
+	})
+	var limitErr *domain.LimitError
+	var fundsErr *domain.InsufficientFundsError
+	var quotaErr *domain.QuotaError
+	switch {
+	case errors.As(err, &limitErr):
+		return c.JSON(http.StatusUnprocessableEntity, LimitErrorResponse{
+			Error:      err.Error(),
+			Violations: toLimitViolationResponses(limitErr.Violations, nil), // One payout, so every item is 0
+		})
+	case errors.As(err, &fundsErr):
+		return c.JSON(http.StatusPaymentRequired, toInsufficientFundsResponse(fundsErr))
+	case errors.As(err, &quotaErr):
+		return quotaExceeded(c, quotaErr)
+	case err != nil:
+		return reversalError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, batch)
+}
+
+func reversalError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		return validationFailed(c, verrs)
+	case errors.Is(err, domain.ErrPayoutNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Payout not found"})
+	case errors.Is(err, domain.ErrNotReversible), errors.Is(err, domain.ErrNotReissuable), errors.Is(err, domain.ErrAlreadyReissued),
+		errors.Is(err, domain.ErrDuplicatePayouts), errors.Is(err, domain.ErrRecipientErased):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		slog.Error("Payout reversal failed", "id", c.Param("id"), "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to update payout"})
+	}
 }
 
 // @Summary List All Payouts
@@ -181,7 +456,7 @@ func (h *PayoutHandler) HandleListAllPayouts(c echo.Context) error {
     // Hardcode a high limit for the dashboard demo
     limit := 100 
     
-    payouts, err := h.service.ListPayouts(ctx, limit)
+    payouts, err := h.service.ListPayouts(ctx, tenantID(c), limit)
 
     if err != nil {
         slog.Error("Failed to list all payouts", "err", err)
@@ -190,4 +465,60 @@ func (h *PayoutHandler) HandleListAllPayouts(c echo.Context) error {
 
     // Return the list directly
     return c.JSON(http.StatusOK, payouts)
-}
\ No newline at end of file
+}
+
+// @Summary Search Payouts by Recipient
+// @Description The tenant's payouts to an account number or phone, newest first. Recipient details are stored encrypted and found through keyed hashes, so matching is exact: no partial numbers. A phone without its + country code needs country.
+// @Tags Payouts
+// @Produce json
+// @Param account_number query string false "Destination account number"
+// @Param phone query string false "Recipient phone, e.g. +2348012345678"
+// @Param country query string false "Country whose numbering plan reads a local phone, e.g. NG"
+// @Param limit query int false "Maximum number of payouts (max 500)" default(100)
+// @Success 200 {object} []domain.Payout "Matching payouts"
+// @Failure 400 {object} ValidationErrorResponse "No account number or phone, or an unreadable phone"
+// @Router /payouts/search [get]
+func (h *PayoutHandler) SearchPayouts(c echo.Context) error {
+	limit := 100
+	if raw := c.QueryParam("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit must be an integer"})
+		}
+		limit = n
+	}
+	payouts, err := h.service.FindPayoutsByRecipient(c.Request().Context(), tenantID(c), c.QueryParam("account_number"), c.QueryParam("phone"), c.QueryParam("country"), limit)
+	if err != nil {
+		var verrs domain.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationFailed(c, verrs)
+		}
+		slog.Error("Failed to search payouts", "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to search payouts"})
+	}
+	return c.JSON(http.StatusOK, payouts)
+}
+
+// tenantID is the tenant the request's API key belongs to
+func tenantID(c echo.Context) string {
+	if t := middlewares.CurrentTenant(c); t != nil {
+		return t.ID
+	}
+	return domain.DefaultTenant
+}
+
+// apiKeyID is the ID of the request's API key, which quotas are counted
+// against; empty for signed-in users
+func apiKeyID(c echo.Context) string {
+	if p := middlewares.CurrentPrincipal(c); p != nil {
+		return p.Key.ID
+	}
+	return ""
+}
+
+func validationFailed(c echo.Context, errs domain.ValidationErrors) error {
+	return c.JSON(http.StatusBadRequest, ValidationErrorResponse{
+		Error:   "validation failed",
+		Details: errs,
+	})
+}
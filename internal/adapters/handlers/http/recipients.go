@@ -0,0 +1,57 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type RecipientHandler struct {
+	retention *services.RetentionService
+}
+
+func NewRecipientHandler(retention *services.RetentionService) *RecipientHandler {
+	return &RecipientHandler{retention: retention}
+}
+
+// @Summary Erase Recipient Details
+// @Description Honours an erasure request. Recipients have no ID of their own, so name any payout sent to them. Their name, phone, email, tag, account number, resolved account name and review details are blanked on that payout and on every other payout of the tenant to the same account (country, bank and account number) or phone. Payouts not yet finished (pending, held, awaiting approval or processing) keep the details they need and are listed in live_payout_ids; erase again once they finish. Amounts, statuses and references are kept. Erased payouts can no longer be re-issued. Each erasure is written to the audit log. Erasing again returns an empty payout_ids. Admin scope required.
+// @Tags Recipients
+// @Produce json
+// @Param id path string true "ID of a payout to the recipient"
+// @Param tenant_id query string false "Tenant that owns the payout (operator only)"
+// @Success 200 {object} ErasureResponse "Payouts erased"
+// @Failure 403 {object} map[string]string "Not an admin, or another tenant's payout"
+// @Failure 404 {object} map[string]string "Payout not found"
+// @Router /recipients/{id}/pii [delete]
+func (h *RecipientHandler) ErasePII(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	erasure, err := h.retention.EraseRecipient(c.Request().Context(), tenant, c.Param("id"), actor(c))
+	if err != nil {
+		if errors.Is(err, domain.ErrPayoutNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		}
+		slog.Error("Failed to erase recipient details", "payout_id", c.Param("id"), "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to erase recipient details"})
+	}
+	return c.JSON(http.StatusOK, toErasureResponse(*erasure))
+}
+
+func toErasureResponse(e domain.Erasure) ErasureResponse {
+	resp := ErasureResponse{TenantID: e.TenantID, PayoutID: e.PayoutID, PayoutIDs: e.PayoutIDs, LivePayoutIDs: e.LivePayoutIDs}
+	if resp.PayoutIDs == nil {
+		resp.PayoutIDs = []string{}
+	}
+	if !e.ErasedAt.IsZero() {
+		resp.ErasedAt = &e.ErasedAt
+	}
+	return resp
+}
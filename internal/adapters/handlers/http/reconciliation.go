@@ -0,0 +1,198 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type ReconciliationHandler struct {
+	service *services.ReconciliationService
+}
+
+func NewReconciliationHandler(service *services.ReconciliationService) *ReconciliationHandler {
+	return &ReconciliationHandler{service: service}
+}
+
+// @Summary Import Afriex Export
+// @Description Reconciles an Afriex transaction export (CSV or JSON) against our payouts, by transaction ID, reference and amount. Send the file as multipart field "file", or as the raw body with a text/csv or application/json content type. The window defaults to the days the export covers.
+// @Tags Reconciliation
+// @Accept multipart/form-data
+// @Accept text/csv
+// @Accept json
+// @Produce json
+// @Param file formData file false "Afriex export"
+// @Param from query string false "Window start, e.g. 2026-10-01"
+// @Param to query string false "Window end, e.g. 2026-10-31 (inclusive)"
+// @Success 201 {object} ReconciliationRunResponse "Run with its exceptions"
+// @Failure 400 {object} ValidationErrorResponse "Unreadable export or bad window"
+// @Router /reconciliations [post]
+func (h *ReconciliationHandler) ImportExport(c echo.Context) error {
+	var errs domain.ValidationErrors
+	var from, to time.Time
+	if raw := c.QueryParam("from"); raw != "" {
+		t, err := parseStatementTime(raw, false)
+		if err != nil {
+			errs.Add("from", "must be YYYY-MM-DD or an RFC 3339 timestamp")
+		}
+		from = t
+	}
+	if raw := c.QueryParam("to"); raw != "" {
+		t, err := parseStatementTime(raw, true)
+		if err != nil {
+			errs.Add("to", "must be YYYY-MM-DD or an RFC 3339 timestamp")
+		}
+		to = t
+	}
+	if len(errs) > 0 {
+		return validationFailed(c, errs)
+	}
+
+	var body io.Reader
+	var source, format string
+	if fh, err := c.FormFile("file"); err == nil {
+		f, err := fh.Open()
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Could not read uploaded file"})
+		}
+		defer f.Close()
+		body, source = f, fh.Filename
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fh.Filename)), ".")
+	} else {
+		body = c.Request().Body
+		source = "upload-" + time.Now().UTC().Format("20060102T150405Z")
+		mt, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+		switch mt {
+		case "text/csv":
+			format = "csv"
+		case echo.MIMEApplicationJSON:
+			format = "json"
+		}
+	}
+
+	run, err := h.service.Import(c.Request().Context(), source, format, body, from, to)
+	if err != nil {
+		return reconciliationError(c, err)
+	}
+	return c.JSON(http.StatusCreated, toReconciliationRunResponse(*run, ""))
+}
+
+// @Summary List Reconciliation Runs
+// @Description Most recent reconciliation runs first, with their totals.
+// @Tags Reconciliation
+// @Produce json
+// @Param limit query int false "How many runs (default 50)"
+// @Success 200 {object} []ReconciliationRunResponse "Runs"
+// @Router /reconciliations [get]
+func (h *ReconciliationHandler) ListRuns(c echo.Context) error {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	runs, err := h.service.List(c.Request().Context(), limit)
+	if err != nil {
+		return reconciliationError(c, err)
+	}
+	resp := make([]ReconciliationRunResponse, 0, len(runs))
+	for _, r := range runs {
+		resp = append(resp, toReconciliationRunResponse(r, ""))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Get Reconciliation Run
+// @Description A run's totals and every exception it found.
+// @Tags Reconciliation
+// @Produce json
+// @Param id path string true "Run ID"
+// @Success 200 {object} ReconciliationRunResponse "Run"
+// @Failure 404 {object} map[string]string "Run not found"
+// @Router /reconciliations/{id} [get]
+func (h *ReconciliationHandler) GetRun(c echo.Context) error {
+	run, err := h.service.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return reconciliationError(c, err)
+	}
+	return c.JSON(http.StatusOK, toReconciliationRunResponse(*run, ""))
+}
+
+// @Summary List Reconciliation Exceptions
+// @Description The exceptions of a run, optionally of one kind.
+// @Tags Reconciliation
+// @Produce json
+// @Param id path string true "Run ID"
+// @Param result query string false "MISSING_OURS, MISSING_THEIRS or AMOUNT_MISMATCH"
+// @Success 200 {object} []ReconciliationItemResponse "Exceptions"
+// @Failure 404 {object} map[string]string "Run not found"
+// @Router /reconciliations/{id}/exceptions [get]
+func (h *ReconciliationHandler) ListExceptions(c echo.Context) error {
+	run, err := h.service.Get(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return reconciliationError(c, err)
+	}
+	return c.JSON(http.StatusOK, toReconciliationRunResponse(*run, strings.ToUpper(c.QueryParam("result"))).Exceptions)
+}
+
+func toReconciliationRunResponse(r domain.ReconciliationRun, result string) ReconciliationRunResponse {
+	resp := ReconciliationRunResponse{
+		ID:               r.ID,
+		Source:           r.Source,
+		From:             r.From,
+		To:               r.To,
+		Records:          r.Records,
+		Matched:          r.Matched,
+		MissingOurs:      r.MissingOurs,
+		MissingTheirs:    r.MissingTheirs,
+		AmountMismatches: r.AmountMismatches,
+		Reversed:         r.Reversed,
+		CreatedAt:        r.CreatedAt,
+		Exceptions:       make([]ReconciliationItemResponse, 0, len(r.Exceptions)),
+	}
+	for _, e := range r.Exceptions {
+		if result != "" && e.Result != result {
+			continue
+		}
+		item := ReconciliationItemResponse{
+			ID:            e.ID,
+			Result:        e.Result,
+			PayoutID:      e.PayoutID,
+			TransactionID: e.TransactionID,
+			Reference:     e.Reference,
+			Detail:        e.Detail,
+		}
+		if e.Ours.Currency != "" {
+			item.OurAmount, item.Currency = e.Ours.String(), e.Ours.Currency
+		}
+		if e.Theirs.Currency != "" {
+			item.TheirAmount, item.Currency = e.Theirs.String(), e.Theirs.Currency
+		}
+		resp.Exceptions = append(resp.Exceptions, item)
+	}
+	return resp
+}
+
+func reconciliationError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	if errors.As(err, &verrs) {
+		return validationFailed(c, verrs)
+	}
+	if errors.Is(err, domain.ErrInvalidExport) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if errors.Is(err, domain.ErrReconciliationNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Reconciliation run not found"})
+	}
+	slog.Error("Reconciliation request failed", "err", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Reconciliation request failed"})
+}
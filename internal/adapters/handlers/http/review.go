@@ -0,0 +1,138 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type ReviewHandler struct {
+	service *services.ReviewService
+}
+
+func NewReviewHandler(service *services.ReviewService) *ReviewHandler {
+	return &ReviewHandler{service: service}
+}
+
+// @Summary List Reviews
+// @Description Lists payouts held by sanctions screening, name enquiry or the large amount check, oldest first.
+// @Tags Reviews
+// @Produce json
+// @Param status query string false "PENDING (default), APPROVED or REJECTED"
+// @Param limit query int false "Maximum number of reviews" default(100)
+// @Success 200 {object} []ReviewResponse "Review queue"
+// @Failure 400 {object} ValidationErrorResponse "Invalid filter"
+// @Router /reviews [get]
+func (h *ReviewHandler) ListReviews(c echo.Context) error {
+	status := strings.ToUpper(strings.TrimSpace(c.QueryParam("status")))
+	limit := 100
+	if raw := c.QueryParam("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "limit must be a positive integer"})
+		}
+		limit = n
+	}
+
+	reviews, err := h.service.ListReviews(c.Request().Context(), tenantID(c), status, limit)
+	if err != nil {
+		return reviewError(c, err)
+	}
+
+	resp := make([]ReviewResponse, 0, len(reviews))
+	for _, rv := range reviews {
+		resp = append(resp, toReviewResponse(rv))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Approve Review
+// @Description Clears the hold and sends the payout back into the execution pipeline.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body ReviewDecisionRequest true "Why it was approved"
+// @Success 200 {object} ReviewResponse "Approved; the payout resumes in the background"
+// @Failure 400 {object} ValidationErrorResponse "Missing reason"
+// @Failure 404 {object} map[string]string "Review not found"
+// @Failure 409 {object} map[string]string "Review already decided"
+// @Router /reviews/{id}/approve [post]
+func (h *ReviewHandler) Approve(c echo.Context) error {
+	var req ReviewDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	rv, err := h.service.Approve(c.Request().Context(), tenantID(c), c.Param("id"), actor(c), req.Reason)
+	if err != nil {
+		return reviewError(c, err)
+	}
+	return c.JSON(http.StatusOK, toReviewResponse(*rv))
+}
+
+// @Summary Reject Review
+// @Description Ends the held payout as REJECTED without sending any money.
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID"
+// @Param request body ReviewDecisionRequest true "Why it was rejected"
+// @Success 200 {object} ReviewResponse "Rejected"
+// @Failure 400 {object} ValidationErrorResponse "Missing reason"
+// @Failure 404 {object} map[string]string "Review not found"
+// @Failure 409 {object} map[string]string "Review already decided"
+// @Router /reviews/{id}/reject [post]
+func (h *ReviewHandler) Reject(c echo.Context) error {
+	var req ReviewDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	rv, err := h.service.Reject(c.Request().Context(), tenantID(c), c.Param("id"), actor(c), req.Reason)
+	if err != nil {
+		return reviewError(c, err)
+	}
+	return c.JSON(http.StatusOK, toReviewResponse(*rv))
+}
+
+func reviewError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		return validationFailed(c, verrs)
+	case errors.Is(err, domain.ErrReviewNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrReviewAlreadyDecided), errors.Is(err, domain.ErrPayoutNotHeld):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to process review"})
+	}
+}
+
+func toReviewResponse(rv domain.Review) ReviewResponse {
+	resp := ReviewResponse{
+		ID:             rv.ID,
+		PayoutID:       rv.PayoutID,
+		BatchID:        rv.BatchID,
+		Reason:         rv.Reason,
+		Detail:         rv.Detail,
+		Status:         rv.Status,
+		Reviewer:       rv.Reviewer,
+		DecisionReason: rv.DecisionReason,
+		CreatedAt:      rv.CreatedAt,
+		RecipientName:  rv.RecipientName,
+		CountryCode:    rv.CountryCode,
+		Amount:         domain.NewMoney(rv.Amount, rv.Currency).String(),
+		Currency:       rv.Currency,
+	}
+	if !rv.DecidedAt.IsZero() {
+		decided := rv.DecidedAt
+		resp.DecidedAt = &decided
+	}
+	return resp
+}
@@ -1,5 +1,13 @@
 package http
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"waya/internal/core/domain"
+)
+
 // BulkPayoutRequest is what the Frontend/User sends us
 type BulkPayoutRequest struct {
 	BatchReference string       `json:"batch_reference" example:"JAN_SALARY_2025"`
@@ -16,14 +24,487 @@ type PayoutItem struct {
 	// Bank Details (Step 2 of Afriex Flow)
 	BankCode      string `json:"bank_code" example:"033"`       // e.g., UBA
 	AccountNumber string `json:"account_number" example:"2000012345"`
+	Channel       string `json:"channel,omitempty" example:"BANK_ACCOUNT"` // BANK_ACCOUNT (default) or MOBILE_MONEY
 	
 	// Transaction Details (Step 3 of Afriex Flow)
-	Amount   float64 `json:"amount" example:"5000.00"` // User sends float, we convert to cents
-	Currency string  `json:"currency" example:"NGN"`
+	Amount   Amount `json:"amount" swaggertype:"string" example:"5000.00"` // Decimal string, converted exactly to minor units
+	Currency string `json:"currency" example:"NGN"`
+}
+
+// Amount is a decimal amount kept as text so it never passes through float64.
+// Clients may send it as a JSON string ("5000.00") or, for backwards
+// compatibility, as a bare JSON number (5000.00).
+type Amount string
+
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*a = ""
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*a = Amount(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("amount must be a decimal string: %w", err)
+	}
+	*a = Amount(n.String())
+	return nil
 }
 
 type BulkPayoutResponse struct {
-	BatchID string `json:"batch_id"`
-	Status  string `json:"status"`
-	Message string `json:"message"`
-}
\ No newline at end of file
+	BatchID        string `json:"batch_id"`
+	Status         string `json:"status"`
+	Message        string `json:"message"`
+	ApprovalReason string `json:"approval_reason,omitempty"` // Why the batch is AWAITING_APPROVAL
+
+	Duplicates []DuplicateResponse `json:"duplicates,omitempty"` // Items that look like repeats of recent payouts
+}
+
+// DuplicateResponse flags a request item that repeats a recent payout
+type DuplicateResponse struct {
+	Item            int       `json:"item" example:"3"` // Index into the request items
+	PayoutID        string    `json:"payout_id"`
+	MatchedPayoutID string    `json:"matched_payout_id"`
+	MatchedBatchID  string    `json:"matched_batch_id"`
+	MatchedAt       time.Time `json:"matched_at"`
+}
+
+// DuplicateErrorResponse is returned when the duplicate policy blocks a batch
+type DuplicateErrorResponse struct {
+	Error      string              `json:"error" example:"batch contains likely duplicate payouts: 2 match(es)"`
+	Duplicates []DuplicateResponse `json:"duplicates"`
+}
+
+// LimitErrorResponse is returned when a batch would break a payout limit
+type LimitErrorResponse struct {
+	Error      string                   `json:"error" example:"limit exceeded: DAILY_PER_RECIPIENT NG/058/0123456789: 5000000.00 requested, 22000000.00 used of 25000000.00"`
+	Violations []LimitViolationResponse `json:"violations"`
+}
+
+// LimitViolationResponse explains which limit a request item breaks
+type LimitViolationResponse struct {
+	Item      int    `json:"item" example:"0"` // Index into the request items
+	Kind      string `json:"kind" example:"DAILY_PER_RECIPIENT"`
+	Scope     string `json:"scope" example:"NG/058/0123456789"`
+	Currency  string `json:"currency" example:"NGN"`
+	Limit     string `json:"limit" example:"25000000.00"`
+	Used      string `json:"used" example:"22000000.00"` // Already committed in the window, excluding this item
+	Requested string `json:"requested" example:"5000000.00"`
+}
+
+// QuotaErrorResponse is returned when a batch would go over a daily payout quota
+type QuotaErrorResponse struct {
+	Error     string    `json:"error" example:"daily payout quota exceeded: tenant quota is 5000 items, 4990 used today, 20 requested"`
+	Scope     string    `json:"scope" example:"tenant"` // tenant or api_key
+	Quota     int       `json:"quota" example:"5000"`
+	Used      int       `json:"used" example:"4990"`
+	Requested int       `json:"requested" example:"20"`
+	ResetsAt  time.Time `json:"resets_at" example:"2026-10-19T00:00:00Z"`
+}
+
+// InsufficientFundsResponse is returned when a batch exceeds the available balance
+type InsufficientFundsResponse struct {
+	Error      string              `json:"error" example:"insufficient funds: NGN 1500000.00 required, 1200000.00 available"`
+	Shortfalls []ShortfallResponse `json:"shortfalls"`
+}
+
+type ShortfallResponse struct {
+	Currency  string `json:"currency" example:"NGN"`
+	Available string `json:"available" example:"1200000.00"`
+	Required  string `json:"required" example:"1500000.00"`
+}
+
+// FundingRequest records money a tenant has sent us
+type FundingRequest struct {
+	TenantID    string `json:"tenant_id" example:"payroll-ng"`            // Tenant to credit; the operator's own when empty
+	Reference   string `json:"reference" example:"GTB-TRF-20261018-0042"` // External ID of the incoming transfer
+	Amount      string `json:"amount" example:"1500000.00"`
+	Currency    string `json:"currency" example:"NGN"`
+	Description string `json:"description" example:"October payroll top-up"`
+}
+
+type FundingResponse struct {
+	EntryID   string    `json:"entry_id"`
+	TenantID  string    `json:"tenant_id" example:"payroll-ng"`
+	Reference string    `json:"reference" example:"GTB-TRF-20261018-0042"`
+	Amount    string    `json:"amount" example:"1500000.00"`
+	Currency  string    `json:"currency" example:"NGN"`
+	Available string    `json:"available" example:"2750000.00"` // Available balance after the top-up
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BalanceResponse is the client's funds in one currency
+type BalanceResponse struct {
+	Currency  string `json:"currency" example:"NGN"`
+	Available string `json:"available" example:"2750000.00"` // Free to pay out
+	Reserved  string `json:"reserved" example:"250000.00"`   // Held for payouts in flight or awaiting a decision
+	Settled   string `json:"settled" example:"9000000.00"`   // Paid out to recipients
+	Funded    string `json:"funded" example:"12000000.00"`   // Total ever topped up
+	Fees      string `json:"fees" example:"45000.00"`        // Charged by Waya on settled payouts
+}
+
+// FeeRuleResponse is one rule of the tenant's fee schedule
+type FeeRuleResponse struct {
+	Country  string `json:"country,omitempty" example:"NG"` // Empty matches every corridor in the currency
+	Currency string `json:"currency" example:"NGN"`         // "*" matches every currency
+	Flat     string `json:"flat,omitempty" example:"50.00"`
+	Percent  string `json:"percent,omitempty" example:"0.5"`
+	Min      string `json:"min,omitempty" example:"0.00"`
+	Max      string `json:"max,omitempty" example:"5000.00"`
+}
+
+// InvoiceResponse bills the fees charged in one month
+type InvoiceResponse struct {
+	Number      string                 `json:"number" example:"WAYA-DEFAULT-202610"`
+	TenantID    string                 `json:"tenant_id"`
+	PeriodStart time.Time              `json:"period_start"`
+	PeriodEnd   time.Time              `json:"period_end"` // Exclusive
+	IssuedAt    time.Time              `json:"issued_at"`
+	Lines       []InvoiceLineResponse  `json:"lines"`
+	Totals      []InvoiceTotalResponse `json:"totals"`
+	Charges     []FeeChargeResponse    `json:"charges"`
+}
+
+// InvoiceLineResponse totals the month's fees for one corridor currency
+type InvoiceLineResponse struct {
+	Country  string `json:"country" example:"NG"`
+	Currency string `json:"currency" example:"NGN"`
+	Payouts  int    `json:"payouts" example:"120"`
+	Volume   string `json:"volume" example:"60000000.00"`
+	Fees     string `json:"fees" example:"306000.00"`
+}
+
+// InvoiceTotalResponse is the amount due in one currency
+type InvoiceTotalResponse struct {
+	Currency string `json:"currency" example:"NGN"`
+	Amount   string `json:"amount" example:"306000.00"`
+}
+
+// FeeChargeResponse is the fee on one settled payout
+type FeeChargeResponse struct {
+	PayoutID  string    `json:"payout_id"`
+	BatchID   string    `json:"batch_id"`
+	Country   string    `json:"country" example:"NG"`
+	Currency  string    `json:"currency" example:"NGN"`
+	Amount    string    `json:"amount" example:"500000.00"`
+	Fee       string    `json:"fee" example:"2550.00"`
+	ChargedAt time.Time `json:"charged_at"`
+}
+
+// BatchCostResponse is what a batch actually cost in the source currency
+type BatchCostResponse struct {
+	BatchID        string                 `json:"batch_id"`
+	SourceCurrency string                 `json:"source_currency" example:"USD"`
+	TotalSource    string                 `json:"total_source" example:"3333.34"` // Debited for conversions
+	TotalFees      string                 `json:"total_fees" example:"12.50"`
+	TotalCost      string                 `json:"total_cost" example:"3345.84"` // Source plus fees
+	Pending        int                    `json:"pending"`                      // Payouts without a recorded cost yet
+	Currencies     []CurrencyCostResponse `json:"currencies"`
+}
+
+// CurrencyCostResponse totals the cost of a batch's payouts in one destination currency
+type CurrencyCostResponse struct {
+	Currency      string `json:"currency" example:"NGN"`
+	Payouts       int    `json:"payouts" example:"2"`
+	Delivered     string `json:"delivered" example:"5000000.00"`
+	Source        string `json:"source" example:"3333.34"`
+	Fees          string `json:"fees" example:"12.50"`
+	TotalCost     string `json:"total_cost" example:"3345.84"`
+	EffectiveRate string `json:"effective_rate" example:"1499.998500"` // Delivered per source unit
+	AllInRate     string `json:"all_in_rate" example:"1494.393000"`    // Delivered per source unit, fees included
+}
+
+// ReconciliationRunResponse is one import of an Afriex export
+type ReconciliationRunResponse struct {
+	ID               string                       `json:"id"`
+	Source           string                       `json:"source" example:"afriex-2026-10-17.csv"`
+	From             time.Time                    `json:"from"`
+	To               time.Time                    `json:"to"` // Exclusive
+	Records          int                          `json:"records" example:"412"`
+	Matched          int                          `json:"matched" example:"410"`
+	MissingOurs      int                          `json:"missing_ours" example:"0"`
+	MissingTheirs    int                          `json:"missing_theirs" example:"1"`
+	AmountMismatches int                          `json:"amount_mismatches" example:"1"`
+	Reversed         int                          `json:"reversed" example:"0"` // Payouts reversed by this import
+	CreatedAt        time.Time                    `json:"created_at"`
+	Exceptions       []ReconciliationItemResponse `json:"exceptions"`
+}
+
+// ReconciliationItemResponse is a record or payout the two sides disagree on
+type ReconciliationItemResponse struct {
+	ID            string `json:"id"`
+	Result        string `json:"result" example:"AMOUNT_MISMATCH"` // MISSING_OURS, MISSING_THEIRS, AMOUNT_MISMATCH, REVERSED
+	PayoutID      string `json:"payout_id,omitempty"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	Reference     string `json:"reference,omitempty"`
+	OurAmount     string `json:"our_amount,omitempty" example:"50000.00"`
+	TheirAmount   string `json:"their_amount,omitempty" example:"5000.00"`
+	Currency      string `json:"currency" example:"NGN"`
+	Detail        string `json:"detail" example:"we paid 50000.00 NGN, Afriex settled 5000.00 NGN"`
+}
+
+// StatementResponse lists ledger entries between two dates
+type StatementResponse struct {
+	Currency string                  `json:"currency" example:"NGN"`
+	From     time.Time               `json:"from"`
+	To       time.Time               `json:"to"`
+	Opening  BalanceResponse         `json:"opening"`
+	Closing  BalanceResponse         `json:"closing"`
+	Entries  []StatementLineResponse `json:"entries"`
+}
+
+// StatementLineResponse is one entry's change to each balance
+type StatementLineResponse struct {
+	EntryID     string    `json:"entry_id"`
+	Kind        string    `json:"kind" example:"RESERVE"` // FUNDING, RESERVE, SETTLE, RELEASE
+	Reference   string    `json:"reference"`              // Payout ID or funding reference
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	Available   string    `json:"available" example:"-50000.00"`
+	Reserved    string    `json:"reserved" example:"50000.00"`
+	Settled     string    `json:"settled" example:"0.00"`
+	Fees        string    `json:"fees" example:"0.00"`
+}
+
+// LimitUsageResponse is current usage against one limit
+type LimitUsageResponse struct {
+	Kind        string     `json:"kind" example:"MONTHLY_PER_CORRIDOR"`
+	Scope       string     `json:"scope" example:"NG/NGN"`
+	Currency    string     `json:"currency" example:"NGN"`
+	Limit       string     `json:"limit" example:"2000000000.00"`
+	Used        string     `json:"used" example:"153000000.00"`
+	Remaining   string     `json:"remaining" example:"1847000000.00"`
+	WindowStart *time.Time `json:"window_start,omitempty"` // Absent for per-payout limits
+	WindowEnd   *time.Time `json:"window_end,omitempty"`
+}
+
+// BatchDecisionRequest is the checker's approval or rejection of a batch
+type BatchDecisionRequest struct {
+	Comment string `json:"comment" example:"Matches January payroll sign-off"` // Required when rejecting
+}
+// ReversePayoutRequest records why a paid payout was returned by the bank
+type ReversePayoutRequest struct {
+	Reason string `json:"reason" example:"Account closed, funds returned by GTBank"`
+}
+
+// ReissuePayoutRequest is the corrected destination for a reversed payout.
+// Empty fields keep the original payout's value.
+type ReissuePayoutRequest struct {
+	BankCode      string `json:"bank_code" example:"044"`
+	AccountNumber string `json:"account_number" example:"0123456789"`
+	Channel       string `json:"channel" example:"BANK_ACCOUNT"`
+	RecipientName string `json:"recipient_name" example:"Emeka Okafor"`
+}
+
+// AfriexWebhookRequest is a transaction update pushed by Afriex
+type AfriexWebhookRequest struct {
+	Event string `json:"event" example:"TRANSACTION.UPDATED"`
+	Data  struct {
+		TransactionID string `json:"transactionId" example:"tx_8f2a"`
+		Status        string `json:"status" example:"REVERSED"`
+		Reason        string `json:"reason" example:"Beneficiary account closed"`
+	} `json:"data"`
+}
+
+// ValidationErrorResponse lists every invalid field in a rejected request
+type ValidationErrorResponse struct {
+	Error   string                   `json:"error" example:"validation failed"`
+	Details []domain.ValidationError `json:"details"`
+}
+
+// CorridorResponse describes a destination country we can pay into
+type CorridorResponse struct {
+	Country        string                     `json:"country" example:"NG"`
+	Name           string                     `json:"name" example:"Nigeria"`
+	Currencies     []CorridorCurrencyResponse `json:"currencies"`
+	Channels       []CorridorChannelResponse  `json:"channels"`
+	RequiredFields []string                   `json:"required_fields" example:"recipient_name,recipient_phone"`
+}
+
+type CorridorCurrencyResponse struct {
+	Code      string `json:"code" example:"NGN"`
+	MinAmount string `json:"min_amount" example:"100.00"`
+	MaxAmount string `json:"max_amount,omitempty" example:"50000000.00"`
+}
+
+type CorridorChannelResponse struct {
+	Type           string   `json:"type" example:"BANK_ACCOUNT"`
+	RequiredFields []string `json:"required_fields" example:"bank_code,account_number"`
+}
+
+// QuoteResponse is the indicative USD cost of a payout
+type QuoteResponse struct {
+	Country             string `json:"country" example:"NG"`
+	DestinationAmount   string `json:"destination_amount" example:"5000.00"`
+	DestinationCurrency string `json:"destination_currency" example:"NGN"`
+	SourceAmount        string `json:"source_amount" example:"3.34"`
+	SourceCurrency      string `json:"source_currency" example:"USD"`
+	Rate                string `json:"rate" example:"1500.25"`
+	Fee                 string `json:"fee" example:"125.00"`          // Waya's fee, in the destination currency
+	TotalDebit          string `json:"total_debit" example:"5125.00"` // Taken from the balance: amount plus fee
+}
+
+// BankResponse is one entry of the bank directory
+type BankResponse struct {
+	Code    string `json:"code" example:"058"`
+	Name    string `json:"name" example:"Guaranty Trust Bank"`
+	Channel string `json:"channel" example:"BANK_ACCOUNT"`
+}
+
+// ReviewDecisionRequest records why a held payout was approved or rejected
+type ReviewDecisionRequest struct {
+	Reason string `json:"reason" example:"Confirmed recipient identity with HR"`
+}
+
+// ReviewResponse is one entry of the manual review queue
+type ReviewResponse struct {
+	ID             string     `json:"id"`
+	PayoutID       string     `json:"payout_id"`
+	BatchID        string     `json:"batch_id"`
+	Reason         string     `json:"reason" example:"NAME_MISMATCH"` // SANCTIONS, NAME_MISMATCH, LARGE_AMOUNT
+	Detail         string     `json:"detail" example:"name mismatch: bank has \"EMEKA OKAFOR\" for \"Emeka Okonkwo\" (score 0.71)"`
+	Status         string     `json:"status" example:"PENDING"`
+	Reviewer       string     `json:"reviewer,omitempty"`
+	DecisionReason string     `json:"decision_reason,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+
+	RecipientName string `json:"recipient_name" example:"Emeka Okonkwo"`
+	CountryCode   string `json:"country_code" example:"NG"`
+	Amount        string `json:"amount" example:"5000.00"`
+	Currency      string `json:"currency" example:"NGN"`
+}
+
+// CreateAPIKeyRequest issues a key for the caller's tenant, or for tenant_id when the operator asks
+type CreateAPIKeyRequest struct {
+	Name       string     `json:"name" example:"Payroll export job"`
+	Scopes     []string   `json:"scopes" example:"payouts:read,payouts:write"` // payouts:read, payouts:write, payouts:approve, admin
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`                        // Never expires when omitted
+	SignedOnly bool       `json:"signed_only,omitempty"`                       // Refuse x-api-key; requests must be signed
+}
+
+// RotateAPIKeyRequest sets how long the old key keeps working
+type RotateAPIKeyRequest struct {
+	Grace string `json:"grace" example:"24h"` // Go duration; default 24h, "0s" ends the old key now
+}
+
+// APIKeyResponse describes a key without its secret
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	TenantID   string     `json:"tenant_id" example:"payroll-ng"`
+	Name       string     `json:"name" example:"Payroll export job"`
+	Prefix     string     `json:"prefix" example:"wk_3f9a01bc"`
+	Scopes     []string   `json:"scopes" example:"payouts:read,payouts:write"`
+	CreatedBy  string     `json:"created_by" example:"key:wk_77d0e2aa"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	RevokedBy  string     `json:"revoked_by,omitempty"`
+	ReplacedBy string     `json:"replaced_by,omitempty"` // Key issued by rotating this one
+	SignedOnly bool       `json:"signed_only"`
+}
+
+// IssuedAPIKeyResponse carries the secret, shown only this once
+type IssuedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"wk_3f9a01bc_5e0c..."`
+}
+
+// LoginRequest signs a user in with their email and password
+type LoginRequest struct {
+	Email    string `json:"email" example:"ngozi@acme.com"`
+	Password string `json:"password" example:"correct horse battery staple"`
+}
+
+// RefreshRequest exchanges a refresh token for a new session, or ends it on logout
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" example:"wr_9c1f..."`
+}
+
+// SessionResponse carries a new access token and the refresh token that replaces the one presented
+type SessionResponse struct {
+	AccessToken      string       `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	TokenType        string       `json:"token_type" example:"Bearer"`
+	ExpiresAt        time.Time    `json:"expires_at"` // Of the access token
+	RefreshToken     string       `json:"refresh_token" example:"wr_9c1f..."`
+	RefreshExpiresAt time.Time    `json:"refresh_expires_at"`
+	User             UserResponse `json:"user"`
+}
+
+// CreateUserRequest adds a user to the caller's tenant, or to tenant_id when the operator asks
+type CreateUserRequest struct {
+	Email    string `json:"email" example:"ngozi@acme.com"`
+	Name     string `json:"name" example:"Ngozi Adeyemi"`
+	Role     string `json:"role" example:"approver"` // viewer, operator, approver, admin
+	Password string `json:"password" example:"correct horse battery staple"`
+}
+
+// SetRoleRequest changes a user's role
+type SetRoleRequest struct {
+	Role string `json:"role" example:"operator"`
+}
+
+// SetPasswordRequest resets a user's password; changing one's own also needs the current one
+type SetPasswordRequest struct {
+	CurrentPassword string `json:"current_password,omitempty"` // Required on /me/password
+	Password        string `json:"password" example:"correct horse battery staple"`
+}
+
+// UserResponse describes a user without their password
+type UserResponse struct {
+	ID          string     `json:"id"`
+	TenantID    string     `json:"tenant_id" example:"payroll-ng"`
+	Email       string     `json:"email" example:"ngozi@acme.com"`
+	Name        string     `json:"name" example:"Ngozi Adeyemi"`
+	Role        string     `json:"role" example:"approver"`
+	CreatedBy   string     `json:"created_by" example:"ada@waya.finance"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	DisabledAt  *time.Time `json:"disabled_at,omitempty"`
+}
+
+// AuditEntryResponse is one entry of the audit log
+type AuditEntryResponse struct {
+	ID         int64           `json:"id" example:"1042"`
+	At         time.Time       `json:"at"`
+	TenantID   string          `json:"tenant_id" example:"payroll-ng"`
+	Actor      string          `json:"actor" example:"ngozi@acme.com"`
+	Principal  string          `json:"principal,omitempty" example:"ngozi@acme.com"` // The credential used: a user's email or key:<prefix>
+	Action     string          `json:"action" example:"batch.approved"`
+	TargetType string          `json:"target_type" example:"batch"`
+	TargetID   string          `json:"target_id"`
+	RequestID  string          `json:"request_id,omitempty"`
+	IP         string          `json:"ip,omitempty" example:"203.0.113.7"`
+	Before     json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After      json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	PrevHash   string          `json:"prev_hash"`
+	Hash       string          `json:"hash"`
+}
+
+// AuditVerificationResponse is the outcome of checking the audit log's hash chain
+type AuditVerificationResponse struct {
+	Intact   bool   `json:"intact"`
+	Checked  int64  `json:"checked" example:"1042"`
+	HeadID   int64  `json:"head_id" example:"1042"`
+	HeadHash string `json:"head_hash"` // Record it elsewhere; a later head that no longer contains it means entries were cut
+	BrokenAt int64  `json:"broken_at,omitempty"`
+	Problem  string `json:"problem,omitempty"`
+}
+
+// ErasureResponse lists the payouts whose recipient details were erased
+type ErasureResponse struct {
+	TenantID  string     `json:"tenant_id" example:"payroll-ng"`
+	PayoutID  string     `json:"payout_id"`           // The payout named in the request
+	PayoutIDs []string   `json:"payout_ids"`          // Erased now; empty when all were already erased
+	ErasedAt  *time.Time `json:"erased_at,omitempty"` // When the named payout was erased
+	// Payouts to the recipient still pending, held, awaiting approval or
+	// processing. They keep the details they need; erase again once they finish.
+	LivePayoutIDs []string `json:"live_payout_ids,omitempty"`
+}
@@ -0,0 +1,320 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/adapters/handlers/http/middlewares"
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+type UserHandler struct {
+	users *services.UserService
+}
+
+func NewUserHandler(users *services.UserService) *UserHandler {
+	return &UserHandler{users: users}
+}
+
+// @Summary Log In
+// @Description Signs a user in with their email and password. Send the access token as "Authorization: Bearer <token>"; when it expires, exchange the refresh token at /auth/refresh. Attempts are rate limited per IP.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body LoginRequest true "Email and password"
+// @Success 200 {object} SessionResponse "Access and refresh tokens"
+// @Failure 401 {object} map[string]string "Wrong email or password, or user disabled"
+// @Failure 429 {object} map[string]string "Too many attempts"
+// @Router /auth/login [post]
+func (h *UserHandler) Login(c echo.Context) error {
+	var req LoginRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	s, err := h.users.Login(c.Request().Context(), req.Email, req.Password)
+	if err != nil {
+		return userError(c, err)
+	}
+	return c.JSON(http.StatusOK, toSessionResponse(s))
+}
+
+// @Summary Refresh Session
+// @Description Exchanges a refresh token for a new access token and a new refresh token. Each refresh token works once; presenting a used one again signs the user out of every session.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body RefreshRequest true "Refresh token"
+// @Success 200 {object} SessionResponse "New access and refresh tokens"
+// @Failure 401 {object} map[string]string "Refresh token invalid, expired or reused"
+// @Router /auth/refresh [post]
+func (h *UserHandler) Refresh(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	s, err := h.users.Refresh(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		return userError(c, err)
+	}
+	return c.JSON(http.StatusOK, toSessionResponse(s))
+}
+
+// @Summary Log Out
+// @Description Ends the session of a refresh token. Its access token stays valid until it expires.
+// @Tags Auth
+// @Accept json
+// @Param request body RefreshRequest true "Refresh token"
+// @Success 204 "Signed out"
+// @Router /auth/logout [post]
+func (h *UserHandler) Logout(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	if err := h.users.Logout(c.Request().Context(), req.RefreshToken); err != nil {
+		return userError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Current User
+// @Description The signed-in user.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} UserResponse "User"
+// @Failure 403 {object} map[string]string "Called with an API key"
+// @Router /me [get]
+func (h *UserHandler) Me(c echo.Context) error {
+	u, ok := currentUser(c)
+	if !ok {
+		return forbidNotUser(c)
+	}
+	return c.JSON(http.StatusOK, toUserResponse(*u))
+}
+
+// @Summary Change Own Password
+// @Description Changes the signed-in user's password and ends all of their sessions, this one included: refresh tokens stop working, so they sign in again once the access token expires.
+// @Tags Auth
+// @Accept json
+// @Param request body SetPasswordRequest true "Current and new password"
+// @Success 204 "Password changed"
+// @Failure 400 {object} ValidationErrorResponse "New password too short or long"
+// @Failure 401 {object} map[string]string "Current password is wrong"
+// @Failure 403 {object} map[string]string "Called with an API key"
+// @Router /me/password [post]
+func (h *UserHandler) ChangePassword(c echo.Context) error {
+	u, ok := currentUser(c)
+	if !ok {
+		return forbidNotUser(c)
+	}
+	var req SetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	if err := h.users.ChangePassword(c.Request().Context(), u, req.CurrentPassword, req.Password); err != nil {
+		return userError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Create User
+// @Description Adds a user to the tenant. Roles: viewer reads; operator also submits and re-issues batches; approver reads and approves or rejects batches and reviews; admin does everything, including users and API keys. Admin required. The operator may pass tenant_id to add a user to another tenant.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param tenant_id query string false "Tenant to add the user to (operator only)"
+// @Param request body CreateUserRequest true "Email, name, role and initial password"
+// @Success 201 {object} UserResponse "User"
+// @Failure 400 {object} ValidationErrorResponse "Invalid email, role or password"
+// @Failure 403 {object} map[string]string "Not an admin, or another tenant's users"
+// @Failure 404 {object} map[string]string "Tenant not found"
+// @Failure 409 {object} map[string]string "Email already taken"
+// @Router /users [post]
+func (h *UserHandler) CreateUser(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	var req CreateUserRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	u, err := h.users.Create(c.Request().Context(), tenant, req.Email, req.Name, req.Role, req.Password, actor(c))
+	if err != nil {
+		return userError(c, err)
+	}
+	return c.JSON(http.StatusCreated, toUserResponse(*u))
+}
+
+// @Summary List Users
+// @Description The tenant's users by email, including disabled ones. Admin required.
+// @Tags Users
+// @Produce json
+// @Param tenant_id query string false "Tenant whose users to list (operator only)"
+// @Success 200 {object} []UserResponse "Users"
+// @Failure 403 {object} map[string]string "Not an admin, or another tenant's users"
+// @Router /users [get]
+func (h *UserHandler) ListUsers(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	users, err := h.users.List(c.Request().Context(), tenant)
+	if err != nil {
+		return userError(c, err)
+	}
+	resp := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		resp = append(resp, toUserResponse(u))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// @Summary Set User Role
+// @Description Changes a user's role from their next request on. Users cannot change their own. Admin required.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param tenant_id query string false "Tenant of the user (operator only)"
+// @Param request body SetRoleRequest true "New role"
+// @Success 200 {object} UserResponse "User"
+// @Failure 400 {object} ValidationErrorResponse "Unknown role"
+// @Failure 403 {object} map[string]string "Not an admin, or the caller's own role"
+// @Failure 404 {object} map[string]string "User not found"
+// @Router /users/{id}/role [post]
+func (h *UserHandler) SetRole(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	var req SetRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	u, err := h.users.SetRole(c.Request().Context(), tenant, c.Param("id"), req.Role, middlewares.CurrentPrincipal(c))
+	if err != nil {
+		return userError(c, err)
+	}
+	return c.JSON(http.StatusOK, toUserResponse(*u))
+}
+
+// @Summary Disable User
+// @Description Stops the user signing in and ends their sessions; their access tokens stop working at once. Users cannot disable themselves. Admin required.
+// @Tags Users
+// @Produce json
+// @Param id path string true "User ID"
+// @Param tenant_id query string false "Tenant of the user (operator only)"
+// @Success 200 {object} UserResponse "Disabled user"
+// @Failure 403 {object} map[string]string "Not an admin, or the caller themselves"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 409 {object} map[string]string "User already disabled"
+// @Router /users/{id}/disable [post]
+func (h *UserHandler) DisableUser(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	u, err := h.users.Disable(c.Request().Context(), tenant, c.Param("id"), middlewares.CurrentPrincipal(c))
+	if errors.Is(err, domain.ErrUserDisabled) {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "User already disabled"})
+	}
+	if err != nil {
+		return userError(c, err)
+	}
+	return c.JSON(http.StatusOK, toUserResponse(*u))
+}
+
+// @Summary Reset User Password
+// @Description Sets a new password for the user and ends their sessions. Admin required.
+// @Tags Users
+// @Accept json
+// @Param id path string true "User ID"
+// @Param tenant_id query string false "Tenant of the user (operator only)"
+// @Param request body SetPasswordRequest true "New password"
+// @Success 204 "Password reset"
+// @Failure 400 {object} ValidationErrorResponse "Password too short or long"
+// @Failure 404 {object} map[string]string "User not found"
+// @Router /users/{id}/password [post]
+func (h *UserHandler) SetPassword(c echo.Context) error {
+	tenant, ok := managedTenant(c)
+	if !ok {
+		return forbidOtherTenant(c)
+	}
+	var req SetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid JSON"})
+	}
+	if err := h.users.SetPassword(c.Request().Context(), tenant, c.Param("id"), req.Password, middlewares.CurrentPrincipal(c)); err != nil {
+		return userError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// currentUser is the signed-in user, or false for API key callers
+func currentUser(c echo.Context) (*domain.User, bool) {
+	if p := middlewares.CurrentPrincipal(c); p != nil && p.User != nil {
+		return p.User, true
+	}
+	return nil, false
+}
+
+func forbidNotUser(c echo.Context) error {
+	return c.JSON(http.StatusForbidden, map[string]string{"error": "Forbidden: only signed-in users have a profile"})
+}
+
+func userError(c echo.Context, err error) error {
+	var verrs domain.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		return validationFailed(c, verrs)
+	case errors.Is(err, domain.ErrInvalidCredentials), errors.Is(err, domain.ErrRefreshInvalid), errors.Is(err, domain.ErrRefreshReused),
+		errors.Is(err, domain.ErrUserDisabled):
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrSelfChange):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrTenantNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrUserExists):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	default:
+		slog.Error("User request failed", "err", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "User request failed"})
+	}
+}
+
+func toSessionResponse(s *domain.Session) SessionResponse {
+	return SessionResponse{
+		AccessToken:      s.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresAt:        s.AccessExpiresAt,
+		RefreshToken:     s.RefreshToken,
+		RefreshExpiresAt: s.RefreshExpiresAt,
+		User:             toUserResponse(s.User),
+	}
+}
+
+func toUserResponse(u domain.User) UserResponse {
+	resp := UserResponse{
+		ID:        u.ID,
+		TenantID:  u.TenantID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      u.Role,
+		CreatedBy: u.CreatedBy,
+		CreatedAt: u.CreatedAt,
+	}
+	if !u.LastLoginAt.IsZero() {
+		t := u.LastLoginAt
+		resp.LastLoginAt = &t
+	}
+	if !u.DisabledAt.IsZero() {
+		t := u.DisabledAt
+		resp.DisabledAt = &t
+	}
+	return resp
+}
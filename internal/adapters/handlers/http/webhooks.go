@@ -0,0 +1,97 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/services"
+)
+
+// Afriex signs each webhook body with HMAC-SHA256 (hex) under the shared secret
+const afriexSignatureHeader = "x-webhook-signature"
+
+const maxWebhookBody = 1 << 20
+
+type WebhookHandler struct {
+	payouts *services.PayoutService
+	secret  string
+}
+
+func NewWebhookHandler(payouts *services.PayoutService, afriexSecret string) *WebhookHandler {
+	return &WebhookHandler{payouts: payouts, secret: afriexSecret}
+}
+
+// @Summary Afriex Webhook Listener
+// @Description Receives transaction updates from Afriex, signed with HMAC-SHA256 of the raw body in the x-webhook-signature header. A REVERSED, RETURNED or REFUNDED status reverses the matching SUCCESS payout. Unknown transactions are acknowledged so Afriex stops retrying.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param x-webhook-signature header string true "Hex HMAC-SHA256 of the body"
+// @Param request body AfriexWebhookRequest true "Afriex webhook payload"
+// @Success 200 {string} string "OK"
+// @Failure 400 {string} string "Invalid payload"
+// @Failure 401 {string} string "Invalid signature"
+// @Failure 503 {string} string "AFRIEX_WEBHOOK_SECRET is not configured"
+// @Router /webhooks/afriex [post]
+func (h *WebhookHandler) HandleAfriexWebhook(c echo.Context) error {
+	if h.secret == "" {
+		slog.Error("Afriex webhook refused: AFRIEX_WEBHOOK_SECRET is not set")
+		return c.String(http.StatusServiceUnavailable, "Webhook secret not configured")
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookBody))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "Invalid Payload")
+	}
+	if !validSignature(h.secret, body, c.Request().Header.Get(afriexSignatureHeader)) {
+		slog.Warn("⚠️ Afriex webhook with a bad signature", "ip", c.RealIP())
+		return c.String(http.StatusUnauthorized, "Invalid Signature")
+	}
+
+	var payload AfriexWebhookRequest
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return c.String(http.StatusBadRequest, "Invalid Payload")
+	}
+	txID, status := strings.TrimSpace(payload.Data.TransactionID), strings.TrimSpace(payload.Data.Status)
+	slog.Info("🔔 Webhook received", "event", payload.Event, "tx_id", txID, "new_status", status)
+	if payload.Event != "TRANSACTION.UPDATED" || txID == "" || !domain.IsProviderReversal(status) {
+		return c.String(http.StatusOK, "OK")
+	}
+
+	reason := strings.TrimSpace(payload.Data.Reason)
+	if reason == "" {
+		reason = "Afriex reports " + strings.ToUpper(status)
+	}
+	_, err = h.payouts.ReverseByTransaction(c.Request().Context(), txID, domain.Reversal{
+		Source: domain.ReversalSourceWebhook,
+		Reason: reason,
+		Actor:  "afriex",
+	})
+	switch {
+	case errors.Is(err, domain.ErrPayoutNotFound), errors.Is(err, domain.ErrNotReversible):
+		slog.Warn("Afriex reversal not applied", "tx_id", txID, "err", err)
+	case err != nil:
+		slog.Error("Failed to apply Afriex reversal", "tx_id", txID, "err", err)
+		return c.String(http.StatusInternalServerError, "Retry later") // Afriex retries non-2xx deliveries
+	}
+	return c.String(http.StatusOK, "OK")
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
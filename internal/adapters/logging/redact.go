@@ -0,0 +1,244 @@
+// Package logging masks recipient details and credentials before log
+// records reach their output.
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Redacted replaces the value of a sensitive field
+const Redacted = "[REDACTED]"
+
+// DefaultFields are masked wherever they appear: attribute keys, JSON body
+// keys, Go struct fields and URL query parameters. Matching ignores case
+// and separators, so "accountNumber", "account_number" and "AccountNumber"
+// are the same field.
+var DefaultFields = []string{
+	// Recipient details, as stored on payouts and sent to Afriex
+	"recipient_name", "full_name", "first_name", "last_name",
+	"account_name", "resolved_account_name", "resolved_name",
+	"account_number", "recipient_phone", "recipient_phone_e164", "phone", "phone_number", "msisdn",
+	"recipient_email", "email", "recipient_tag", "tag",
+	"detail", // Review and hold details quote names
+	// Credentials
+	"password", "token", "access_token", "refresh_token", "secret",
+	"api_key", "x-api-key", "authorization",
+}
+
+// Fields returns DefaultFields adjusted by a comma-separated list: a name
+// adds a field, "-name" stops masking a default one.
+func Fields(spec string) []string {
+	drop := make(map[string]bool)
+	var extra []string
+	for _, f := range strings.Split(spec, ",") {
+		f = strings.TrimSpace(f)
+		switch {
+		case f == "":
+		case strings.HasPrefix(f, "-"):
+			drop[fieldKey(f[1:])] = true
+		default:
+			extra = append(extra, f)
+		}
+	}
+	fields := make([]string, 0, len(DefaultFields)+len(extra))
+	for _, f := range append(append([]string{}, DefaultFields...), extra...) {
+		if !drop[fieldKey(f)] {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// NewLogger writes JSON records at or above level, with fields and
+// anything that looks like a phone, account number or email masked.
+func NewLogger(w io.Writer, level slog.Leveler, fields []string) *slog.Logger {
+	return slog.New(NewRedactingHandler(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}), fields))
+}
+
+var (
+	// Phone and account numbers: 10 digits or more, kept to the last four
+	digitsPattern = regexp.MustCompile(`\+?\b\d{10,19}\b`)
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@([A-Za-z0-9\-]+\.)+[A-Za-z]{2,}`)
+	queryPattern  = regexp.MustCompile(`([?&])([^=&#\s]+)=([^&#\s]*)`)
+)
+
+// RedactingHandler masks sensitive values in a record's message and
+// attributes, then passes it on. String values holding a JSON document,
+// such as Afriex response bodies, have the fields masked inside the
+// document; structs and maps logged with slog.Any are masked the same way.
+type RedactingHandler struct {
+	next   slog.Handler
+	fields map[string]bool
+}
+
+// NewRedactingHandler wraps next so it never sees the given fields
+func NewRedactingHandler(next slog.Handler, fields []string) *RedactingHandler {
+	h := &RedactingHandler{next: next, fields: make(map[string]bool, len(fields))}
+	for _, f := range fields {
+		h.fields[fieldKey(f)] = true
+	}
+	return h
+}
+
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *RedactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, h.text(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.attr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	masked := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		masked[i] = h.attr(a)
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(masked), fields: h.fields}
+}
+
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), fields: h.fields}
+}
+
+func (h *RedactingHandler) sensitive(key string) bool {
+	return h.fields[fieldKey(key)]
+}
+
+func (h *RedactingHandler) attr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if h.sensitive(a.Key) {
+		if a.Value.Kind() == slog.KindString && a.Value.String() == "" {
+			return a
+		}
+		return slog.String(a.Key, Redacted)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(h.text(a.Value.String()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		masked := make([]slog.Attr, len(group))
+		for i, g := range group {
+			masked[i] = h.attr(g)
+		}
+		a.Value = slog.GroupValue(masked...)
+	case slog.KindAny:
+		a.Value = h.any(a.Value.Any())
+	}
+	return a
+}
+
+func (h *RedactingHandler) any(v any) slog.Value {
+	switch v := v.(type) {
+	case nil:
+		return slog.AnyValue(nil)
+	case error:
+		return slog.StringValue(h.text(v.Error()))
+	case []byte:
+		return slog.StringValue(h.text(string(v)))
+	case json.RawMessage:
+		return slog.StringValue(h.text(string(v)))
+	}
+
+	// Structs, maps and slices: go through JSON so their field names can be
+	// matched, and log the masked document in their place
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return slog.StringValue(Redacted)
+	}
+	doc, ok := decode(raw)
+	if !ok {
+		return slog.AnyValue(v)
+	}
+	return slog.AnyValue(h.value(doc))
+}
+
+// text masks a free-form string: a whole JSON document field by field,
+// anything else by pattern.
+func (h *RedactingHandler) text(s string) string {
+	if trimmed := strings.TrimSpace(s); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if doc, ok := decode([]byte(trimmed)); ok {
+			if out, err := json.Marshal(h.value(doc)); err == nil {
+				return string(out)
+			}
+		}
+	}
+	return h.patterns(s)
+}
+
+func (h *RedactingHandler) patterns(s string) string {
+	s = queryPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := queryPattern.FindStringSubmatch(m)
+		if !h.sensitive(parts[2]) || parts[3] == "" {
+			return m
+		}
+		return parts[1] + parts[2] + "=" + Redacted
+	})
+	s = emailPattern.ReplaceAllStringFunc(s, func(m string) string {
+		return "***" + m[strings.LastIndex(m, "@"):]
+	})
+	return digitsPattern.ReplaceAllStringFunc(s, func(m string) string {
+		prefix := ""
+		if strings.HasPrefix(m, "+") {
+			prefix, m = "+", m[1:]
+		}
+		return prefix + strings.Repeat("*", len(m)-4) + m[len(m)-4:]
+	})
+}
+
+// value masks a decoded JSON document in place
+func (h *RedactingHandler) value(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if h.sensitive(k) {
+				if s, ok := field.(string); !ok || s != "" {
+					v[k] = Redacted
+				}
+				continue
+			}
+			v[k] = h.value(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = h.value(item)
+		}
+	case string:
+		return h.patterns(v)
+	}
+	return v
+}
+
+// decode parses a JSON document keeping numbers as written
+func decode(raw []byte) (any, bool) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil || dec.More() {
+		return nil, false
+	}
+	return doc, true
+}
+
+// fieldKey folds case and drops separators: "X-Api-Key" is "xapikey"
+func fieldKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
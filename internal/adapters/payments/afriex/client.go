@@ -51,7 +51,8 @@ func (c *Client) do(ctx context.Context, method, path string, body interface{},
 
 	respBody, _ := io.ReadAll(resp.Body)
 
-	// LOGGING (Crucial for Hackathon debugging)
+	// LOGGING (Crucial for Hackathon debugging). Bodies carry recipient details,
+	// which the server's log handler masks; see internal/adapters/logging
 	slog.Debug("Afriex API", "path", path, "status", resp.StatusCode, "resp", string(respBody))
 
 	if resp.StatusCode >= 400 {
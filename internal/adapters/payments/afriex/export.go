@@ -0,0 +1,216 @@
+package afriex
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"waya/internal/core/domain"
+)
+
+// Export column names, normalized (lower case, letters and digits only).
+// The API's own field names come first; the rest are dashboard and
+// snake_case spellings.
+var exportFields = map[string][]string{
+	"transaction": {"transactionid", "id", "txid"},
+	"reference":   {"metareference", "reference", "merchantreference", "externalreference"},
+	"amount":      {"destinationamount", "amount"},
+	"currency":    {"destinationcurrency", "currency"},
+	"status":      {"status"},
+	"created":     {"createdat", "created", "date"},
+}
+
+// ParseExport reads an Afriex transaction export. format is "csv" or "json";
+// empty sniffs the content. CSV needs a header row, JSON may be an array of
+// transactions or {"data": [...]}, with nested objects such as meta flattened
+// (meta.reference is the payout ID Waya sends).
+func ParseExport(r io.Reader, format string) ([]domain.ProviderRecord, error) {
+	br := bufio.NewReader(r)
+	if format == "" {
+		format = "csv"
+		if b, err := peekNonSpace(br); err == nil && (b == '[' || b == '{') {
+			format = "json"
+		}
+	}
+
+	var rows []map[string]string
+	var err error
+	switch strings.ToLower(format) {
+	case "csv":
+		rows, err = readExportCSV(br)
+	case "json":
+		rows, err = readExportJSON(br)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", domain.ErrInvalidExport, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var errs domain.ValidationErrors
+	records := make([]domain.ProviderRecord, 0, len(rows))
+	for i, row := range rows {
+		line := i + 1
+		rec := domain.ProviderRecord{
+			Line:          line,
+			TransactionID: field(row, "transaction"),
+			Reference:     field(row, "reference"),
+			Status:        field(row, "status"),
+		}
+		where := fmt.Sprintf("record %d", line)
+		if rec.TransactionID == "" && rec.Reference == "" {
+			errs.Add(where, "has no transaction ID or reference")
+			continue
+		}
+		currency := strings.ToUpper(field(row, "currency"))
+		if rec.Amount, err = domain.ParseMoneyRounded(field(row, "amount"), currency); err != nil {
+			errs.Add(where, "amount: %v", err)
+			continue
+		}
+		if raw := field(row, "created"); raw != "" {
+			if rec.CreatedAt, err = parseExportTime(raw); err != nil {
+				errs.Add(where, "unrecognised date %q", raw)
+				continue
+			}
+		}
+		records = append(records, rec)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return records, nil
+}
+
+func readExportCSV(r io.Reader) ([]map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: header: %v", domain.ErrInvalidExport, err)
+	}
+	for i, h := range header {
+		header[i] = normalizeField(h)
+	}
+
+	var rows []map[string]string
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidExport, err)
+		}
+		row := make(map[string]string, len(header))
+		for i, v := range rec {
+			if i < len(header) {
+				row[header[i]] = strings.TrimSpace(v)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+func readExportJSON(r io.Reader) ([]map[string]string, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidExport, err)
+	}
+	if obj, ok := doc.(map[string]any); ok {
+		doc = obj["data"]
+	}
+	items, ok := doc.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an array of transactions", domain.ErrInvalidExport)
+	}
+
+	rows := make([]map[string]string, 0, len(items))
+	for _, it := range items {
+		obj, ok := it.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected an array of transactions", domain.ErrInvalidExport)
+		}
+		row := make(map[string]string)
+		flatten(row, "", obj)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func flatten(row map[string]string, prefix string, obj map[string]any) {
+	for k, v := range obj {
+		key := prefix + normalizeField(k)
+		switch v := v.(type) {
+		case map[string]any:
+			flatten(row, key, v)
+		case string:
+			row[key] = strings.TrimSpace(v)
+		case json.Number:
+			row[key] = v.String()
+		case nil:
+		default:
+			row[key] = fmt.Sprint(v)
+		}
+	}
+}
+
+func field(row map[string]string, name string) string {
+	for _, k := range exportFields[name] {
+		if v := row[k]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func normalizeField(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// parseExportTime accepts RFC 3339, "2006-01-02 15:04:05", plain dates and
+// Unix timestamps in seconds or milliseconds. Times without a zone are UTC.
+func parseExportTime(raw string) (time.Time, error) {
+	if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		if n > 1e11 {
+			return time.UnixMilli(n).UTC(), nil
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised time %q", raw)
+}
+
+// peekNonSpace returns the first byte after whitespace and a UTF-8 BOM
+// without consuming anything.
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for n := 1; ; n++ {
+		b, err := br.Peek(n)
+		if len(b) < n {
+			return 0, err
+		}
+		switch c := b[n-1]; c {
+		case ' ', '\t', '\r', '\n', 0xEF, 0xBB, 0xBF:
+		default:
+			return c, nil
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package afriex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ListInstitutions fetches the banks (or mobile money operators) Afriex can pay into for a country.
+func (c *Client) ListInstitutions(ctx context.Context, countryCode, channel string) ([]Institution, error) {
+	var resp InstitutionListResponse
+	q := url.Values{}
+	q.Set("countryCode", countryCode)
+	q.Set("channel", channel)
+	path := fmt.Sprintf("/api/v1/payment-method/institutions?%s", q.Encode())
+	if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ResolveAccount looks up the holder name registered on a bank or mobile money account.
+func (c *Client) ResolveAccount(ctx context.Context, req ResolveAccountRequest) (string, error) {
+	var resp ResolveAccountResponse
+	if err := c.do(ctx, "POST", "/api/v1/payment-method/resolve", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.Data.AccountName, nil
+}
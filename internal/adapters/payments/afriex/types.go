@@ -53,6 +53,25 @@ type PaymentMethodResponse struct {
 	} `json:"data"`
 }
 
+// Account name enquiry: who owns this account?
+type ResolveAccountRequest struct {
+	Channel         string `json:"channel"`
+	AccountNumber   string `json:"accountNumber"`
+	CountryCode     string `json:"countryCode"`
+	InstitutionCode string `json:"institutionCode,omitempty"`
+}
+
+type ResolveAccountResponse struct {
+	Data struct {
+		AccountName   string `json:"accountName"`
+		AccountNumber string `json:"accountNumber"`
+	} `json:"data"`
+}
+
+type InstitutionListResponse struct {
+	Data []Institution `json:"data"`
+}
+
 // --- 3. TRANSACTION (The Payout) ---
 type CreateTransactionRequest struct {
 	CustomerID          string            `json:"customerId"`
@@ -69,6 +88,7 @@ type TransactionResponse struct {
 		Status            string `json:"status"`
 		SourceAmount      string `json:"sourceAmount"`
 		DestinationAmount string `json:"destinationAmount"`
+		Fee               string `json:"fee"` // In the source currency; absent when Afriex charges none
 	} `json:"data"`
 }
 
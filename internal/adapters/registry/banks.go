@@ -0,0 +1,56 @@
+package registry
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+
+	"waya/internal/core/domain"
+)
+
+//go:embed banks.yaml
+var defaultBanks []byte
+
+type banksFile struct {
+	Banks map[string][]struct {
+		Code    string `yaml:"code"`
+		Name    string `yaml:"name"`
+		Channel string `yaml:"channel"`
+	} `yaml:"banks"`
+}
+
+// LoadBanks builds the bank directory from path, or from the embedded default
+// when path is empty. YAML and JSON are both accepted.
+func LoadBanks(path string) (*domain.BankDirectory, error) {
+	data := defaultBanks
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read banks file: %w", err)
+		}
+		data = b
+	}
+
+	var f banksFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse banks file: %w", err)
+	}
+
+	var banks []domain.Bank
+	for country, entries := range f.Banks {
+		for _, e := range entries {
+			if e.Code == "" || e.Name == "" {
+				return nil, fmt.Errorf("bank in %s needs a code and a name", country)
+			}
+			channel := e.Channel
+			if channel == "" {
+				channel = domain.ChannelBankAccount
+			}
+			banks = append(banks, domain.Bank{Country: country, Code: e.Code, Name: e.Name, Channel: channel})
+		}
+	}
+
+	return domain.NewBankDirectory(banks), nil
+}
@@ -0,0 +1,89 @@
+package registry
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+
+	"waya/internal/core/domain"
+)
+
+//go:embed corridors.yaml
+var defaultCorridors []byte
+
+type corridorsFile struct {
+	Corridors []corridorEntry `yaml:"corridors"`
+}
+
+type corridorEntry struct {
+	Country        string   `yaml:"country"`
+	Name           string   `yaml:"name"`
+	RequiredFields []string `yaml:"required_fields"`
+	Currencies     []struct {
+		Code        string `yaml:"code"`
+		MinAmount   string `yaml:"min_amount"`
+		MaxAmount   string `yaml:"max_amount"`
+		ReviewAbove string `yaml:"review_above"`
+	} `yaml:"currencies"`
+	Channels []struct {
+		Type           string   `yaml:"type"`
+		RequiredFields []string `yaml:"required_fields"`
+	} `yaml:"channels"`
+}
+
+// LoadCorridors builds the corridor registry from path, or from the embedded
+// default when path is empty. YAML and JSON are both accepted.
+func LoadCorridors(path string) (*domain.CorridorRegistry, error) {
+	data := defaultCorridors
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read corridors file: %w", err)
+		}
+		data = b
+	}
+
+	var f corridorsFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse corridors file: %w", err)
+	}
+
+	corridors := make([]domain.Corridor, 0, len(f.Corridors))
+	for _, e := range f.Corridors {
+		c := domain.Corridor{
+			Country:        e.Country,
+			Name:           e.Name,
+			RequiredFields: e.RequiredFields,
+		}
+		for _, cur := range e.Currencies {
+			min, err := parseBound(cur.MinAmount, cur.Code)
+			if err != nil {
+				return nil, fmt.Errorf("corridor %s min_amount: %w", e.Country, err)
+			}
+			max, err := parseBound(cur.MaxAmount, cur.Code)
+			if err != nil {
+				return nil, fmt.Errorf("corridor %s max_amount: %w", e.Country, err)
+			}
+			review, err := parseBound(cur.ReviewAbove, cur.Code)
+			if err != nil {
+				return nil, fmt.Errorf("corridor %s review_above: %w", e.Country, err)
+			}
+			c.Currencies = append(c.Currencies, domain.CorridorCurrency{Code: cur.Code, MinAmount: min, MaxAmount: max, ReviewAbove: review})
+		}
+		for _, ch := range e.Channels {
+			c.Channels = append(c.Channels, domain.CorridorChannel{Type: ch.Type, RequiredFields: ch.RequiredFields})
+		}
+		corridors = append(corridors, c)
+	}
+
+	return domain.NewCorridorRegistry(corridors)
+}
+
+func parseBound(amount, currency string) (domain.Money, error) {
+	if amount == "" {
+		return domain.NewMoney(0, currency), nil
+	}
+	return domain.ParseMoney(amount, currency)
+}
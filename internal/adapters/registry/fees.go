@@ -0,0 +1,74 @@
+package registry
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"waya/internal/core/domain"
+)
+
+//go:embed fees.yaml
+var defaultFees []byte
+
+type feesFile struct {
+	Schedules []struct {
+		Tenant string `yaml:"tenant"`
+		Rules  []struct {
+			Country  string `yaml:"country"`
+			Currency string `yaml:"currency"`
+			Flat     string `yaml:"flat"`
+			Percent  string `yaml:"percent"`
+			Min      string `yaml:"min"`
+			Max      string `yaml:"max"`
+		} `yaml:"rules"`
+	} `yaml:"schedules"`
+}
+
+// LoadFees builds the tenants' fee schedules from path, or from the embedded
+// default when path is empty. YAML and JSON are both accepted.
+func LoadFees(path string) (*domain.FeeSchedules, error) {
+	data := defaultFees
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read fees file: %w", err)
+		}
+		data = b
+	}
+
+	var f feesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse fees file: %w", err)
+	}
+
+	schedules := make([]domain.FeeSchedule, 0, len(f.Schedules))
+	for _, s := range f.Schedules {
+		schedule := domain.FeeSchedule{TenantID: s.Tenant}
+		for _, e := range s.Rules {
+			currency := strings.ToUpper(e.Currency)
+			rule := domain.FeeRule{Country: e.Country, Currency: currency, Percent: e.Percent}
+			if currency != domain.FeeAnyCurrency {
+				var err error
+				if rule.Flat, err = parseBound(e.Flat, currency); err != nil {
+					return nil, fmt.Errorf("fees %s %s flat: %w", s.Tenant, currency, err)
+				}
+				if rule.Min, err = parseBound(e.Min, currency); err != nil {
+					return nil, fmt.Errorf("fees %s %s min: %w", s.Tenant, currency, err)
+				}
+				if rule.Max, err = parseBound(e.Max, currency); err != nil {
+					return nil, fmt.Errorf("fees %s %s max: %w", s.Tenant, currency, err)
+				}
+			} else if e.Flat != "" || e.Min != "" || e.Max != "" {
+				return nil, fmt.Errorf("fees %s: %w: a %q rule can only set percent", s.Tenant, domain.ErrInvalidFeeRule, domain.FeeAnyCurrency)
+			}
+			schedule.Rules = append(schedule.Rules, rule)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return domain.NewFeeSchedules(schedules)
+}
@@ -0,0 +1,67 @@
+package registry
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+
+	"waya/internal/core/domain"
+)
+
+//go:embed limits.yaml
+var defaultLimits []byte
+
+type limitsFile struct {
+	Limits []struct {
+		Country           string `yaml:"country"`
+		Currency          string `yaml:"currency"`
+		PerPayout         string `yaml:"per_payout"`
+		DailyPerRecipient string `yaml:"daily_per_recipient"`
+		Monthly           string `yaml:"monthly"`
+	} `yaml:"limits"`
+}
+
+// LoadLimits builds the limit registry from path, or from the embedded
+// default when path is empty. YAML and JSON are both accepted.
+func LoadLimits(path string) (*domain.LimitRegistry, error) {
+	data := defaultLimits
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read limits file: %w", err)
+		}
+		data = b
+	}
+
+	var f limitsFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse limits file: %w", err)
+	}
+
+	limits := make([]domain.CorridorLimits, 0, len(f.Limits))
+	for _, e := range f.Limits {
+		perPayout, err := parseBound(e.PerPayout, e.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("limits %s per_payout: %w", e.Country, err)
+		}
+		daily, err := parseBound(e.DailyPerRecipient, e.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("limits %s daily_per_recipient: %w", e.Country, err)
+		}
+		monthly, err := parseBound(e.Monthly, e.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("limits %s monthly: %w", e.Country, err)
+		}
+		limits = append(limits, domain.CorridorLimits{
+			Country:           e.Country,
+			Currency:          e.Currency,
+			PerPayout:         perPayout,
+			DailyPerRecipient: daily,
+			Monthly:           monthly,
+		})
+	}
+
+	return domain.NewLimitRegistry(limits)
+}
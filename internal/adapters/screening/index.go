@@ -0,0 +1,179 @@
+package screening
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// Entry is one name (primary or alias) on a watchlist.
+type Entry struct {
+	ID   string
+	Name string
+}
+
+// List is a parsed watchlist file.
+type List struct {
+	Name    string // "OFAC", "UN", "EU"
+	Version string // Publish date when the file carries one, otherwise a content hash
+	Entries []Entry
+}
+
+type indexedName struct {
+	list   string
+	id     string
+	name   string
+	tokens []string
+	skels  []string
+}
+
+// Index screens names against every loaded list. It is read-only after
+// construction and safe for concurrent use.
+type Index struct {
+	names     []indexedName
+	byKey     map[string][]int
+	version   string
+	threshold float64
+}
+
+var _ ports.SanctionsScreener = (*Index)(nil)
+
+// NewIndex builds the lookup structures. Names scoring at or above threshold
+// (0..1) are reported as hits.
+func NewIndex(lists []List, threshold float64) *Index {
+	ix := &Index{byKey: make(map[string][]int), threshold: threshold}
+
+	versions := make([]string, 0, len(lists))
+	for _, l := range lists {
+		versions = append(versions, l.Name+"@"+l.Version)
+		for _, e := range l.Entries {
+			tokens := canonicalTokens(e.Name)
+			if len(tokens) == 0 {
+				continue
+			}
+			n := indexedName{list: l.Name, id: e.ID, name: e.Name, tokens: tokens}
+			for _, t := range tokens {
+				n.skels = append(n.skels, skeleton(t))
+			}
+
+			pos := len(ix.names)
+			ix.names = append(ix.names, n)
+			for _, k := range blockingKeys(n.tokens, n.skels) {
+				ix.byKey[k] = append(ix.byKey[k], pos)
+			}
+		}
+	}
+	sort.Strings(versions)
+	ix.version = strings.Join(versions, ",")
+	return ix
+}
+
+// Version identifies the list files the index was built from.
+func (ix *Index) Version() string {
+	return ix.version
+}
+
+// Size is the number of indexed names, aliases included.
+func (ix *Index) Size() int {
+	return len(ix.names)
+}
+
+// Screen returns every listed entry whose best-matching name scores at or
+// above the threshold, strongest first.
+func (ix *Index) Screen(ctx context.Context, name string) (domain.ScreeningResult, error) {
+	result := domain.ScreeningResult{ListVersion: ix.version}
+
+	tokens := canonicalTokens(name)
+	if len(tokens) == 0 {
+		return result, nil
+	}
+	skels := make([]string, len(tokens))
+	for i, t := range tokens {
+		skels[i] = skeleton(t)
+	}
+
+	// Candidates must share a key with enough distinct query tokens.
+	shared := make(map[int]map[int]bool)
+	for qi := range tokens {
+		for _, k := range blockingKeys(tokens[qi:qi+1], skels[qi:qi+1]) {
+			for _, pos := range ix.byKey[k] {
+				if shared[pos] == nil {
+					shared[pos] = make(map[int]bool)
+				}
+				shared[pos][qi] = true
+			}
+		}
+	}
+
+	best := make(map[string]domain.ScreeningHit)
+	for pos, qs := range shared {
+		n := ix.names[pos]
+		if len(qs) < min(2, len(tokens), len(n.tokens)) {
+			continue
+		}
+		score := nameScore(tokens, skels, n.tokens, n.skels)
+		if score < ix.threshold {
+			continue
+		}
+		key := n.list + "/" + n.id
+		if prev, ok := best[key]; !ok || score > prev.Score {
+			best[key] = domain.ScreeningHit{List: n.list, EntryID: n.id, Name: n.name, Score: score}
+		}
+	}
+
+	for _, h := range best {
+		result.Hits = append(result.Hits, h)
+	}
+	sort.Slice(result.Hits, func(i, j int) bool {
+		if result.Hits[i].Score != result.Hits[j].Score {
+			return result.Hits[i].Score > result.Hits[j].Score
+		}
+		return result.Hits[i].EntryID < result.Hits[j].EntryID
+	})
+	return result, nil
+}
+
+func blockingKeys(tokens, skels []string) []string {
+	keys := make([]string, 0, len(tokens)*2)
+	for i, t := range tokens {
+		keys = append(keys, "t:"+t)
+		if len(skels[i]) >= 2 {
+			keys = append(keys, "s:"+skels[i])
+		}
+	}
+	return keys
+}
+
+// nameScore compares two tokenised names regardless of word order. Every
+// token of the shorter name must find a close partner in the longer one, and
+// a single-word name only ever matches another single-word name.
+func nameScore(aTokens, aSkels, bTokens, bSkels []string) float64 {
+	if len(aTokens) > len(bTokens) {
+		aTokens, aSkels, bTokens, bSkels = bTokens, bSkels, aTokens, aSkels
+	}
+	if len(aTokens) == 1 && len(bTokens) > 1 {
+		return 0
+	}
+
+	var sum float64
+	for i := range aTokens {
+		var top float64
+		for j := range bTokens {
+			if s := tokenScore(aTokens[i], aSkels[i], bTokens[j], bSkels[j]); s > top {
+				top = s
+			}
+		}
+		sum += top
+	}
+	return sum / float64(len(aTokens))
+}
+
+func tokenScore(a, aSkel, b, bSkel string) float64 {
+	if a == b {
+		return 1
+	}
+	return (domain.EditSimilarity(a, b) + domain.EditSimilarity(aSkel, bSkel)) / 2
+}
@@ -0,0 +1,415 @@
+package screening
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LoadDir parses every watchlist file in dir. Supported formats:
+//
+//   - OFAC SDN CSV (sdn.csv + alt.csv) and consolidated CSV (cons_prim.csv + cons_alt.csv)
+//   - OFAC SDN XML (sdn.xml)
+//   - UN Security Council consolidated list XML
+//   - EU financial sanctions (FSF) XML
+//   - Any other CSV with a header row containing a "name" column (and optionally "id")
+func LoadDir(dir string) ([]List, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	byName := make(map[string]*List)
+	var order []string
+	add := func(l *List) {
+		if existing, ok := byName[l.Name]; ok {
+			existing.Entries = append(existing.Entries, l.Entries...)
+			existing.Version = combineVersions(existing.Version, l.Version)
+			return
+		}
+		byName[l.Name] = l
+		order = append(order, l.Name)
+	}
+
+	for _, path := range paths {
+		base := strings.ToLower(filepath.Base(path))
+		ext := filepath.Ext(base)
+		if ext != ".csv" && ext != ".xml" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+
+		var l *List
+		switch {
+		case base == "sdn.csv":
+			l, err = parseOFACPrimary(data, "OFAC")
+		case base == "alt.csv":
+			l, err = parseOFACAliases(data, "OFAC")
+		case base == "cons_prim.csv":
+			l, err = parseOFACPrimary(data, "OFAC-CONS")
+		case base == "cons_alt.csv":
+			l, err = parseOFACAliases(data, "OFAC-CONS")
+		case base == "add.csv" || base == "sdn_comments.csv" || base == "cons_add.csv":
+			continue // addresses and remarks carry no names
+		case ext == ".xml":
+			l, err = parseXML(data)
+		default:
+			l, err = parseGenericCSV(data, strings.ToUpper(strings.TrimSuffix(base, ext)))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		add(l)
+	}
+
+	if len(order) == 0 {
+		return nil, fmt.Errorf("no watchlist files found in %s", dir)
+	}
+	lists := make([]List, 0, len(order))
+	for _, name := range order {
+		lists = append(lists, *byName[name])
+	}
+	return lists, nil
+}
+
+func contentVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+func combineVersions(a, b string) string {
+	if a == b {
+		return a
+	}
+	return a + "+" + b
+}
+
+// ofacValue trims a CSV field and blanks "-0-", OFAC's empty-field placeholder.
+func ofacValue(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "-0-" {
+		return ""
+	}
+	return s
+}
+
+// ofacName turns "LASTNAME, Firstname" into "Firstname LASTNAME".
+func ofacName(s string) string {
+	last, first, ok := strings.Cut(s, ",")
+	if !ok {
+		return strings.TrimSpace(s)
+	}
+	return strings.TrimSpace(first) + " " + strings.TrimSpace(last)
+}
+
+func newCSVReader(data []byte) *csv.Reader {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	return r
+}
+
+// sdn.csv: ent_num, SDN_Name, SDN_Type, Program, ...
+func parseOFACPrimary(data []byte, list string) (*List, error) {
+	l := &List{Name: list, Version: contentVersion(data)}
+	r := newCSVReader(data)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rec) < 2 {
+			continue
+		}
+		id, name := ofacValue(rec[0]), ofacValue(rec[1])
+		if id == "" || name == "" {
+			continue
+		}
+		l.Entries = append(l.Entries, Entry{ID: id, Name: ofacName(name)})
+	}
+	return l, nil
+}
+
+// alt.csv: ent_num, alt_num, alt_type, alt_name, alt_remarks
+func parseOFACAliases(data []byte, list string) (*List, error) {
+	l := &List{Name: list, Version: contentVersion(data)}
+	r := newCSVReader(data)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rec) < 4 {
+			continue
+		}
+		id, name := ofacValue(rec[0]), ofacValue(rec[3])
+		if id == "" || name == "" {
+			continue
+		}
+		l.Entries = append(l.Entries, Entry{ID: id, Name: ofacName(name)})
+	}
+	return l, nil
+}
+
+// parseGenericCSV reads in-house or third-party lists with a header row.
+func parseGenericCSV(data []byte, list string) (*List, error) {
+	l := &List{Name: list, Version: contentVersion(data)}
+	r := newCSVReader(data)
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	nameCol, idCol := -1, -1
+	for i, h := range header {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "name", "full_name", "whole_name":
+			nameCol = i
+		case "id", "uid", "reference":
+			idCol = i
+		}
+	}
+	if nameCol < 0 {
+		return nil, errors.New(`csv header has no "name" column`)
+	}
+
+	row := 1
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		row++
+		if nameCol >= len(rec) || strings.TrimSpace(rec[nameCol]) == "" {
+			continue
+		}
+		id := fmt.Sprintf("row-%d", row)
+		if idCol >= 0 && idCol < len(rec) && strings.TrimSpace(rec[idCol]) != "" {
+			id = strings.TrimSpace(rec[idCol])
+		}
+		l.Entries = append(l.Entries, Entry{ID: id, Name: strings.TrimSpace(rec[nameCol])})
+	}
+	return l, nil
+}
+
+// parseXML detects the list by its root element.
+func parseXML(data []byte) (*List, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("no root element: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "sdnList":
+			return parseOFACXML(dec, data)
+		case "CONSOLIDATED_LIST":
+			return parseUNXML(dec, start, data)
+		case "export":
+			return parseEUXML(dec, start, data)
+		default:
+			return nil, fmt.Errorf("unrecognised watchlist root element <%s>", start.Name.Local)
+		}
+	}
+}
+
+func attr(start xml.StartElement, name string) string {
+	for _, a := range start.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func joinName(parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+type ofacSDNEntry struct {
+	UID       string `xml:"uid"`
+	FirstName string `xml:"firstName"`
+	LastName  string `xml:"lastName"`
+	AKAs      []struct {
+		Category  string `xml:"category"`
+		FirstName string `xml:"firstName"`
+		LastName  string `xml:"lastName"`
+	} `xml:"akaList>aka"`
+}
+
+func parseOFACXML(dec *xml.Decoder, data []byte) (*List, error) {
+	l := &List{Name: "OFAC", Version: contentVersion(data)}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "Publish_Date":
+			var d string
+			if err := dec.DecodeElement(&d, &start); err != nil {
+				return nil, err
+			}
+			if d = strings.TrimSpace(d); d != "" {
+				l.Version = d
+			}
+		case "sdnEntry":
+			var e ofacSDNEntry
+			if err := dec.DecodeElement(&e, &start); err != nil {
+				return nil, err
+			}
+			if name := joinName(e.FirstName, e.LastName); name != "" {
+				l.Entries = append(l.Entries, Entry{ID: e.UID, Name: name})
+			}
+			for _, a := range e.AKAs {
+				if strings.EqualFold(a.Category, "weak") {
+					continue // OFAC flags these as too generic to screen on
+				}
+				if name := joinName(a.FirstName, a.LastName); name != "" {
+					l.Entries = append(l.Entries, Entry{ID: e.UID, Name: name})
+				}
+			}
+		}
+	}
+	return l, nil
+}
+
+type unRecord struct {
+	DataID            string    `xml:"DATAID"`
+	Reference         string    `xml:"REFERENCE_NUMBER"`
+	FirstName         string    `xml:"FIRST_NAME"`
+	SecondName        string    `xml:"SECOND_NAME"`
+	ThirdName         string    `xml:"THIRD_NAME"`
+	FourthName        string    `xml:"FOURTH_NAME"`
+	IndividualAliases []unAlias `xml:"INDIVIDUAL_ALIAS"`
+	EntityAliases     []unAlias `xml:"ENTITY_ALIAS"`
+}
+
+type unAlias struct {
+	Quality string `xml:"QUALITY"`
+	Name    string `xml:"ALIAS_NAME"`
+}
+
+func parseUNXML(dec *xml.Decoder, root xml.StartElement, data []byte) (*List, error) {
+	l := &List{Name: "UN", Version: contentVersion(data)}
+	if d := attr(root, "dateGenerated"); d != "" {
+		l.Version = d
+	}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || (start.Name.Local != "INDIVIDUAL" && start.Name.Local != "ENTITY") {
+			continue
+		}
+		var r unRecord
+		if err := dec.DecodeElement(&r, &start); err != nil {
+			return nil, err
+		}
+		id := r.Reference
+		if id == "" {
+			id = r.DataID
+		}
+		if name := joinName(r.FirstName, r.SecondName, r.ThirdName, r.FourthName); name != "" {
+			l.Entries = append(l.Entries, Entry{ID: id, Name: name})
+		}
+		for _, a := range append(r.IndividualAliases, r.EntityAliases...) {
+			if a.Name == "" || strings.EqualFold(a.Quality, "Low") {
+				continue
+			}
+			l.Entries = append(l.Entries, Entry{ID: id, Name: a.Name})
+		}
+	}
+	return l, nil
+}
+
+type euEntity struct {
+	LogicalID   string `xml:"logicalId,attr"`
+	EUReference string `xml:"euReferenceNumber,attr"`
+	Aliases     []struct {
+		WholeName string `xml:"wholeName,attr"`
+		FirstName string `xml:"firstName,attr"`
+		LastName  string `xml:"lastName,attr"`
+	} `xml:"nameAlias"`
+}
+
+func parseEUXML(dec *xml.Decoder, root xml.StartElement, data []byte) (*List, error) {
+	l := &List{Name: "EU", Version: contentVersion(data)}
+	if d := attr(root, "generationDate"); d != "" {
+		l.Version = d
+	}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "sanctionEntity" {
+			continue
+		}
+		var e euEntity
+		if err := dec.DecodeElement(&e, &start); err != nil {
+			return nil, err
+		}
+		id := e.EUReference
+		if id == "" {
+			id = e.LogicalID
+		}
+		for _, a := range e.Aliases {
+			name := a.WholeName
+			if name == "" {
+				name = joinName(a.FirstName, a.LastName)
+			}
+			if name != "" {
+				l.Entries = append(l.Entries, Entry{ID: id, Name: name})
+			}
+		}
+	}
+	return l, nil
+}
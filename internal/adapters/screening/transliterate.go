@@ -0,0 +1,76 @@
+package screening
+
+import (
+	"strings"
+
+	"waya/internal/core/domain"
+)
+
+// cyrillic maps Russian/Ukrainian letters to their common Latin spelling so
+// "Иванов" and "Ivanov" land on the same key.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "i", 'є': "ye", 'ґ': "g",
+}
+
+// spellings folds the usual transliteration variants together, e.g.
+// Mohammed/Muhammad, Yusuf/Youssef, Khalid/Halid. Order matters: longer
+// clusters are rewritten before the letters they contain.
+var spellings = strings.NewReplacer(
+	"shch", "sh", "sch", "sh", "tch", "ch", "dzh", "j", "dj", "j", "zh", "j",
+	"kh", "h", "gh", "g", "ph", "f", "th", "t", "dh", "d", "ck", "k",
+	"ou", "u", "oo", "u", "ee", "i", "ie", "i", "ey", "i", "ay", "ai",
+	"q", "k", "c", "k", "w", "v", "y", "i", "x", "ks", "'", "",
+)
+
+// canonicalTokens turns a name into comparable tokens: Latin script, no
+// accents or honorifics, transliteration variants folded, doubled letters collapsed.
+func canonicalTokens(name string) []string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if lat, ok := cyrillic[r]; ok {
+			b.WriteString(lat)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	tokens := domain.NormalizeName(b.String())
+	out := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		t = collapseRepeats(spellings.Replace(t))
+		if t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// skeleton keeps the consonant outline of a token ("muhamad" -> "mhmd"), which
+// survives the vowel differences transliteration introduces.
+func skeleton(token string) string {
+	var b strings.Builder
+	for i, r := range token {
+		if i > 0 && strings.ContainsRune("aeiou", r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return collapseRepeats(b.String())
+}
+
+func collapseRepeats(s string) string {
+	var b strings.Builder
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
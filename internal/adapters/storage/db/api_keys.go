@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.APIKeyStore = (*SQLiteRepo)(nil)
+
+func (r *SQLiteRepo) CreateAPIKey(ctx context.Context, k domain.APIKey, keyHash string, signingKey []byte) error {
+	return r.insertAPIKey(ctx, r.q, k, keyHash, signingKey)
+}
+
+func (r *SQLiteRepo) insertAPIKey(ctx context.Context, q *Queries, k domain.APIKey, keyHash string, signingKey []byte) error {
+	secret, err := r.pii.sealSigningKey(k.ID, signingKey)
+	if err != nil {
+		return err
+	}
+	return q.CreateAPIKey(ctx, CreateAPIKeyParams{
+		ID:            k.ID,
+		TenantID:      k.TenantID,
+		Name:          k.Name,
+		Prefix:        k.Prefix,
+		KeyHash:       keyHash,
+		Scopes:        strings.Join(k.Scopes, " "),
+		CreatedBy:     k.CreatedBy,
+		ExpiresAt:     nullTime(k.ExpiresAt),
+		SignedOnly:    k.SignedOnly,
+		SigningSecret: secret,
+	})
+}
+
+func (r *SQLiteRepo) SetAPIKeySigningKey(ctx context.Context, id string, signingKey []byte) error {
+	secret, err := r.pii.sealSigningKey(id, signingKey)
+	if err != nil {
+		return err
+	}
+	return r.q.SetAPIKeySigningSecret(ctx, SetAPIKeySigningSecretParams{SigningSecret: secret, ID: id})
+}
+
+func (r *SQLiteRepo) FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	row, err := r.q.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	k := toDomainAPIKey(GetAPIKeyRow(row))
+	return &k, nil
+}
+
+func (r *SQLiteRepo) FindAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, []byte, error) {
+	row, err := r.q.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, nil, err
+	}
+	signingKey, err := r.pii.openSigningKey(ctx, row.ID, row.SigningSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+	k := toDomainAPIKey(GetAPIKeyRow{
+		ID:         row.ID,
+		TenantID:   row.TenantID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		Scopes:     row.Scopes,
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt,
+		ExpiresAt:  row.ExpiresAt,
+		LastUsedAt: row.LastUsedAt,
+		RevokedAt:  row.RevokedAt,
+		RevokedBy:  row.RevokedBy,
+		ReplacedBy: row.ReplacedBy,
+		SignedOnly: row.SignedOnly,
+	})
+	return &k, signingKey, nil
+}
+
+func (r *SQLiteRepo) GetAPIKey(ctx context.Context, tenantID, id string) (*domain.APIKey, error) {
+	row, err := r.q.GetAPIKey(ctx, GetAPIKeyParams{TenantID: tenantID, ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	k := toDomainAPIKey(row)
+	return &k, nil
+}
+
+func (r *SQLiteRepo) ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error) {
+	rows, err := r.q.ListAPIKeys(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]domain.APIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, toDomainAPIKey(GetAPIKeyRow(row)))
+	}
+	return keys, nil
+}
+
+func (r *SQLiteRepo) TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error {
+	return r.q.TouchAPIKey(ctx, TouchAPIKeyParams{ID: id, UsedAt: nullTime(usedAt)})
+}
+
+func (r *SQLiteRepo) RevokeAPIKey(ctx context.Context, tenantID, id, by string) error {
+	n, err := r.q.RevokeAPIKey(ctx, RevokeAPIKeyParams{
+		RevokedBy: sql.NullString{String: by, Valid: by != ""},
+		TenantID:  tenantID,
+		ID:        id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrAPIKeyRevoked
+	}
+	return nil
+}
+
+// RotateAPIKey marks old as replaced and stores next in one transaction, so
+// two rotations of the same key cannot both issue a successor.
+func (r *SQLiteRepo) RotateAPIKey(ctx context.Context, old domain.APIKey, oldExpiresAt time.Time, next domain.APIKey, nextHash string, nextSigningKey []byte) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		n, err := q.ReplaceAPIKey(ctx, ReplaceAPIKeyParams{
+			ReplacedBy: sql.NullString{String: next.ID, Valid: true},
+			ExpiresAt:  nullTime(oldExpiresAt),
+			TenantID:   old.TenantID,
+			ID:         old.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return domain.ErrAPIKeyReplaced
+		}
+		return r.insertAPIKey(ctx, q, next, nextHash, nextSigningKey)
+	})
+}
+
+func toDomainAPIKey(row GetAPIKeyRow) domain.APIKey {
+	return domain.APIKey{
+		ID:         row.ID,
+		TenantID:   row.TenantID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		Scopes:     strings.Fields(row.Scopes),
+		CreatedBy:  row.CreatedBy,
+		CreatedAt:  row.CreatedAt,
+		ExpiresAt:  row.ExpiresAt.Time,
+		LastUsedAt: row.LastUsedAt.Time,
+		RevokedAt:  row.RevokedAt.Time,
+		RevokedBy:  row.RevokedBy.String,
+		ReplacedBy: row.ReplacedBy.String,
+		SignedOnly: row.SignedOnly,
+	}
+}
+
+// sealSigningKey seals a key's request signing secret. Without a master key
+// there is nowhere safe to keep it, so nothing is stored and the key cannot
+// sign requests.
+func (c *PIICipher) sealSigningKey(id string, signingKey []byte) (sql.NullString, error) {
+	if c == nil || len(signingKey) == 0 {
+		return sql.NullString{}, nil
+	}
+	sealed, err := c.seal(hex.EncodeToString(signingKey), "api_keys.signing_secret/"+id)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: sealed, Valid: true}, nil
+}
+
+// openSigningKey is the reverse of sealSigningKey; a key without a signing
+// secret gets nil.
+func (c *PIICipher) openSigningKey(ctx context.Context, id string, secret sql.NullString) ([]byte, error) {
+	if !secret.Valid {
+		return nil, nil
+	}
+	if sealedWith(secret.String) == "" {
+		return nil, fmt.Errorf("signing secret of api key %s is not sealed", id)
+	}
+	plain, err := c.open(ctx, secret.String, "api_keys.signing_secret/"+id)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(plain)
+}
+
+// nullTime stores a zero time as NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t.UTC(), Valid: !t.IsZero()}
+}
+
+// nullString stores an empty string as NULL
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.AuditStore = (*SQLiteRepo)(nil)
+
+// auditTimeRange bounds queries without From or To
+var auditTimeRange = [2]time.Time{
+	time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+}
+
+// AppendAudit reads the last entry and writes the next in one transaction.
+// Transactions take the write lock up front, so writers in other processes
+// queue rather than fork the chain.
+func (r *SQLiteRepo) AppendAudit(ctx context.Context, e domain.AuditEntry) (*domain.AuditEntry, error) {
+	err := r.withTx(ctx, func(q *Queries) error {
+		e.ID, e.PrevHash = 1, domain.GenesisHash
+		last, err := q.LastAuditEntry(ctx)
+		switch {
+		case err == nil:
+			e.ID, e.PrevHash = last.ID+1, last.Hash
+		case err != sql.ErrNoRows:
+			return err
+		}
+		e.At = e.At.UTC()
+		e.Hash = e.ComputeHash()
+		return q.InsertAuditEntry(ctx, InsertAuditEntryParams{
+			ID:          e.ID,
+			At:          e.At,
+			TenantID:    e.TenantID,
+			Actor:       e.Actor,
+			Principal:   e.Principal,
+			Action:      e.Action,
+			TargetType:  e.TargetType,
+			TargetID:    e.TargetID,
+			RequestID:   e.RequestID,
+			Ip:          e.IP,
+			BeforeState: e.Before,
+			AfterState:  e.After,
+			PrevHash:    e.PrevHash,
+			Hash:        e.Hash,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *SQLiteRepo) ListAudit(ctx context.Context, f domain.AuditFilter) ([]domain.AuditEntry, error) {
+	params := ListAuditEntriesParams{
+		TenantID:   f.TenantID,
+		Actor:      f.Actor,
+		Action:     f.Action,
+		TargetType: f.TargetType,
+		TargetID:   f.TargetID,
+		RequestID:  f.RequestID,
+		Since:      auditTimeRange[0],
+		Until:      auditTimeRange[1],
+		BeforeID:   math.MaxInt64,
+		Limit:      int64(f.Limit),
+	}
+	if !f.From.IsZero() {
+		params.Since = f.From.UTC()
+	}
+	if !f.To.IsZero() {
+		params.Until = f.To.UTC()
+	}
+	if f.BeforeID > 0 {
+		params.BeforeID = f.BeforeID
+	}
+	rows, err := r.q.ListAuditEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAuditEntries(rows), nil
+}
+
+func (r *SQLiteRepo) ScanAudit(ctx context.Context, afterID int64, limit int) ([]domain.AuditEntry, error) {
+	rows, err := r.q.ScanAuditLog(ctx, ScanAuditLogParams{AfterID: afterID, Limit: int64(limit)})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAuditEntries(rows), nil
+}
+
+func toDomainAuditEntries(rows []AuditLog) []domain.AuditEntry {
+	entries := make([]domain.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, domain.AuditEntry{
+			ID:         row.ID,
+			At:         row.At,
+			TenantID:   row.TenantID,
+			Actor:      row.Actor,
+			Principal:  row.Principal,
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			RequestID:  row.RequestID,
+			IP:         row.Ip,
+			Before:     row.BeforeState,
+			After:      row.AfterState,
+			PrevHash:   row.PrevHash,
+			Hash:       row.Hash,
+		})
+	}
+	return entries
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// CreateBatch stores the batch, its payouts and the SUBMITTED audit event in
+// one transaction so a half-saved batch can never be approved or executed.
+// Guards run first inside the same transaction, so limit and balance checks
+// (and the reservations they post) cannot interleave with another batch.
+func (r *SQLiteRepo) CreateBatch(ctx context.Context, b domain.Batch, submitted domain.BatchEvent, guards ...ports.BatchGuard) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		for _, guard := range guards {
+			if err := guard(ctx, txStore{q: q, pii: r.pii}); err != nil {
+				return err
+			}
+		}
+		err := q.CreateBatch(ctx, CreateBatchParams{
+			ID:             b.ID,
+			TenantID:       b.TenantID,
+			TotalAmount:    b.TotalAmount,
+			TotalCount:     int64(b.TotalCount),
+			Status:         b.Status,
+			Reference:      b.Reference,
+			SubmittedBy:    b.SubmittedBy,
+			ApprovalStatus: b.ApprovalStatus,
+			ApprovalReason: b.ApprovalReason,
+			ApiKeyID:       b.APIKeyID,
+		})
+		if err != nil {
+			return err
+		}
+		for _, p := range b.Payouts {
+			if err := r.insertPayout(ctx, q, p); err != nil {
+				return err
+			}
+		}
+		return insertBatchEvent(ctx, q, submitted)
+	})
+}
+
+// GetBatch returns the batch row and its audit trail, without payouts.
+// Batches created before the batches table existed return ErrBatchNotFound.
+func (r *SQLiteRepo) GetBatch(ctx context.Context, tenantID, id string) (*domain.Batch, error) {
+	row, err := r.q.GetBatch(ctx, GetBatchParams{TenantID: tenantID, ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrBatchNotFound
+		}
+		return nil, err
+	}
+	events, err := r.q.ListBatchEvents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &domain.Batch{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		Reference:      row.Reference,
+		SubmittedBy:    row.SubmittedBy,
+		APIKeyID:       row.ApiKeyID,
+		ApprovalStatus: row.ApprovalStatus,
+		ApprovalReason: row.ApprovalReason,
+		CreatedAt:      row.CreatedAt.Time,
+	}
+	for _, e := range events {
+		b.Approvals = append(b.Approvals, domain.BatchEvent{
+			ID:        e.ID,
+			BatchID:   e.BatchID,
+			Actor:     e.Actor,
+			Action:    e.Action,
+			Comment:   e.Comment,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return b, nil
+}
+
+// DecideBatch moves a PENDING batch to APPROVED or REJECTED, moves its
+// AWAITING_APPROVAL payouts to payoutStatus and records the audit event, all
+// atomically. A batch that is no longer pending fails with
+// ErrBatchNotAwaitingApproval, so two checkers cannot both act on it.
+func (r *SQLiteRepo) DecideBatch(ctx context.Context, id, approvalStatus, payoutStatus, payoutMsg string, event domain.BatchEvent) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		status := domain.BatchProcessing
+		if approvalStatus == domain.ApprovalRejected {
+			status = domain.BatchRejected
+		}
+		n, err := q.DecideBatchApproval(ctx, DecideBatchApprovalParams{ID: id, ApprovalStatus: approvalStatus, Status: status})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return domain.ErrBatchNotAwaitingApproval
+		}
+		err = q.TransitionBatchPayouts(ctx, TransitionBatchPayoutsParams{
+			NewStatus:    payoutStatus,
+			ErrorMessage: sql.NullString{String: payoutMsg, Valid: payoutMsg != ""},
+			BatchID:      sql.NullString{String: id, Valid: true},
+			OldStatus:    domain.StatusAwaitingApproval,
+		})
+		if err != nil {
+			return err
+		}
+		return insertBatchEvent(ctx, q, event)
+	})
+}
+
+func insertBatchEvent(ctx context.Context, q *Queries, e domain.BatchEvent) error {
+	return q.CreateBatchEvent(ctx, CreateBatchEventParams{
+		ID:      e.ID,
+		BatchID: e.BatchID,
+		Actor:   e.Actor,
+		Action:  e.Action,
+		Comment: e.Comment,
+	})
+}
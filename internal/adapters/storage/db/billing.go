@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"waya/internal/core/domain"
+)
+
+func (r *SQLiteRepo) ListFeeCharges(ctx context.Context, tenantID string, from, to time.Time) ([]domain.FeeCharge, error) {
+	rows, err := r.q.ListTenantFeeCharges(ctx, ListTenantFeeChargesParams{
+		TenantID: tenantID,
+		FromTime: from.UTC(),
+		ToTime:   to.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	charges := make([]domain.FeeCharge, 0, len(rows))
+	for _, row := range rows {
+		payout := row.PayoutAmount
+		if row.Amount > 0 {
+			payout = -payout // A reversal debiting FEES refunds the charge
+		}
+		charges = append(charges, domain.FeeCharge{
+			PayoutID:  row.Reference,
+			BatchID:   row.BatchID.String,
+			Country:   row.CountryCode,
+			Amount:    domain.NewMoney(payout, row.Currency),
+			Fee:       domain.NewMoney(-row.Amount, row.Currency), // FEES is credit-normal
+			ChargedAt: row.CreatedAt,
+		})
+	}
+	return charges, nil
+}
@@ -24,36 +24,602 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.claimPayoutReissueStmt, err = db.PrepareContext(ctx, claimPayoutReissue); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimPayoutReissue: %w", err)
+	}
+	if q.countKeyPayoutItemsStmt, err = db.PrepareContext(ctx, countKeyPayoutItems); err != nil {
+		return nil, fmt.Errorf("error preparing query CountKeyPayoutItems: %w", err)
+	}
+	if q.countTenantPayoutItemsStmt, err = db.PrepareContext(ctx, countTenantPayoutItems); err != nil {
+		return nil, fmt.Errorf("error preparing query CountTenantPayoutItems: %w", err)
+	}
+	if q.createAPIKeyStmt, err = db.PrepareContext(ctx, createAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAPIKey: %w", err)
+	}
+	if q.createBatchStmt, err = db.PrepareContext(ctx, createBatch); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateBatch: %w", err)
+	}
+	if q.createBatchEventStmt, err = db.PrepareContext(ctx, createBatchEvent); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateBatchEvent: %w", err)
+	}
+	if q.createJournalEntryStmt, err = db.PrepareContext(ctx, createJournalEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateJournalEntry: %w", err)
+	}
+	if q.createPIIKeyStmt, err = db.PrepareContext(ctx, createPIIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePIIKey: %w", err)
+	}
 	if q.createPayoutStmt, err = db.PrepareContext(ctx, createPayout); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePayout: %w", err)
 	}
+	if q.createPostingStmt, err = db.PrepareContext(ctx, createPosting); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePosting: %w", err)
+	}
+	if q.createReconciliationItemStmt, err = db.PrepareContext(ctx, createReconciliationItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReconciliationItem: %w", err)
+	}
+	if q.createReconciliationRunStmt, err = db.PrepareContext(ctx, createReconciliationRun); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReconciliationRun: %w", err)
+	}
+	if q.createRefreshTokenStmt, err = db.PrepareContext(ctx, createRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateRefreshToken: %w", err)
+	}
+	if q.createReviewStmt, err = db.PrepareContext(ctx, createReview); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateReview: %w", err)
+	}
+	if q.createTenantStmt, err = db.PrepareContext(ctx, createTenant); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTenant: %w", err)
+	}
+	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
+	}
+	if q.decideBatchApprovalStmt, err = db.PrepareContext(ctx, decideBatchApproval); err != nil {
+		return nil, fmt.Errorf("error preparing query DecideBatchApproval: %w", err)
+	}
+	if q.decideReviewStmt, err = db.PrepareContext(ctx, decideReview); err != nil {
+		return nil, fmt.Errorf("error preparing query DecideReview: %w", err)
+	}
+	if q.deleteExpiredNoncesStmt, err = db.PrepareContext(ctx, deleteExpiredNonces); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredNonces: %w", err)
+	}
+	if q.deletePayoutQuotaStmt, err = db.PrepareContext(ctx, deletePayoutQuota); err != nil {
+		return nil, fmt.Errorf("error preparing query DeletePayoutQuota: %w", err)
+	}
+	if q.deleteRateLimitStmt, err = db.PrepareContext(ctx, deleteRateLimit); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteRateLimit: %w", err)
+	}
+	if q.disableUserStmt, err = db.PrepareContext(ctx, disableUser); err != nil {
+		return nil, fmt.Errorf("error preparing query DisableUser: %w", err)
+	}
+	if q.ensureLedgerAccountStmt, err = db.PrepareContext(ctx, ensureLedgerAccount); err != nil {
+		return nil, fmt.Errorf("error preparing query EnsureLedgerAccount: %w", err)
+	}
+	if q.erasePayoutPIIStmt, err = db.PrepareContext(ctx, erasePayoutPII); err != nil {
+		return nil, fmt.Errorf("error preparing query ErasePayoutPII: %w", err)
+	}
+	if q.eraseReviewDetailsStmt, err = db.PrepareContext(ctx, eraseReviewDetails); err != nil {
+		return nil, fmt.Errorf("error preparing query EraseReviewDetails: %w", err)
+	}
+	if q.findPayoutByIDStmt, err = db.PrepareContext(ctx, findPayoutByID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindPayoutByID: %w", err)
+	}
+	if q.findRecentDuplicateStmt, err = db.PrepareContext(ctx, findRecentDuplicate); err != nil {
+		return nil, fmt.Errorf("error preparing query FindRecentDuplicate: %w", err)
+	}
+	if q.getAPIKeyStmt, err = db.PrepareContext(ctx, getAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIKey: %w", err)
+	}
+	if q.getAPIKeyByHashStmt, err = db.PrepareContext(ctx, getAPIKeyByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIKeyByHash: %w", err)
+	}
+	if q.getAPIKeyByPrefixStmt, err = db.PrepareContext(ctx, getAPIKeyByPrefix); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAPIKeyByPrefix: %w", err)
+	}
+	if q.getAccountBalanceStmt, err = db.PrepareContext(ctx, getAccountBalance); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAccountBalance: %w", err)
+	}
+	if q.getBatchStmt, err = db.PrepareContext(ctx, getBatch); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBatch: %w", err)
+	}
+	if q.getPIIKeyStmt, err = db.PrepareContext(ctx, getPIIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPIIKey: %w", err)
+	}
 	if q.getPayoutStmt, err = db.PrepareContext(ctx, getPayout); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPayout: %w", err)
 	}
+	if q.getPayoutByTransactionIDStmt, err = db.PrepareContext(ctx, getPayoutByTransactionID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPayoutByTransactionID: %w", err)
+	}
+	if q.getReconciliationRunStmt, err = db.PrepareContext(ctx, getReconciliationRun); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReconciliationRun: %w", err)
+	}
+	if q.getRefreshTokenByHashStmt, err = db.PrepareContext(ctx, getRefreshTokenByHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetRefreshTokenByHash: %w", err)
+	}
+	if q.getReviewStmt, err = db.PrepareContext(ctx, getReview); err != nil {
+		return nil, fmt.Errorf("error preparing query GetReview: %w", err)
+	}
+	if q.getTenantStmt, err = db.PrepareContext(ctx, getTenant); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTenant: %w", err)
+	}
+	if q.getUserStmt, err = db.PrepareContext(ctx, getUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUser: %w", err)
+	}
+	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
+	}
+	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
+	}
+	if q.getUserPasswordHashStmt, err = db.PrepareContext(ctx, getUserPasswordHash); err != nil {
+		return nil, fmt.Errorf("error preparing query GetUserPasswordHash: %w", err)
+	}
+	if q.insertAuditEntryStmt, err = db.PrepareContext(ctx, insertAuditEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertAuditEntry: %w", err)
+	}
+	if q.insertRequestNonceStmt, err = db.PrepareContext(ctx, insertRequestNonce); err != nil {
+		return nil, fmt.Errorf("error preparing query InsertRequestNonce: %w", err)
+	}
+	if q.lastAuditEntryStmt, err = db.PrepareContext(ctx, lastAuditEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query LastAuditEntry: %w", err)
+	}
+	if q.listAPIKeySigningSecretsStmt, err = db.PrepareContext(ctx, listAPIKeySigningSecrets); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAPIKeySigningSecrets: %w", err)
+	}
+	if q.listAPIKeysStmt, err = db.PrepareContext(ctx, listAPIKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAPIKeys: %w", err)
+	}
+	if q.listAccountBalancesStmt, err = db.PrepareContext(ctx, listAccountBalances); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAccountBalances: %w", err)
+	}
+	if q.listApprovedReviewReasonsStmt, err = db.PrepareContext(ctx, listApprovedReviewReasons); err != nil {
+		return nil, fmt.Errorf("error preparing query ListApprovedReviewReasons: %w", err)
+	}
+	if q.listAuditEntriesStmt, err = db.PrepareContext(ctx, listAuditEntries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAuditEntries: %w", err)
+	}
+	if q.listBatchEventsStmt, err = db.PrepareContext(ctx, listBatchEvents); err != nil {
+		return nil, fmt.Errorf("error preparing query ListBatchEvents: %w", err)
+	}
+	if q.listExpiredPayoutsStmt, err = db.PrepareContext(ctx, listExpiredPayouts); err != nil {
+		return nil, fmt.Errorf("error preparing query ListExpiredPayouts: %w", err)
+	}
+	if q.listJournalEntriesByReferenceStmt, err = db.PrepareContext(ctx, listJournalEntriesByReference); err != nil {
+		return nil, fmt.Errorf("error preparing query ListJournalEntriesByReference: %w", err)
+	}
+	if q.listPIIKeysStmt, err = db.PrepareContext(ctx, listPIIKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPIIKeys: %w", err)
+	}
+	if q.listPayoutPIIStmt, err = db.PrepareContext(ctx, listPayoutPII); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPayoutPII: %w", err)
+	}
+	if q.listPayoutQuotasStmt, err = db.PrepareContext(ctx, listPayoutQuotas); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPayoutQuotas: %w", err)
+	}
 	if q.listPayoutsStmt, err = db.PrepareContext(ctx, listPayouts); err != nil {
 		return nil, fmt.Errorf("error preparing query ListPayouts: %w", err)
 	}
 	if q.listPayoutsByBatchIDStmt, err = db.PrepareContext(ctx, listPayoutsByBatchID); err != nil {
 		return nil, fmt.Errorf("error preparing query ListPayoutsByBatchID: %w", err)
 	}
+	if q.listPayoutsByRecipientStmt, err = db.PrepareContext(ctx, listPayoutsByRecipient); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPayoutsByRecipient: %w", err)
+	}
+	if q.listPostingsByEntryStmt, err = db.PrepareContext(ctx, listPostingsByEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPostingsByEntry: %w", err)
+	}
+	if q.listRateLimitsStmt, err = db.PrepareContext(ctx, listRateLimits); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRateLimits: %w", err)
+	}
+	if q.listRecipientPayoutIDsStmt, err = db.PrepareContext(ctx, listRecipientPayoutIDs); err != nil {
+		return nil, fmt.Errorf("error preparing query ListRecipientPayoutIDs: %w", err)
+	}
+	if q.listReconciliationItemsStmt, err = db.PrepareContext(ctx, listReconciliationItems); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReconciliationItems: %w", err)
+	}
+	if q.listReconciliationRunsStmt, err = db.PrepareContext(ctx, listReconciliationRuns); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReconciliationRuns: %w", err)
+	}
+	if q.listReviewDetailsStmt, err = db.PrepareContext(ctx, listReviewDetails); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReviewDetails: %w", err)
+	}
+	if q.listReviewsByStatusStmt, err = db.PrepareContext(ctx, listReviewsByStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query ListReviewsByStatus: %w", err)
+	}
+	if q.listSuccessfulPayoutsBetweenStmt, err = db.PrepareContext(ctx, listSuccessfulPayoutsBetween); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSuccessfulPayoutsBetween: %w", err)
+	}
+	if q.listTenantFeeChargesStmt, err = db.PrepareContext(ctx, listTenantFeeCharges); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTenantFeeCharges: %w", err)
+	}
+	if q.listTenantPostingsBetweenStmt, err = db.PrepareContext(ctx, listTenantPostingsBetween); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTenantPostingsBetween: %w", err)
+	}
+	if q.listTenantsStmt, err = db.PrepareContext(ctx, listTenants); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTenants: %w", err)
+	}
+	if q.listUsersStmt, err = db.PrepareContext(ctx, listUsers); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUsers: %w", err)
+	}
+	if q.releaseHeldPayoutStmt, err = db.PrepareContext(ctx, releaseHeldPayout); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseHeldPayout: %w", err)
+	}
+	if q.releasePayoutReissueStmt, err = db.PrepareContext(ctx, releasePayoutReissue); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleasePayoutReissue: %w", err)
+	}
+	if q.replaceAPIKeyStmt, err = db.PrepareContext(ctx, replaceAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query ReplaceAPIKey: %w", err)
+	}
+	if q.replaceRefreshTokenStmt, err = db.PrepareContext(ctx, replaceRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query ReplaceRefreshToken: %w", err)
+	}
+	if q.retirePIIDataKeysStmt, err = db.PrepareContext(ctx, retirePIIDataKeys); err != nil {
+		return nil, fmt.Errorf("error preparing query RetirePIIDataKeys: %w", err)
+	}
+	if q.reversePayoutStmt, err = db.PrepareContext(ctx, reversePayout); err != nil {
+		return nil, fmt.Errorf("error preparing query ReversePayout: %w", err)
+	}
+	if q.revokeAPIKeyStmt, err = db.PrepareContext(ctx, revokeAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeAPIKey: %w", err)
+	}
+	if q.revokeRefreshTokenStmt, err = db.PrepareContext(ctx, revokeRefreshToken); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeRefreshToken: %w", err)
+	}
+	if q.revokeUserRefreshTokensStmt, err = db.PrepareContext(ctx, revokeUserRefreshTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query RevokeUserRefreshTokens: %w", err)
+	}
+	if q.rewrapPIIKeyStmt, err = db.PrepareContext(ctx, rewrapPIIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query RewrapPIIKey: %w", err)
+	}
+	if q.scanAuditLogStmt, err = db.PrepareContext(ctx, scanAuditLog); err != nil {
+		return nil, fmt.Errorf("error preparing query ScanAuditLog: %w", err)
+	}
+	if q.setAPIKeySigningSecretStmt, err = db.PrepareContext(ctx, setAPIKeySigningSecret); err != nil {
+		return nil, fmt.Errorf("error preparing query SetAPIKeySigningSecret: %w", err)
+	}
+	if q.setPayoutCostStmt, err = db.PrepareContext(ctx, setPayoutCost); err != nil {
+		return nil, fmt.Errorf("error preparing query SetPayoutCost: %w", err)
+	}
+	if q.setPayoutTransactionIDStmt, err = db.PrepareContext(ctx, setPayoutTransactionID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetPayoutTransactionID: %w", err)
+	}
+	if q.setResolvedAccountNameStmt, err = db.PrepareContext(ctx, setResolvedAccountName); err != nil {
+		return nil, fmt.Errorf("error preparing query SetResolvedAccountName: %w", err)
+	}
+	if q.setScreeningListVersionStmt, err = db.PrepareContext(ctx, setScreeningListVersion); err != nil {
+		return nil, fmt.Errorf("error preparing query SetScreeningListVersion: %w", err)
+	}
+	if q.setTenantWebhookURLStmt, err = db.PrepareContext(ctx, setTenantWebhookURL); err != nil {
+		return nil, fmt.Errorf("error preparing query SetTenantWebhookURL: %w", err)
+	}
+	if q.setUserPasswordStmt, err = db.PrepareContext(ctx, setUserPassword); err != nil {
+		return nil, fmt.Errorf("error preparing query SetUserPassword: %w", err)
+	}
+	if q.setUserRoleStmt, err = db.PrepareContext(ctx, setUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query SetUserRole: %w", err)
+	}
+	if q.sumCorridorPayoutsStmt, err = db.PrepareContext(ctx, sumCorridorPayouts); err != nil {
+		return nil, fmt.Errorf("error preparing query SumCorridorPayouts: %w", err)
+	}
+	if q.sumRecipientPayoutsStmt, err = db.PrepareContext(ctx, sumRecipientPayouts); err != nil {
+		return nil, fmt.Errorf("error preparing query SumRecipientPayouts: %w", err)
+	}
+	if q.sumTenantPostingsBeforeStmt, err = db.PrepareContext(ctx, sumTenantPostingsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query SumTenantPostingsBefore: %w", err)
+	}
+	if q.touchAPIKeyStmt, err = db.PrepareContext(ctx, touchAPIKey); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchAPIKey: %w", err)
+	}
+	if q.touchUserLoginStmt, err = db.PrepareContext(ctx, touchUserLogin); err != nil {
+		return nil, fmt.Errorf("error preparing query TouchUserLogin: %w", err)
+	}
+	if q.transitionBatchPayoutsStmt, err = db.PrepareContext(ctx, transitionBatchPayouts); err != nil {
+		return nil, fmt.Errorf("error preparing query TransitionBatchPayouts: %w", err)
+	}
+	if q.updatePayoutPIIStmt, err = db.PrepareContext(ctx, updatePayoutPII); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePayoutPII: %w", err)
+	}
 	if q.updatePayoutStatusStmt, err = db.PrepareContext(ctx, updatePayoutStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdatePayoutStatus: %w", err)
 	}
+	if q.updateReviewDetailStmt, err = db.PrepareContext(ctx, updateReviewDetail); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateReviewDetail: %w", err)
+	}
+	if q.upsertPayoutQuotaStmt, err = db.PrepareContext(ctx, upsertPayoutQuota); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertPayoutQuota: %w", err)
+	}
+	if q.upsertRateLimitStmt, err = db.PrepareContext(ctx, upsertRateLimit); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertRateLimit: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.claimPayoutReissueStmt != nil {
+		if cerr := q.claimPayoutReissueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimPayoutReissueStmt: %w", cerr)
+		}
+	}
+	if q.countKeyPayoutItemsStmt != nil {
+		if cerr := q.countKeyPayoutItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countKeyPayoutItemsStmt: %w", cerr)
+		}
+	}
+	if q.countTenantPayoutItemsStmt != nil {
+		if cerr := q.countTenantPayoutItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countTenantPayoutItemsStmt: %w", cerr)
+		}
+	}
+	if q.createAPIKeyStmt != nil {
+		if cerr := q.createAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.createBatchStmt != nil {
+		if cerr := q.createBatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createBatchStmt: %w", cerr)
+		}
+	}
+	if q.createBatchEventStmt != nil {
+		if cerr := q.createBatchEventStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createBatchEventStmt: %w", cerr)
+		}
+	}
+	if q.createJournalEntryStmt != nil {
+		if cerr := q.createJournalEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createJournalEntryStmt: %w", cerr)
+		}
+	}
+	if q.createPIIKeyStmt != nil {
+		if cerr := q.createPIIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPIIKeyStmt: %w", cerr)
+		}
+	}
 	if q.createPayoutStmt != nil {
 		if cerr := q.createPayoutStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createPayoutStmt: %w", cerr)
 		}
 	}
+	if q.createPostingStmt != nil {
+		if cerr := q.createPostingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPostingStmt: %w", cerr)
+		}
+	}
+	if q.createReconciliationItemStmt != nil {
+		if cerr := q.createReconciliationItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReconciliationItemStmt: %w", cerr)
+		}
+	}
+	if q.createReconciliationRunStmt != nil {
+		if cerr := q.createReconciliationRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReconciliationRunStmt: %w", cerr)
+		}
+	}
+	if q.createRefreshTokenStmt != nil {
+		if cerr := q.createRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.createReviewStmt != nil {
+		if cerr := q.createReviewStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createReviewStmt: %w", cerr)
+		}
+	}
+	if q.createTenantStmt != nil {
+		if cerr := q.createTenantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTenantStmt: %w", cerr)
+		}
+	}
+	if q.createUserStmt != nil {
+		if cerr := q.createUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
+		}
+	}
+	if q.decideBatchApprovalStmt != nil {
+		if cerr := q.decideBatchApprovalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decideBatchApprovalStmt: %w", cerr)
+		}
+	}
+	if q.decideReviewStmt != nil {
+		if cerr := q.decideReviewStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing decideReviewStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredNoncesStmt != nil {
+		if cerr := q.deleteExpiredNoncesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredNoncesStmt: %w", cerr)
+		}
+	}
+	if q.deletePayoutQuotaStmt != nil {
+		if cerr := q.deletePayoutQuotaStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deletePayoutQuotaStmt: %w", cerr)
+		}
+	}
+	if q.deleteRateLimitStmt != nil {
+		if cerr := q.deleteRateLimitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteRateLimitStmt: %w", cerr)
+		}
+	}
+	if q.disableUserStmt != nil {
+		if cerr := q.disableUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing disableUserStmt: %w", cerr)
+		}
+	}
+	if q.ensureLedgerAccountStmt != nil {
+		if cerr := q.ensureLedgerAccountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing ensureLedgerAccountStmt: %w", cerr)
+		}
+	}
+	if q.erasePayoutPIIStmt != nil {
+		if cerr := q.erasePayoutPIIStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing erasePayoutPIIStmt: %w", cerr)
+		}
+	}
+	if q.eraseReviewDetailsStmt != nil {
+		if cerr := q.eraseReviewDetailsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing eraseReviewDetailsStmt: %w", cerr)
+		}
+	}
+	if q.findPayoutByIDStmt != nil {
+		if cerr := q.findPayoutByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findPayoutByIDStmt: %w", cerr)
+		}
+	}
+	if q.findRecentDuplicateStmt != nil {
+		if cerr := q.findRecentDuplicateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findRecentDuplicateStmt: %w", cerr)
+		}
+	}
+	if q.getAPIKeyStmt != nil {
+		if cerr := q.getAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.getAPIKeyByHashStmt != nil {
+		if cerr := q.getAPIKeyByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIKeyByHashStmt: %w", cerr)
+		}
+	}
+	if q.getAPIKeyByPrefixStmt != nil {
+		if cerr := q.getAPIKeyByPrefixStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAPIKeyByPrefixStmt: %w", cerr)
+		}
+	}
+	if q.getAccountBalanceStmt != nil {
+		if cerr := q.getAccountBalanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAccountBalanceStmt: %w", cerr)
+		}
+	}
+	if q.getBatchStmt != nil {
+		if cerr := q.getBatchStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBatchStmt: %w", cerr)
+		}
+	}
+	if q.getPIIKeyStmt != nil {
+		if cerr := q.getPIIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPIIKeyStmt: %w", cerr)
+		}
+	}
 	if q.getPayoutStmt != nil {
 		if cerr := q.getPayoutStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPayoutStmt: %w", cerr)
 		}
 	}
+	if q.getPayoutByTransactionIDStmt != nil {
+		if cerr := q.getPayoutByTransactionIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPayoutByTransactionIDStmt: %w", cerr)
+		}
+	}
+	if q.getReconciliationRunStmt != nil {
+		if cerr := q.getReconciliationRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReconciliationRunStmt: %w", cerr)
+		}
+	}
+	if q.getRefreshTokenByHashStmt != nil {
+		if cerr := q.getRefreshTokenByHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getRefreshTokenByHashStmt: %w", cerr)
+		}
+	}
+	if q.getReviewStmt != nil {
+		if cerr := q.getReviewStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getReviewStmt: %w", cerr)
+		}
+	}
+	if q.getTenantStmt != nil {
+		if cerr := q.getTenantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTenantStmt: %w", cerr)
+		}
+	}
+	if q.getUserStmt != nil {
+		if cerr := q.getUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserStmt: %w", cerr)
+		}
+	}
+	if q.getUserByEmailStmt != nil {
+		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
+		}
+	}
+	if q.getUserByIDStmt != nil {
+		if cerr := q.getUserByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
+		}
+	}
+	if q.getUserPasswordHashStmt != nil {
+		if cerr := q.getUserPasswordHashStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getUserPasswordHashStmt: %w", cerr)
+		}
+	}
+	if q.insertAuditEntryStmt != nil {
+		if cerr := q.insertAuditEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertAuditEntryStmt: %w", cerr)
+		}
+	}
+	if q.insertRequestNonceStmt != nil {
+		if cerr := q.insertRequestNonceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing insertRequestNonceStmt: %w", cerr)
+		}
+	}
+	if q.lastAuditEntryStmt != nil {
+		if cerr := q.lastAuditEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lastAuditEntryStmt: %w", cerr)
+		}
+	}
+	if q.listAPIKeySigningSecretsStmt != nil {
+		if cerr := q.listAPIKeySigningSecretsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAPIKeySigningSecretsStmt: %w", cerr)
+		}
+	}
+	if q.listAPIKeysStmt != nil {
+		if cerr := q.listAPIKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAPIKeysStmt: %w", cerr)
+		}
+	}
+	if q.listAccountBalancesStmt != nil {
+		if cerr := q.listAccountBalancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAccountBalancesStmt: %w", cerr)
+		}
+	}
+	if q.listApprovedReviewReasonsStmt != nil {
+		if cerr := q.listApprovedReviewReasonsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listApprovedReviewReasonsStmt: %w", cerr)
+		}
+	}
+	if q.listAuditEntriesStmt != nil {
+		if cerr := q.listAuditEntriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAuditEntriesStmt: %w", cerr)
+		}
+	}
+	if q.listBatchEventsStmt != nil {
+		if cerr := q.listBatchEventsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listBatchEventsStmt: %w", cerr)
+		}
+	}
+	if q.listExpiredPayoutsStmt != nil {
+		if cerr := q.listExpiredPayoutsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listExpiredPayoutsStmt: %w", cerr)
+		}
+	}
+	if q.listJournalEntriesByReferenceStmt != nil {
+		if cerr := q.listJournalEntriesByReferenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listJournalEntriesByReferenceStmt: %w", cerr)
+		}
+	}
+	if q.listPIIKeysStmt != nil {
+		if cerr := q.listPIIKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPIIKeysStmt: %w", cerr)
+		}
+	}
+	if q.listPayoutPIIStmt != nil {
+		if cerr := q.listPayoutPIIStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPayoutPIIStmt: %w", cerr)
+		}
+	}
+	if q.listPayoutQuotasStmt != nil {
+		if cerr := q.listPayoutQuotasStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPayoutQuotasStmt: %w", cerr)
+		}
+	}
 	if q.listPayoutsStmt != nil {
 		if cerr := q.listPayoutsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listPayoutsStmt: %w", cerr)
@@ -64,11 +630,221 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listPayoutsByBatchIDStmt: %w", cerr)
 		}
 	}
+	if q.listPayoutsByRecipientStmt != nil {
+		if cerr := q.listPayoutsByRecipientStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPayoutsByRecipientStmt: %w", cerr)
+		}
+	}
+	if q.listPostingsByEntryStmt != nil {
+		if cerr := q.listPostingsByEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPostingsByEntryStmt: %w", cerr)
+		}
+	}
+	if q.listRateLimitsStmt != nil {
+		if cerr := q.listRateLimitsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRateLimitsStmt: %w", cerr)
+		}
+	}
+	if q.listRecipientPayoutIDsStmt != nil {
+		if cerr := q.listRecipientPayoutIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listRecipientPayoutIDsStmt: %w", cerr)
+		}
+	}
+	if q.listReconciliationItemsStmt != nil {
+		if cerr := q.listReconciliationItemsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReconciliationItemsStmt: %w", cerr)
+		}
+	}
+	if q.listReconciliationRunsStmt != nil {
+		if cerr := q.listReconciliationRunsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReconciliationRunsStmt: %w", cerr)
+		}
+	}
+	if q.listReviewDetailsStmt != nil {
+		if cerr := q.listReviewDetailsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReviewDetailsStmt: %w", cerr)
+		}
+	}
+	if q.listReviewsByStatusStmt != nil {
+		if cerr := q.listReviewsByStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listReviewsByStatusStmt: %w", cerr)
+		}
+	}
+	if q.listSuccessfulPayoutsBetweenStmt != nil {
+		if cerr := q.listSuccessfulPayoutsBetweenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSuccessfulPayoutsBetweenStmt: %w", cerr)
+		}
+	}
+	if q.listTenantFeeChargesStmt != nil {
+		if cerr := q.listTenantFeeChargesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTenantFeeChargesStmt: %w", cerr)
+		}
+	}
+	if q.listTenantPostingsBetweenStmt != nil {
+		if cerr := q.listTenantPostingsBetweenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTenantPostingsBetweenStmt: %w", cerr)
+		}
+	}
+	if q.listTenantsStmt != nil {
+		if cerr := q.listTenantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTenantsStmt: %w", cerr)
+		}
+	}
+	if q.listUsersStmt != nil {
+		if cerr := q.listUsersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUsersStmt: %w", cerr)
+		}
+	}
+	if q.releaseHeldPayoutStmt != nil {
+		if cerr := q.releaseHeldPayoutStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseHeldPayoutStmt: %w", cerr)
+		}
+	}
+	if q.releasePayoutReissueStmt != nil {
+		if cerr := q.releasePayoutReissueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releasePayoutReissueStmt: %w", cerr)
+		}
+	}
+	if q.replaceAPIKeyStmt != nil {
+		if cerr := q.replaceAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing replaceAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.replaceRefreshTokenStmt != nil {
+		if cerr := q.replaceRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing replaceRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.retirePIIDataKeysStmt != nil {
+		if cerr := q.retirePIIDataKeysStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retirePIIDataKeysStmt: %w", cerr)
+		}
+	}
+	if q.reversePayoutStmt != nil {
+		if cerr := q.reversePayoutStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing reversePayoutStmt: %w", cerr)
+		}
+	}
+	if q.revokeAPIKeyStmt != nil {
+		if cerr := q.revokeAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.revokeRefreshTokenStmt != nil {
+		if cerr := q.revokeRefreshTokenStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeRefreshTokenStmt: %w", cerr)
+		}
+	}
+	if q.revokeUserRefreshTokensStmt != nil {
+		if cerr := q.revokeUserRefreshTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing revokeUserRefreshTokensStmt: %w", cerr)
+		}
+	}
+	if q.rewrapPIIKeyStmt != nil {
+		if cerr := q.rewrapPIIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rewrapPIIKeyStmt: %w", cerr)
+		}
+	}
+	if q.scanAuditLogStmt != nil {
+		if cerr := q.scanAuditLogStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing scanAuditLogStmt: %w", cerr)
+		}
+	}
+	if q.setAPIKeySigningSecretStmt != nil {
+		if cerr := q.setAPIKeySigningSecretStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setAPIKeySigningSecretStmt: %w", cerr)
+		}
+	}
+	if q.setPayoutCostStmt != nil {
+		if cerr := q.setPayoutCostStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setPayoutCostStmt: %w", cerr)
+		}
+	}
+	if q.setPayoutTransactionIDStmt != nil {
+		if cerr := q.setPayoutTransactionIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setPayoutTransactionIDStmt: %w", cerr)
+		}
+	}
+	if q.setResolvedAccountNameStmt != nil {
+		if cerr := q.setResolvedAccountNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setResolvedAccountNameStmt: %w", cerr)
+		}
+	}
+	if q.setScreeningListVersionStmt != nil {
+		if cerr := q.setScreeningListVersionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setScreeningListVersionStmt: %w", cerr)
+		}
+	}
+	if q.setTenantWebhookURLStmt != nil {
+		if cerr := q.setTenantWebhookURLStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setTenantWebhookURLStmt: %w", cerr)
+		}
+	}
+	if q.setUserPasswordStmt != nil {
+		if cerr := q.setUserPasswordStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setUserPasswordStmt: %w", cerr)
+		}
+	}
+	if q.setUserRoleStmt != nil {
+		if cerr := q.setUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setUserRoleStmt: %w", cerr)
+		}
+	}
+	if q.sumCorridorPayoutsStmt != nil {
+		if cerr := q.sumCorridorPayoutsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumCorridorPayoutsStmt: %w", cerr)
+		}
+	}
+	if q.sumRecipientPayoutsStmt != nil {
+		if cerr := q.sumRecipientPayoutsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumRecipientPayoutsStmt: %w", cerr)
+		}
+	}
+	if q.sumTenantPostingsBeforeStmt != nil {
+		if cerr := q.sumTenantPostingsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumTenantPostingsBeforeStmt: %w", cerr)
+		}
+	}
+	if q.touchAPIKeyStmt != nil {
+		if cerr := q.touchAPIKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchAPIKeyStmt: %w", cerr)
+		}
+	}
+	if q.touchUserLoginStmt != nil {
+		if cerr := q.touchUserLoginStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing touchUserLoginStmt: %w", cerr)
+		}
+	}
+	if q.transitionBatchPayoutsStmt != nil {
+		if cerr := q.transitionBatchPayoutsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing transitionBatchPayoutsStmt: %w", cerr)
+		}
+	}
+	if q.updatePayoutPIIStmt != nil {
+		if cerr := q.updatePayoutPIIStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePayoutPIIStmt: %w", cerr)
+		}
+	}
 	if q.updatePayoutStatusStmt != nil {
 		if cerr := q.updatePayoutStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updatePayoutStatusStmt: %w", cerr)
 		}
 	}
+	if q.updateReviewDetailStmt != nil {
+		if cerr := q.updateReviewDetailStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateReviewDetailStmt: %w", cerr)
+		}
+	}
+	if q.upsertPayoutQuotaStmt != nil {
+		if cerr := q.upsertPayoutQuotaStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertPayoutQuotaStmt: %w", cerr)
+		}
+	}
+	if q.upsertRateLimitStmt != nil {
+		if cerr := q.upsertRateLimitStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertRateLimitStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -106,23 +882,217 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                       DBTX
-	tx                       *sql.Tx
-	createPayoutStmt         *sql.Stmt
-	getPayoutStmt            *sql.Stmt
-	listPayoutsStmt          *sql.Stmt
-	listPayoutsByBatchIDStmt *sql.Stmt
-	updatePayoutStatusStmt   *sql.Stmt
+	db                                DBTX
+	tx                                *sql.Tx
+	claimPayoutReissueStmt            *sql.Stmt
+	countKeyPayoutItemsStmt           *sql.Stmt
+	countTenantPayoutItemsStmt        *sql.Stmt
+	createAPIKeyStmt                  *sql.Stmt
+	createBatchStmt                   *sql.Stmt
+	createBatchEventStmt              *sql.Stmt
+	createJournalEntryStmt            *sql.Stmt
+	createPIIKeyStmt                  *sql.Stmt
+	createPayoutStmt                  *sql.Stmt
+	createPostingStmt                 *sql.Stmt
+	createReconciliationItemStmt      *sql.Stmt
+	createReconciliationRunStmt       *sql.Stmt
+	createRefreshTokenStmt            *sql.Stmt
+	createReviewStmt                  *sql.Stmt
+	createTenantStmt                  *sql.Stmt
+	createUserStmt                    *sql.Stmt
+	decideBatchApprovalStmt           *sql.Stmt
+	decideReviewStmt                  *sql.Stmt
+	deleteExpiredNoncesStmt           *sql.Stmt
+	deletePayoutQuotaStmt             *sql.Stmt
+	deleteRateLimitStmt               *sql.Stmt
+	disableUserStmt                   *sql.Stmt
+	ensureLedgerAccountStmt           *sql.Stmt
+	erasePayoutPIIStmt                *sql.Stmt
+	eraseReviewDetailsStmt            *sql.Stmt
+	findPayoutByIDStmt                *sql.Stmt
+	findRecentDuplicateStmt           *sql.Stmt
+	getAPIKeyStmt                     *sql.Stmt
+	getAPIKeyByHashStmt               *sql.Stmt
+	getAPIKeyByPrefixStmt             *sql.Stmt
+	getAccountBalanceStmt             *sql.Stmt
+	getBatchStmt                      *sql.Stmt
+	getPIIKeyStmt                     *sql.Stmt
+	getPayoutStmt                     *sql.Stmt
+	getPayoutByTransactionIDStmt      *sql.Stmt
+	getReconciliationRunStmt          *sql.Stmt
+	getRefreshTokenByHashStmt         *sql.Stmt
+	getReviewStmt                     *sql.Stmt
+	getTenantStmt                     *sql.Stmt
+	getUserStmt                       *sql.Stmt
+	getUserByEmailStmt                *sql.Stmt
+	getUserByIDStmt                   *sql.Stmt
+	getUserPasswordHashStmt           *sql.Stmt
+	insertAuditEntryStmt              *sql.Stmt
+	insertRequestNonceStmt            *sql.Stmt
+	lastAuditEntryStmt                *sql.Stmt
+	listAPIKeySigningSecretsStmt      *sql.Stmt
+	listAPIKeysStmt                   *sql.Stmt
+	listAccountBalancesStmt           *sql.Stmt
+	listApprovedReviewReasonsStmt     *sql.Stmt
+	listAuditEntriesStmt              *sql.Stmt
+	listBatchEventsStmt               *sql.Stmt
+	listExpiredPayoutsStmt            *sql.Stmt
+	listJournalEntriesByReferenceStmt *sql.Stmt
+	listPIIKeysStmt                   *sql.Stmt
+	listPayoutPIIStmt                 *sql.Stmt
+	listPayoutQuotasStmt              *sql.Stmt
+	listPayoutsStmt                   *sql.Stmt
+	listPayoutsByBatchIDStmt          *sql.Stmt
+	listPayoutsByRecipientStmt        *sql.Stmt
+	listPostingsByEntryStmt           *sql.Stmt
+	listRateLimitsStmt                *sql.Stmt
+	listRecipientPayoutIDsStmt        *sql.Stmt
+	listReconciliationItemsStmt       *sql.Stmt
+	listReconciliationRunsStmt        *sql.Stmt
+	listReviewDetailsStmt             *sql.Stmt
+	listReviewsByStatusStmt           *sql.Stmt
+	listSuccessfulPayoutsBetweenStmt  *sql.Stmt
+	listTenantFeeChargesStmt          *sql.Stmt
+	listTenantPostingsBetweenStmt     *sql.Stmt
+	listTenantsStmt                   *sql.Stmt
+	listUsersStmt                     *sql.Stmt
+	releaseHeldPayoutStmt             *sql.Stmt
+	releasePayoutReissueStmt          *sql.Stmt
+	replaceAPIKeyStmt                 *sql.Stmt
+	replaceRefreshTokenStmt           *sql.Stmt
+	retirePIIDataKeysStmt             *sql.Stmt
+	reversePayoutStmt                 *sql.Stmt
+	revokeAPIKeyStmt                  *sql.Stmt
+	revokeRefreshTokenStmt            *sql.Stmt
+	revokeUserRefreshTokensStmt       *sql.Stmt
+	rewrapPIIKeyStmt                  *sql.Stmt
+	scanAuditLogStmt                  *sql.Stmt
+	setAPIKeySigningSecretStmt        *sql.Stmt
+	setPayoutCostStmt                 *sql.Stmt
+	setPayoutTransactionIDStmt        *sql.Stmt
+	setResolvedAccountNameStmt        *sql.Stmt
+	setScreeningListVersionStmt       *sql.Stmt
+	setTenantWebhookURLStmt           *sql.Stmt
+	setUserPasswordStmt               *sql.Stmt
+	setUserRoleStmt                   *sql.Stmt
+	sumCorridorPayoutsStmt            *sql.Stmt
+	sumRecipientPayoutsStmt           *sql.Stmt
+	sumTenantPostingsBeforeStmt       *sql.Stmt
+	touchAPIKeyStmt                   *sql.Stmt
+	touchUserLoginStmt                *sql.Stmt
+	transitionBatchPayoutsStmt        *sql.Stmt
+	updatePayoutPIIStmt               *sql.Stmt
+	updatePayoutStatusStmt            *sql.Stmt
+	updateReviewDetailStmt            *sql.Stmt
+	upsertPayoutQuotaStmt             *sql.Stmt
+	upsertRateLimitStmt               *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                       tx,
-		tx:                       tx,
-		createPayoutStmt:         q.createPayoutStmt,
-		getPayoutStmt:            q.getPayoutStmt,
-		listPayoutsStmt:          q.listPayoutsStmt,
-		listPayoutsByBatchIDStmt: q.listPayoutsByBatchIDStmt,
-		updatePayoutStatusStmt:   q.updatePayoutStatusStmt,
+		db:                                tx,
+		tx:                                tx,
+		claimPayoutReissueStmt:            q.claimPayoutReissueStmt,
+		countKeyPayoutItemsStmt:           q.countKeyPayoutItemsStmt,
+		countTenantPayoutItemsStmt:        q.countTenantPayoutItemsStmt,
+		createAPIKeyStmt:                  q.createAPIKeyStmt,
+		createBatchStmt:                   q.createBatchStmt,
+		createBatchEventStmt:              q.createBatchEventStmt,
+		createJournalEntryStmt:            q.createJournalEntryStmt,
+		createPIIKeyStmt:                  q.createPIIKeyStmt,
+		createPayoutStmt:                  q.createPayoutStmt,
+		createPostingStmt:                 q.createPostingStmt,
+		createReconciliationItemStmt:      q.createReconciliationItemStmt,
+		createReconciliationRunStmt:       q.createReconciliationRunStmt,
+		createRefreshTokenStmt:            q.createRefreshTokenStmt,
+		createReviewStmt:                  q.createReviewStmt,
+		createTenantStmt:                  q.createTenantStmt,
+		createUserStmt:                    q.createUserStmt,
+		decideBatchApprovalStmt:           q.decideBatchApprovalStmt,
+		decideReviewStmt:                  q.decideReviewStmt,
+		deleteExpiredNoncesStmt:           q.deleteExpiredNoncesStmt,
+		deletePayoutQuotaStmt:             q.deletePayoutQuotaStmt,
+		deleteRateLimitStmt:               q.deleteRateLimitStmt,
+		disableUserStmt:                   q.disableUserStmt,
+		ensureLedgerAccountStmt:           q.ensureLedgerAccountStmt,
+		erasePayoutPIIStmt:                q.erasePayoutPIIStmt,
+		eraseReviewDetailsStmt:            q.eraseReviewDetailsStmt,
+		findPayoutByIDStmt:                q.findPayoutByIDStmt,
+		findRecentDuplicateStmt:           q.findRecentDuplicateStmt,
+		getAPIKeyStmt:                     q.getAPIKeyStmt,
+		getAPIKeyByHashStmt:               q.getAPIKeyByHashStmt,
+		getAPIKeyByPrefixStmt:             q.getAPIKeyByPrefixStmt,
+		getAccountBalanceStmt:             q.getAccountBalanceStmt,
+		getBatchStmt:                      q.getBatchStmt,
+		getPIIKeyStmt:                     q.getPIIKeyStmt,
+		getPayoutStmt:                     q.getPayoutStmt,
+		getPayoutByTransactionIDStmt:      q.getPayoutByTransactionIDStmt,
+		getReconciliationRunStmt:          q.getReconciliationRunStmt,
+		getRefreshTokenByHashStmt:         q.getRefreshTokenByHashStmt,
+		getReviewStmt:                     q.getReviewStmt,
+		getTenantStmt:                     q.getTenantStmt,
+		getUserStmt:                       q.getUserStmt,
+		getUserByEmailStmt:                q.getUserByEmailStmt,
+		getUserByIDStmt:                   q.getUserByIDStmt,
+		getUserPasswordHashStmt:           q.getUserPasswordHashStmt,
+		insertAuditEntryStmt:              q.insertAuditEntryStmt,
+		insertRequestNonceStmt:            q.insertRequestNonceStmt,
+		lastAuditEntryStmt:                q.lastAuditEntryStmt,
+		listAPIKeySigningSecretsStmt:      q.listAPIKeySigningSecretsStmt,
+		listAPIKeysStmt:                   q.listAPIKeysStmt,
+		listAccountBalancesStmt:           q.listAccountBalancesStmt,
+		listApprovedReviewReasonsStmt:     q.listApprovedReviewReasonsStmt,
+		listAuditEntriesStmt:              q.listAuditEntriesStmt,
+		listBatchEventsStmt:               q.listBatchEventsStmt,
+		listExpiredPayoutsStmt:            q.listExpiredPayoutsStmt,
+		listJournalEntriesByReferenceStmt: q.listJournalEntriesByReferenceStmt,
+		listPIIKeysStmt:                   q.listPIIKeysStmt,
+		listPayoutPIIStmt:                 q.listPayoutPIIStmt,
+		listPayoutQuotasStmt:              q.listPayoutQuotasStmt,
+		listPayoutsStmt:                   q.listPayoutsStmt,
+		listPayoutsByBatchIDStmt:          q.listPayoutsByBatchIDStmt,
+		listPayoutsByRecipientStmt:        q.listPayoutsByRecipientStmt,
+		listPostingsByEntryStmt:           q.listPostingsByEntryStmt,
+		listRateLimitsStmt:                q.listRateLimitsStmt,
+		listRecipientPayoutIDsStmt:        q.listRecipientPayoutIDsStmt,
+		listReconciliationItemsStmt:       q.listReconciliationItemsStmt,
+		listReconciliationRunsStmt:        q.listReconciliationRunsStmt,
+		listReviewDetailsStmt:             q.listReviewDetailsStmt,
+		listReviewsByStatusStmt:           q.listReviewsByStatusStmt,
+		listSuccessfulPayoutsBetweenStmt:  q.listSuccessfulPayoutsBetweenStmt,
+		listTenantFeeChargesStmt:          q.listTenantFeeChargesStmt,
+		listTenantPostingsBetweenStmt:     q.listTenantPostingsBetweenStmt,
+		listTenantsStmt:                   q.listTenantsStmt,
+		listUsersStmt:                     q.listUsersStmt,
+		releaseHeldPayoutStmt:             q.releaseHeldPayoutStmt,
+		releasePayoutReissueStmt:          q.releasePayoutReissueStmt,
+		replaceAPIKeyStmt:                 q.replaceAPIKeyStmt,
+		replaceRefreshTokenStmt:           q.replaceRefreshTokenStmt,
+		retirePIIDataKeysStmt:             q.retirePIIDataKeysStmt,
+		reversePayoutStmt:                 q.reversePayoutStmt,
+		revokeAPIKeyStmt:                  q.revokeAPIKeyStmt,
+		revokeRefreshTokenStmt:            q.revokeRefreshTokenStmt,
+		revokeUserRefreshTokensStmt:       q.revokeUserRefreshTokensStmt,
+		rewrapPIIKeyStmt:                  q.rewrapPIIKeyStmt,
+		scanAuditLogStmt:                  q.scanAuditLogStmt,
+		setAPIKeySigningSecretStmt:        q.setAPIKeySigningSecretStmt,
+		setPayoutCostStmt:                 q.setPayoutCostStmt,
+		setPayoutTransactionIDStmt:        q.setPayoutTransactionIDStmt,
+		setResolvedAccountNameStmt:        q.setResolvedAccountNameStmt,
+		setScreeningListVersionStmt:       q.setScreeningListVersionStmt,
+		setTenantWebhookURLStmt:           q.setTenantWebhookURLStmt,
+		setUserPasswordStmt:               q.setUserPasswordStmt,
+		setUserRoleStmt:                   q.setUserRoleStmt,
+		sumCorridorPayoutsStmt:            q.sumCorridorPayoutsStmt,
+		sumRecipientPayoutsStmt:           q.sumRecipientPayoutsStmt,
+		sumTenantPostingsBeforeStmt:       q.sumTenantPostingsBeforeStmt,
+		touchAPIKeyStmt:                   q.touchAPIKeyStmt,
+		touchUserLoginStmt:                q.touchUserLoginStmt,
+		transitionBatchPayoutsStmt:        q.transitionBatchPayoutsStmt,
+		updatePayoutPIIStmt:               q.updatePayoutPIIStmt,
+		updatePayoutStatusStmt:            q.updatePayoutStatusStmt,
+		updateReviewDetailStmt:            q.updateReviewDetailStmt,
+		upsertPayoutQuotaStmt:             q.upsertPayoutQuotaStmt,
+		upsertRateLimitStmt:               q.upsertRateLimitStmt,
 	}
 }
@@ -0,0 +1,198 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var (
+	_ ports.LedgerStore = (*SQLiteRepo)(nil)
+	_ ports.BatchTx     = txStore{}
+)
+
+// txStore runs limit, ledger and duplicate queries through q, so a guard
+// inside a transaction sees exactly what that transaction is about to commit
+// against.
+type txStore struct {
+	q   *Queries
+	pii *PIICipher // Indexes account numbers and keys fingerprints
+}
+
+// PostEntry saves a balanced entry and its postings in one transaction.
+func (r *SQLiteRepo) PostEntry(ctx context.Context, e domain.JournalEntry) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		return txStore{q: q}.PostEntry(ctx, e)
+	})
+}
+
+func (r *SQLiteRepo) AccountBalance(ctx context.Context, tenantID, currency, accountType string) (int64, error) {
+	return txStore{q: r.q}.AccountBalance(ctx, tenantID, currency, accountType)
+}
+
+// ListBalances returns every ledger account of a tenant with its balance.
+func (r *SQLiteRepo) ListBalances(ctx context.Context, tenantID string) ([]domain.AccountBalance, error) {
+	rows, err := r.q.ListAccountBalances(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]domain.AccountBalance, 0, len(rows))
+	for _, row := range rows {
+		balances = append(balances, domain.AccountBalance{
+			AccountID:   row.ID,
+			TenantID:    row.TenantID,
+			AccountType: row.Type,
+			Currency:    row.Currency,
+			Balance:     domain.SignedBalance(row.Type, row.Total),
+		})
+	}
+	return balances, nil
+}
+
+// ListEntriesByReference returns every entry posted for a payout or top-up,
+// oldest first, with their postings.
+func (r *SQLiteRepo) ListEntriesByReference(ctx context.Context, reference string) ([]domain.JournalEntry, error) {
+	rows, err := r.q.ListJournalEntriesByReference(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]domain.JournalEntry, 0, len(rows))
+	for _, row := range rows {
+		e, err := r.toDomainEntry(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (r *SQLiteRepo) toDomainEntry(ctx context.Context, row JournalEntry) (domain.JournalEntry, error) {
+	e := domain.JournalEntry{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		Kind:        row.Kind,
+		Reference:   row.Reference,
+		Description: row.Description,
+		CreatedAt:   row.CreatedAt,
+	}
+	postings, err := r.q.ListPostingsByEntry(ctx, row.ID)
+	if err != nil {
+		return e, err
+	}
+	for _, p := range postings {
+		e.Postings = append(e.Postings, domain.Posting{AccountID: p.AccountID, AccountType: p.Type, Amount: p.Amount, Currency: p.Currency})
+	}
+	return e, nil
+}
+
+// PostEntry writes the entry on the caller's transaction. Accounts are
+// created on first use; a second entry of the same kind for a reference, or
+// a second close of a reservation, fails with domain.ErrDuplicateEntry.
+func (u txStore) PostEntry(ctx context.Context, e domain.JournalEntry) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	err := u.q.CreateJournalEntry(ctx, CreateJournalEntryParams{
+		ID:          e.ID,
+		TenantID:    e.TenantID,
+		Kind:        e.Kind,
+		Reference:   e.Reference,
+		Description: e.Description,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return fmt.Errorf("%w: %s %s", domain.ErrDuplicateEntry, e.Kind, e.Reference)
+		}
+		return err
+	}
+	for _, p := range e.Postings {
+		err := u.q.EnsureLedgerAccount(ctx, EnsureLedgerAccountParams{
+			ID:            p.AccountID,
+			TenantID:      e.TenantID,
+			Type:          p.AccountType,
+			Currency:      p.Currency,
+			NormalBalance: domain.NormalBalance(p.AccountType),
+		})
+		if err != nil {
+			return err
+		}
+		err = u.q.CreatePosting(ctx, CreatePostingParams{
+			EntryID:   e.ID,
+			AccountID: p.AccountID,
+			Amount:    p.Amount,
+			Currency:  p.Currency,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccountBalance returns the account's balance on its normal side; accounts
+// that were never posted to are simply zero.
+func (u txStore) AccountBalance(ctx context.Context, tenantID, currency, accountType string) (int64, error) {
+	sum, err := u.q.GetAccountBalance(ctx, domain.LedgerAccountID(tenantID, currency, accountType))
+	if err != nil {
+		return 0, err
+	}
+	return domain.SignedBalance(accountType, sum), nil
+}
+
+// BalancesBefore returns each account type's balance, on its normal side,
+// from postings made before the given time.
+func (r *SQLiteRepo) BalancesBefore(ctx context.Context, tenantID, currency string, before time.Time) (map[string]int64, error) {
+	rows, err := r.q.SumTenantPostingsBefore(ctx, SumTenantPostingsBeforeParams{
+		TenantID: tenantID,
+		Currency: currency,
+		Before:   before.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		balances[row.Type] = domain.SignedBalance(row.Type, row.Total)
+	}
+	return balances, nil
+}
+
+// ListEntriesBetween returns the tenant's entries in [from, to), oldest
+// first, each with its postings in the given currency.
+func (r *SQLiteRepo) ListEntriesBetween(ctx context.Context, tenantID, currency string, from, to time.Time) ([]domain.JournalEntry, error) {
+	rows, err := r.q.ListTenantPostingsBetween(ctx, ListTenantPostingsBetweenParams{
+		TenantID: tenantID,
+		Currency: currency,
+		FromTime: from.UTC(),
+		ToTime:   to.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var entries []domain.JournalEntry
+	for _, row := range rows {
+		if len(entries) == 0 || entries[len(entries)-1].ID != row.ID {
+			entries = append(entries, domain.JournalEntry{
+				ID:          row.ID,
+				TenantID:    tenantID,
+				Kind:        row.Kind,
+				Reference:   row.Reference,
+				Description: row.Description,
+				CreatedAt:   row.CreatedAt,
+			})
+		}
+		e := &entries[len(entries)-1]
+		e.Postings = append(e.Postings, domain.Posting{
+			AccountID:   domain.LedgerAccountID(tenantID, currency, row.Type),
+			AccountType: row.Type,
+			Amount:      row.Amount,
+			Currency:    currency,
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.LimitUsageReader = (*SQLiteRepo)(nil)
+
+func (r *SQLiteRepo) RecipientTotal(ctx context.Context, tenantID string, account domain.RecipientAccount, currency string, since time.Time) (int64, error) {
+	return txStore{q: r.q, pii: r.pii}.RecipientTotal(ctx, tenantID, account, currency, since)
+}
+
+func (r *SQLiteRepo) CorridorTotal(ctx context.Context, tenantID, country, currency string, since time.Time) (int64, error) {
+	return txStore{q: r.q}.CorridorTotal(ctx, tenantID, country, currency, since)
+}
+
+// RecipientTotal sums a tenant's live payouts to one account since the given
+// time.
+func (u txStore) RecipientTotal(ctx context.Context, tenantID string, account domain.RecipientAccount, currency string, since time.Time) (int64, error) {
+	return u.q.SumRecipientPayouts(ctx, SumRecipientPayoutsParams{
+		TenantID:      tenantID,
+		CountryCode:   account.Country,
+		BankCode:      sql.NullString{String: account.BankCode, Valid: account.BankCode != ""},
+		AccountBidx:   u.pii.blindIndex(bidxAccount, account.AccountNumber),
+		AccountNumber: sql.NullString{String: account.AccountNumber, Valid: true},
+		Currency:      currency,
+		Since:         sql.NullTime{Time: since.UTC(), Valid: true},
+	})
+}
+
+// CorridorTotal sums a tenant's live payouts into a corridor currency since
+// the given time.
+func (u txStore) CorridorTotal(ctx context.Context, tenantID, country, currency string, since time.Time) (int64, error) {
+	return u.q.SumCorridorPayouts(ctx, SumCorridorPayoutsParams{
+		TenantID:    tenantID,
+		CountryCode: country,
+		Currency:    currency,
+		Since:       sql.NullTime{Time: since.UTC(), Valid: true},
+	})
+}
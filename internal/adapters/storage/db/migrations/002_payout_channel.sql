@@ -0,0 +1,2 @@
+-- Delivery channel per payout (BANK_ACCOUNT, MOBILE_MONEY), validated against the corridor registry
+ALTER TABLE payouts ADD COLUMN channel TEXT NOT NULL DEFAULT 'BANK_ACCOUNT';
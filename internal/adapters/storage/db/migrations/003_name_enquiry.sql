@@ -0,0 +1,3 @@
+-- Account name enquiry: holder name returned by the bank and how well it matched
+ALTER TABLE payouts ADD COLUMN resolved_account_name TEXT;
+ALTER TABLE payouts ADD COLUMN name_match_score REAL;
@@ -0,0 +1,2 @@
+-- recipient_phone keeps what the client sent; this is the normalized E.164 form sent to Afriex
+ALTER TABLE payouts ADD COLUMN recipient_phone_e164 TEXT;
@@ -0,0 +1,2 @@
+-- Sanctions list version each recipient was screened against (e.g. "OFAC@10/01/2026,UN@2026-10-01")
+ALTER TABLE payouts ADD COLUMN screening_list_version TEXT;
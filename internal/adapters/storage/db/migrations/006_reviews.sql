@@ -0,0 +1,16 @@
+-- Manual review queue: one row per hold, decided by a named reviewer
+CREATE TABLE reviews (
+    id TEXT PRIMARY KEY,
+    payout_id TEXT NOT NULL REFERENCES payouts(id),
+    batch_id TEXT NOT NULL,
+    reason TEXT NOT NULL,           -- SANCTIONS, NAME_MISMATCH, LARGE_AMOUNT
+    detail TEXT NOT NULL,           -- What the check found
+    status TEXT NOT NULL DEFAULT 'PENDING',
+    reviewer TEXT,
+    decision_reason TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_at DATETIME
+);
+
+CREATE INDEX idx_reviews_status ON reviews (status, created_at);
+CREATE INDEX idx_reviews_payout ON reviews (payout_id);
@@ -0,0 +1,17 @@
+-- Batches carry a maker-checker approval state
+ALTER TABLE batches ADD COLUMN reference TEXT NOT NULL DEFAULT '';
+ALTER TABLE batches ADD COLUMN submitted_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE batches ADD COLUMN approval_status TEXT NOT NULL DEFAULT 'NOT_REQUIRED'; -- NOT_REQUIRED, PENDING, APPROVED, REJECTED
+ALTER TABLE batches ADD COLUMN approval_reason TEXT NOT NULL DEFAULT '';
+
+-- Audit trail of who submitted, approved or rejected each batch
+CREATE TABLE batch_events (
+    id TEXT PRIMARY KEY,
+    batch_id TEXT NOT NULL REFERENCES batches(id),
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_batch_events_batch ON batch_events (batch_id, created_at);
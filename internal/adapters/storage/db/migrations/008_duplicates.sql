@@ -0,0 +1,5 @@
+-- Duplicate detection: hash of account + amount + currency, and the earlier payout it matched
+ALTER TABLE payouts ADD COLUMN fingerprint TEXT;
+ALTER TABLE payouts ADD COLUMN duplicate_of TEXT;
+
+CREATE INDEX idx_payouts_fingerprint ON payouts (fingerprint, created_at);
@@ -0,0 +1,3 @@
+-- Limit checks: live totals per recipient account per day and per corridor per month
+CREATE INDEX idx_payouts_recipient ON payouts (country_code, bank_code, account_number, created_at);
+CREATE INDEX idx_payouts_corridor ON payouts (country_code, currency, created_at);
@@ -0,0 +1,33 @@
+-- Double-entry ledger: balances are always derived from postings, never stored
+CREATE TABLE ledger_accounts (
+    id TEXT PRIMARY KEY,              -- tenant:CURRENCY:TYPE
+    tenant_id TEXT NOT NULL,
+    type TEXT NOT NULL,               -- FUNDING, AVAILABLE, RESERVED, SETTLED
+    currency TEXT NOT NULL,
+    normal_balance TEXT NOT NULL,     -- DEBIT or CREDIT
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE journal_entries (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL,
+    kind TEXT NOT NULL,               -- FUNDING, RESERVE, SETTLE, RELEASE
+    reference TEXT NOT NULL,          -- Payout ID or external funding reference
+    description TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE postings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id TEXT NOT NULL REFERENCES journal_entries(id),
+    account_id TEXT NOT NULL REFERENCES ledger_accounts(id),
+    amount INTEGER NOT NULL,          -- Minor units, debits positive, credits negative
+    currency TEXT NOT NULL
+);
+
+-- A reference is posted once per kind, and a reservation is closed (settled or released) once
+CREATE UNIQUE INDEX idx_journal_entries_kind_ref ON journal_entries (tenant_id, kind, reference);
+CREATE UNIQUE INDEX idx_journal_entries_closed ON journal_entries (tenant_id, reference) WHERE kind IN ('SETTLE', 'RELEASE');
+CREATE INDEX idx_journal_entries_reference ON journal_entries (reference);
+CREATE INDEX idx_postings_account ON postings (account_id);
+CREATE INDEX idx_postings_entry ON postings (entry_id);
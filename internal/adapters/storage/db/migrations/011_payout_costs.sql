@@ -0,0 +1,5 @@
+-- What Afriex actually debited per payout: conversion amount, fee and the rate obtained
+ALTER TABLE payouts ADD COLUMN source_amount INTEGER;
+ALTER TABLE payouts ADD COLUMN source_currency TEXT;
+ALTER TABLE payouts ADD COLUMN fee_amount INTEGER;
+ALTER TABLE payouts ADD COLUMN effective_rate TEXT;
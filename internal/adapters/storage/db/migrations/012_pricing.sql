@@ -0,0 +1,5 @@
+-- Waya's own fee per payout, priced from the tenant's fee schedule at submission
+ALTER TABLE payouts ADD COLUMN service_fee INTEGER NOT NULL DEFAULT 0;
+
+-- Invoices read a tenant's entries by month
+CREATE INDEX idx_journal_entries_tenant_created ON journal_entries (tenant_id, created_at);
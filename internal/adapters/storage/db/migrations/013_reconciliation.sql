@@ -0,0 +1,35 @@
+-- Afriex transaction ID per payout, so settlements can be matched to exports
+ALTER TABLE payouts ADD COLUMN transaction_id TEXT;
+
+CREATE INDEX idx_payouts_transaction ON payouts (transaction_id);
+
+-- One row per imported Afriex export
+CREATE TABLE reconciliation_runs (
+    id TEXT PRIMARY KEY,
+    source TEXT NOT NULL,                 -- File name of the export
+    period_from DATETIME NOT NULL,
+    period_to DATETIME NOT NULL,          -- Exclusive
+    records INTEGER NOT NULL,
+    matched INTEGER NOT NULL,
+    missing_ours INTEGER NOT NULL,
+    missing_theirs INTEGER NOT NULL,
+    amount_mismatches INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only exceptions are kept; matched records are counted on the run
+CREATE TABLE reconciliation_items (
+    id TEXT PRIMARY KEY,
+    run_id TEXT NOT NULL REFERENCES reconciliation_runs(id),
+    result TEXT NOT NULL,                 -- MISSING_OURS, MISSING_THEIRS, AMOUNT_MISMATCH
+    payout_id TEXT,
+    transaction_id TEXT,
+    reference TEXT,
+    our_amount INTEGER,
+    their_amount INTEGER,
+    currency TEXT NOT NULL,
+    detail TEXT NOT NULL
+);
+
+CREATE INDEX idx_reconciliation_runs_created ON reconciliation_runs (created_at);
+CREATE INDEX idx_reconciliation_items_run ON reconciliation_items (run_id, result);
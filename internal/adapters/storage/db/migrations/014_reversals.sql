@@ -0,0 +1,12 @@
+-- Payouts the bank returned after they showed SUCCESS, and their re-issues
+ALTER TABLE payouts ADD COLUMN reversal_source TEXT;    -- AFRIEX_WEBHOOK, RECONCILIATION, ADMIN
+ALTER TABLE payouts ADD COLUMN reversal_reason TEXT;
+ALTER TABLE payouts ADD COLUMN reversed_by TEXT;
+ALTER TABLE payouts ADD COLUMN reversed_at DATETIME;
+ALTER TABLE payouts ADD COLUMN reissue_of TEXT;         -- Reversed payout this one re-sends
+ALTER TABLE payouts ADD COLUMN reissued_as TEXT;        -- Set once, so a reversal is re-issued at most once
+
+CREATE INDEX idx_payouts_reissue_of ON payouts (reissue_of);
+
+-- Reversals spotted while reconciling an export
+ALTER TABLE reconciliation_runs ADD COLUMN reversed INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,18 @@
+-- Business units sharing the deployment; each is resolved from its API key
+CREATE TABLE tenants (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    api_key_hash TEXT UNIQUE,            -- SHA-256 of the API key; the key itself is never stored
+    webhook_url TEXT NOT NULL DEFAULT '', -- Client webhook for this tenant's events
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The operator's tenant owns everything created before tenants existed
+INSERT INTO tenants (id, name) VALUES ('default', 'Default');
+
+ALTER TABLE payouts ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE batches ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX idx_payouts_tenant_batch ON payouts (tenant_id, batch_id);
+CREATE INDEX idx_payouts_tenant_created ON payouts (tenant_id, created_at);
+CREATE INDEX idx_batches_tenant ON batches (tenant_id, created_at);
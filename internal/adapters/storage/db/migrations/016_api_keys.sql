@@ -0,0 +1,27 @@
+-- API keys, several per tenant. Only a SHA-256 of each key is stored; the
+-- prefix identifies a key in lists and logs without revealing it.
+CREATE TABLE api_keys (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL REFERENCES tenants (id),
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT NOT NULL,                -- Space separated: payouts:read payouts:write admin
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME,                 -- NULL never expires
+    last_used_at DATETIME,
+    revoked_at DATETIME,
+    revoked_by TEXT,
+    replaced_by TEXT                     -- Key that rotation issued in its place
+);
+
+CREATE INDEX idx_api_keys_tenant ON api_keys (tenant_id, created_at);
+
+-- Keys issued before this table existed become admin keys of their tenant
+INSERT INTO api_keys (id, tenant_id, name, prefix, key_hash, scopes, created_by)
+SELECT lower(hex(randomblob(16))), id, 'Initial key', '', api_key_hash, 'admin', 'migration'
+FROM tenants WHERE api_key_hash IS NOT NULL;
+
+-- tenants.api_key_hash is no longer read
+UPDATE tenants SET api_key_hash = NULL;
@@ -0,0 +1,24 @@
+-- Token-bucket request limits. An empty key_id applies to each of the
+-- tenant's keys, and route '*' to every route without a rule of its own.
+CREATE TABLE rate_limits (
+    tenant_id TEXT NOT NULL REFERENCES tenants (id),
+    key_id TEXT NOT NULL DEFAULT '',
+    route TEXT NOT NULL DEFAULT '*',     -- Method and route pattern, e.g. POST /api/v1/payouts
+    per_minute INTEGER NOT NULL,
+    burst INTEGER NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, key_id, route)
+);
+
+-- Daily caps on payout items submitted. An empty key_id caps the whole tenant.
+CREATE TABLE payout_quotas (
+    tenant_id TEXT NOT NULL REFERENCES tenants (id),
+    key_id TEXT NOT NULL DEFAULT '',
+    daily_items INTEGER NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, key_id)
+);
+
+-- Which key submitted a batch, so quotas can be counted per key
+ALTER TABLE batches ADD COLUMN api_key_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX idx_batches_api_key ON batches (api_key_id, created_at);
@@ -0,0 +1,17 @@
+-- Signed requests name their key by prefix, so prefixes must be unique.
+-- Keys migrated from tenants have none and cannot sign.
+CREATE UNIQUE INDEX idx_api_keys_prefix ON api_keys (prefix) WHERE prefix != '';
+
+-- Keys that refuse the plain x-api-key header
+ALTER TABLE api_keys ADD COLUMN signed_only BOOLEAN NOT NULL DEFAULT 0;
+
+-- Nonces of signed requests, kept until the request's timestamp falls
+-- outside the clock skew window and a replay would be refused anyway
+CREATE TABLE request_nonces (
+    key_id TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (key_id, nonce)
+);
+
+CREATE INDEX idx_request_nonces_expiry ON request_nonces (expires_at);
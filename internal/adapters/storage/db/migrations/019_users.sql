@@ -0,0 +1,29 @@
+-- Dashboard users. Emails are unique across tenants so login needs no tenant.
+CREATE TABLE users (
+    id TEXT PRIMARY KEY,
+    tenant_id TEXT NOT NULL REFERENCES tenants (id),
+    email TEXT NOT NULL UNIQUE,          -- Lower case
+    name TEXT NOT NULL,
+    role TEXT NOT NULL,                  -- viewer, operator, approver or admin
+    password_hash TEXT NOT NULL,         -- bcrypt
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at DATETIME,
+    disabled_at DATETIME
+);
+
+CREATE INDEX idx_users_tenant ON users (tenant_id, email);
+
+-- Refresh tokens, stored as SHA-256. Each is used once; refreshing records
+-- the token that replaced it.
+CREATE TABLE refresh_tokens (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users (id),
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    replaced_by TEXT
+);
+
+CREATE INDEX idx_refresh_tokens_user ON refresh_tokens (user_id);
@@ -0,0 +1,32 @@
+-- Append-only audit trail of administrative and money-moving actions. Each
+-- row carries the SHA-256 of its contents and of the row before it, so an
+-- edited, inserted or removed row breaks the chain.
+CREATE TABLE audit_log (
+    id INTEGER PRIMARY KEY,              -- Sequence, 1 up, without gaps
+    at DATETIME NOT NULL,
+    tenant_id TEXT NOT NULL,             -- No foreign key: entries outlive what they describe
+    actor TEXT NOT NULL,                 -- Who acted: a user's email or the name a key caller gave
+    principal TEXT NOT NULL DEFAULT '',  -- The credential used, e.g. key:wk_3f9a01bc
+    action TEXT NOT NULL,                -- e.g. batch.approved
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    request_id TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    before_state TEXT NOT NULL DEFAULT '', -- JSON, empty when there was nothing before
+    after_state TEXT NOT NULL DEFAULT '',
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX idx_audit_log_tenant ON audit_log (tenant_id, id);
+CREATE INDEX idx_audit_log_target ON audit_log (target_type, target_id);
+
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
@@ -0,0 +1,18 @@
+-- Envelope encryption of recipient details. Each key is random and stored
+-- sealed with a master key that never touches the database.
+CREATE TABLE pii_keys (
+    id TEXT PRIMARY KEY,
+    purpose TEXT NOT NULL,              -- DATA encrypts columns, INDEX keys the blind indexes
+    wrapped_key TEXT NOT NULL,          -- AES-GCM sealed with the master key, base64
+    master_key_id TEXT NOT NULL,        -- Which master key sealed it
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    retired_at DATETIME                 -- Set when a newer DATA key takes over; still decrypts old rows
+);
+
+-- Blind indexes: keyed hashes of the normalized account number and phone,
+-- so payouts can be found by recipient without decrypting every row
+ALTER TABLE payouts ADD COLUMN account_number_bidx TEXT;
+ALTER TABLE payouts ADD COLUMN recipient_phone_bidx TEXT;
+
+CREATE INDEX idx_payouts_account_bidx ON payouts (account_number_bidx, created_at);
+CREATE INDEX idx_payouts_phone_bidx ON payouts (recipient_phone_bidx, created_at);
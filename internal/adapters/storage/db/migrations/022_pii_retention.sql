@@ -0,0 +1,5 @@
+-- Recipient details erased on request or after the retention period. The
+-- payout stays for the books; its details are blanked and this is set.
+ALTER TABLE payouts ADD COLUMN pii_erased_at DATETIME;
+
+CREATE INDEX idx_payouts_retention ON payouts (pii_erased_at, created_at);
@@ -0,0 +1,4 @@
+-- Signed requests are keyed with a secret derived from the API key
+-- (pkg/wayasign.SigningKey), sealed under the PII keys. key_hash no longer
+-- signs, so keys issued before this cannot sign until they are rotated.
+ALTER TABLE api_keys ADD COLUMN signing_secret TEXT;
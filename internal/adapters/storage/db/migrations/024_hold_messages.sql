@@ -0,0 +1,11 @@
+-- Held payouts carried the hold's detail, which can quote the recipient's
+-- and resolved account names, in plaintext. The review keeps the detail;
+-- the payout keeps only the reason.
+UPDATE payouts
+SET error_message = COALESCE('held for review: ' || (
+    SELECT reason FROM reviews
+    WHERE reviews.payout_id = payouts.id
+    ORDER BY created_at DESC, id DESC
+    LIMIT 1
+), 'held for review')
+WHERE status IN ('HELD_REVIEW', 'HELD_COMPLIANCE');
@@ -6,32 +6,234 @@ package db
 
 import (
 	"database/sql"
+	"time"
 )
 
+type ApiKey struct {
+	ID            string         `json:"id"`
+	TenantID      string         `json:"tenant_id"`
+	Name          string         `json:"name"`
+	Prefix        string         `json:"prefix"`
+	KeyHash       string         `json:"key_hash"`
+	Scopes        string         `json:"scopes"`
+	CreatedBy     string         `json:"created_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+	ExpiresAt     sql.NullTime   `json:"expires_at"`
+	LastUsedAt    sql.NullTime   `json:"last_used_at"`
+	RevokedAt     sql.NullTime   `json:"revoked_at"`
+	RevokedBy     sql.NullString `json:"revoked_by"`
+	ReplacedBy    sql.NullString `json:"replaced_by"`
+	SignedOnly    bool           `json:"signed_only"`
+	SigningSecret sql.NullString `json:"signing_secret"`
+}
+
+type AuditLog struct {
+	ID          int64     `json:"id"`
+	At          time.Time `json:"at"`
+	TenantID    string    `json:"tenant_id"`
+	Actor       string    `json:"actor"`
+	Principal   string    `json:"principal"`
+	Action      string    `json:"action"`
+	TargetType  string    `json:"target_type"`
+	TargetID    string    `json:"target_id"`
+	RequestID   string    `json:"request_id"`
+	Ip          string    `json:"ip"`
+	BeforeState string    `json:"before_state"`
+	AfterState  string    `json:"after_state"`
+	PrevHash    string    `json:"prev_hash"`
+	Hash        string    `json:"hash"`
+}
+
 type Batch struct {
-	ID          string       `json:"id"`
-	TotalAmount int64        `json:"total_amount"`
-	TotalCount  int64        `json:"total_count"`
-	Status      string       `json:"status"`
-	CreatedAt   sql.NullTime `json:"created_at"`
+	ID             string       `json:"id"`
+	TotalAmount    int64        `json:"total_amount"`
+	TotalCount     int64        `json:"total_count"`
+	Status         string       `json:"status"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+	Reference      string       `json:"reference"`
+	SubmittedBy    string       `json:"submitted_by"`
+	ApprovalStatus string       `json:"approval_status"`
+	ApprovalReason string       `json:"approval_reason"`
+	TenantID       string       `json:"tenant_id"`
+	ApiKeyID       string       `json:"api_key_id"`
+}
+
+type BatchEvent struct {
+	ID        string    `json:"id"`
+	BatchID   string    `json:"batch_id"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type JournalEntry struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	Kind        string    `json:"kind"`
+	Reference   string    `json:"reference"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type LedgerAccount struct {
+	ID            string    `json:"id"`
+	TenantID      string    `json:"tenant_id"`
+	Type          string    `json:"type"`
+	Currency      string    `json:"currency"`
+	NormalBalance string    `json:"normal_balance"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 type Payout struct {
+	ID                   string          `json:"id"`
+	BatchID              sql.NullString  `json:"batch_id"`
+	ReferenceID          string          `json:"reference_id"`
+	RecipientName        string          `json:"recipient_name"`
+	RecipientPhone       string          `json:"recipient_phone"`
+	RecipientEmail       sql.NullString  `json:"recipient_email"`
+	RecipientTag         sql.NullString  `json:"recipient_tag"`
+	CountryCode          string          `json:"country_code"`
+	BankCode             sql.NullString  `json:"bank_code"`
+	BankName             sql.NullString  `json:"bank_name"`
+	AccountNumber        sql.NullString  `json:"account_number"`
+	Amount               int64           `json:"amount"`
+	Currency             string          `json:"currency"`
+	Status               string          `json:"status"`
+	ErrorMessage         sql.NullString  `json:"error_message"`
+	CreatedAt            sql.NullTime    `json:"created_at"`
+	UpdatedAt            sql.NullTime    `json:"updated_at"`
+	Channel              string          `json:"channel"`
+	ResolvedAccountName  sql.NullString  `json:"resolved_account_name"`
+	NameMatchScore       sql.NullFloat64 `json:"name_match_score"`
+	RecipientPhoneE164   sql.NullString  `json:"recipient_phone_e164"`
+	ScreeningListVersion sql.NullString  `json:"screening_list_version"`
+	Fingerprint          sql.NullString  `json:"fingerprint"`
+	DuplicateOf          sql.NullString  `json:"duplicate_of"`
+	SourceAmount         sql.NullInt64   `json:"source_amount"`
+	SourceCurrency       sql.NullString  `json:"source_currency"`
+	FeeAmount            sql.NullInt64   `json:"fee_amount"`
+	EffectiveRate        sql.NullString  `json:"effective_rate"`
+	ServiceFee           int64           `json:"service_fee"`
+	TransactionID        sql.NullString  `json:"transaction_id"`
+	ReversalSource       sql.NullString  `json:"reversal_source"`
+	ReversalReason       sql.NullString  `json:"reversal_reason"`
+	ReversedBy           sql.NullString  `json:"reversed_by"`
+	ReversedAt           sql.NullTime    `json:"reversed_at"`
+	ReissueOf            sql.NullString  `json:"reissue_of"`
+	ReissuedAs           sql.NullString  `json:"reissued_as"`
+	TenantID             string          `json:"tenant_id"`
+	AccountNumberBidx    sql.NullString  `json:"account_number_bidx"`
+	RecipientPhoneBidx   sql.NullString  `json:"recipient_phone_bidx"`
+	PiiErasedAt          sql.NullTime    `json:"pii_erased_at"`
+}
+
+type PayoutQuota struct {
+	TenantID   string    `json:"tenant_id"`
+	KeyID      string    `json:"key_id"`
+	DailyItems int64     `json:"daily_items"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type PiiKey struct {
+	ID          string       `json:"id"`
+	Purpose     string       `json:"purpose"`
+	WrappedKey  string       `json:"wrapped_key"`
+	MasterKeyID string       `json:"master_key_id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	RetiredAt   sql.NullTime `json:"retired_at"`
+}
+
+type Posting struct {
+	ID        int64  `json:"id"`
+	EntryID   string `json:"entry_id"`
+	AccountID string `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+type RateLimit struct {
+	TenantID  string    `json:"tenant_id"`
+	KeyID     string    `json:"key_id"`
+	Route     string    `json:"route"`
+	PerMinute int64     `json:"per_minute"`
+	Burst     int64     `json:"burst"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReconciliationItem struct {
+	ID            string         `json:"id"`
+	RunID         string         `json:"run_id"`
+	Result        string         `json:"result"`
+	PayoutID      sql.NullString `json:"payout_id"`
+	TransactionID sql.NullString `json:"transaction_id"`
+	Reference     sql.NullString `json:"reference"`
+	OurAmount     sql.NullInt64  `json:"our_amount"`
+	TheirAmount   sql.NullInt64  `json:"their_amount"`
+	Currency      string         `json:"currency"`
+	Detail        string         `json:"detail"`
+}
+
+type ReconciliationRun struct {
+	ID               string    `json:"id"`
+	Source           string    `json:"source"`
+	PeriodFrom       time.Time `json:"period_from"`
+	PeriodTo         time.Time `json:"period_to"`
+	Records          int64     `json:"records"`
+	Matched          int64     `json:"matched"`
+	MissingOurs      int64     `json:"missing_ours"`
+	MissingTheirs    int64     `json:"missing_theirs"`
+	AmountMismatches int64     `json:"amount_mismatches"`
+	CreatedAt        time.Time `json:"created_at"`
+	Reversed         int64     `json:"reversed"`
+}
+
+type RefreshToken struct {
+	ID         string         `json:"id"`
+	UserID     string         `json:"user_id"`
+	TokenHash  string         `json:"token_hash"`
+	CreatedAt  time.Time      `json:"created_at"`
+	ExpiresAt  time.Time      `json:"expires_at"`
+	RevokedAt  sql.NullTime   `json:"revoked_at"`
+	ReplacedBy sql.NullString `json:"replaced_by"`
+}
+
+type RequestNonce struct {
+	KeyID     string    `json:"key_id"`
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type Review struct {
 	ID             string         `json:"id"`
-	BatchID        sql.NullString `json:"batch_id"`
-	ReferenceID    string         `json:"reference_id"`
-	RecipientName  string         `json:"recipient_name"`
-	RecipientPhone string         `json:"recipient_phone"`
-	RecipientEmail sql.NullString `json:"recipient_email"`
-	RecipientTag   sql.NullString `json:"recipient_tag"`
-	CountryCode    string         `json:"country_code"`
-	BankCode       sql.NullString `json:"bank_code"`
-	BankName       sql.NullString `json:"bank_name"`
-	AccountNumber  sql.NullString `json:"account_number"`
-	Amount         int64          `json:"amount"`
-	Currency       string         `json:"currency"`
+	PayoutID       string         `json:"payout_id"`
+	BatchID        string         `json:"batch_id"`
+	Reason         string         `json:"reason"`
+	Detail         string         `json:"detail"`
 	Status         string         `json:"status"`
-	ErrorMessage   sql.NullString `json:"error_message"`
-	CreatedAt      sql.NullTime   `json:"created_at"`
-	UpdatedAt      sql.NullTime   `json:"updated_at"`
+	Reviewer       sql.NullString `json:"reviewer"`
+	DecisionReason sql.NullString `json:"decision_reason"`
+	CreatedAt      time.Time      `json:"created_at"`
+	DecidedAt      sql.NullTime   `json:"decided_at"`
+}
+
+type Tenant struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	ApiKeyHash sql.NullString `json:"api_key_hash"`
+	WebhookUrl string         `json:"webhook_url"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type User struct {
+	ID           string       `json:"id"`
+	TenantID     string       `json:"tenant_id"`
+	Email        string       `json:"email"`
+	Name         string       `json:"name"`
+	Role         string       `json:"role"`
+	PasswordHash string       `json:"password_hash"`
+	CreatedBy    string       `json:"created_by"`
+	CreatedAt    time.Time    `json:"created_at"`
+	LastLoginAt  sql.NullTime `json:"last_login_at"`
+	DisabledAt   sql.NullTime `json:"disabled_at"`
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	// "fmt"
 	// "waya/internal/adapters/storage/db"
@@ -12,19 +13,49 @@ import (
 )
 
 type SQLiteRepo struct {
-	q *Queries
+	conn *sql.DB
+	q    *Queries
+	pii  *PIICipher
 }
 
 // Ensure SQLiteRepo implements PaymentRepository
 var _ ports.PaymentRepository = (*SQLiteRepo)(nil)
 
-func NewRepository(database *Database) *SQLiteRepo {
+// NewRepository stores recipient details encrypted with pii; a nil pii
+// stores them in plaintext.
+func NewRepository(database *Database, pii *PIICipher) *SQLiteRepo {
 	return &SQLiteRepo{
-		q: database.Q,
+		conn: database.Conn,
+		q:    database.Q,
+		pii:  pii,
 	}
 }
 
+// withTx runs fn inside a single transaction, rolling back on error.
+func (r *SQLiteRepo) withTx(ctx context.Context, fn func(q *Queries) error) error {
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(r.q.WithTx(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
 func (r *SQLiteRepo) SavePayout(ctx context.Context, p domain.Payout) error {
+	return r.insertPayout(ctx, r.q, p)
+}
+
+// insertPayout encrypts the recipient details, indexes the account number
+// and phone and keys the duplicate fingerprint before writing.
+func (r *SQLiteRepo) insertPayout(ctx context.Context, q *Queries, p domain.Payout) error {
+	accountIdx, phoneIdx := r.pii.payoutIndexes(p)
+	if err := r.pii.sealPayout(&p); err != nil {
+		return err
+	}
+
     // Handle Nullable Strings for SQLC
     email := sql.NullString{String: p.RecipientEmail, Valid: p.RecipientEmail != ""}
     tag := sql.NullString{String: p.RecipientTag, Valid: p.RecipientTag != ""}
@@ -33,8 +64,9 @@ func (r *SQLiteRepo) SavePayout(ctx context.Context, p domain.Payout) error {
     bankName := sql.NullString{String: p.BankName, Valid: p.BankName != ""}
     batchID := sql.NullString{String: p.BatchID, Valid: p.BatchID != ""}
 
-    _, err := r.q.CreatePayout(ctx, CreatePayoutParams{
+    _, err := q.CreatePayout(ctx, CreatePayoutParams{
         ID:             p.ID,
+        TenantID:       p.TenantID,
         BatchID:        batchID,
         ReferenceID:    p.ReferenceID,
         RecipientName:  p.RecipientName,
@@ -48,13 +80,23 @@ func (r *SQLiteRepo) SavePayout(ctx context.Context, p domain.Payout) error {
         Amount:         p.Amount,
         Currency:       p.Currency,
         Status:         p.Status,
+        Channel:        p.Channel,
+        RecipientPhoneE164: sql.NullString{String: p.RecipientPhoneE164, Valid: p.RecipientPhoneE164 != ""},
+        ErrorMessage:       sql.NullString{String: p.ErrorMessage, Valid: p.ErrorMessage != ""},
+        ScreeningListVersion: sql.NullString{String: p.ScreeningListVersion, Valid: p.ScreeningListVersion != ""},
+        Fingerprint:          r.pii.fingerprint(p.Fingerprint),
+        DuplicateOf:          sql.NullString{String: p.DuplicateOf, Valid: p.DuplicateOf != ""},
+        ServiceFee:           p.ServiceFee,
+        ReissueOf:            sql.NullString{String: p.ReissueOf, Valid: p.ReissueOf != ""},
+        AccountNumberBidx:    accountIdx,
+        RecipientPhoneBidx:   phoneIdx,
     })
     return err
 }
 
 // Also update GetPayout and ListPayouts to map back from DB to Domain!
-func (r *SQLiteRepo) GetPayout(ctx context.Context, id string) (*domain.Payout, error) {
-    row, err := r.q.GetPayout(ctx, id)
+func (r *SQLiteRepo) GetPayout(ctx context.Context, tenantID, id string) (*domain.Payout, error) {
+    row, err := r.q.GetPayout(ctx, GetPayoutParams{TenantID: tenantID, ID: id})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // Not found, return nil without error
@@ -62,22 +104,28 @@ func (r *SQLiteRepo) GetPayout(ctx context.Context, id string) (*domain.Payout,
 		return nil, err
 	}
 
-	// Map DB model to Domain model, handling NULLs
-    return &domain.Payout{
-        ID:             row.ID,
-        BatchID:        row.BatchID.String,
-        ReferenceID:    row.ReferenceID,
-        RecipientName:  row.RecipientName,
-        RecipientPhone: row.RecipientPhone,
-        RecipientEmail: row.RecipientEmail.String,
-        CountryCode:    row.CountryCode,
-        BankCode:       row.BankCode.String,
-        AccountNumber:  row.AccountNumber.String,
-        Amount:         row.Amount,
-        Currency:       row.Currency,
-        Status:         row.Status,
-        // ... rest
-    }, nil
+	p, err := r.payout(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// FindPayoutByID looks a payout up whatever its tenant, for platform work
+// such as reversals reported by Afriex. It returns nil when there is none.
+func (r *SQLiteRepo) FindPayoutByID(ctx context.Context, id string) (*domain.Payout, error) {
+	row, err := r.q.FindPayoutByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	p, err := r.payout(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
 }
 
 func (r *SQLiteRepo) UpdatePayoutStatus(ctx context.Context, id string, status string, errMsg string) error {
@@ -91,64 +139,240 @@ func (r *SQLiteRepo) UpdatePayoutStatus(ctx context.Context, id string, status s
 	})
 }
 
-func (r *SQLiteRepo) ListPayouts(ctx context.Context, limit int) ([]domain.Payout, error) {
-	rows, err := r.q.ListPayouts(ctx)
+func (r *SQLiteRepo) SetResolvedAccountName(ctx context.Context, id string, name string, score float64) error {
+	name, err := r.pii.seal(name, "payouts.resolved_account_name/"+id)
+	if err != nil {
+		return err
+	}
+	return r.q.SetResolvedAccountName(ctx, SetResolvedAccountNameParams{
+		ID:                  id,
+		ResolvedAccountName: sql.NullString{String: name, Valid: name != ""},
+		NameMatchScore:      sql.NullFloat64{Float64: score, Valid: true},
+	})
+}
+
+func (r *SQLiteRepo) SetScreeningListVersion(ctx context.Context, id string, version string) error {
+	return r.q.SetScreeningListVersion(ctx, SetScreeningListVersionParams{
+		ID:                   id,
+		ScreeningListVersion: sql.NullString{String: version, Valid: version != ""},
+	})
+}
+
+// ReleaseHeldPayout moves a held payout on to status. It fails with
+// domain.ErrPayoutNotHeld if the payout is no longer held, so two reviewers
+// deciding at once cannot both resume or reject it.
+func (r *SQLiteRepo) ReleaseHeldPayout(ctx context.Context, id string, status string, errMsg string) error {
+	n, err := r.q.ReleaseHeldPayout(ctx, ReleaseHeldPayoutParams{
+		ID:           id,
+		Status:       status,
+		ErrorMessage: sql.NullString{String: errMsg, Valid: errMsg != ""},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrPayoutNotHeld
+	}
+	return nil
+}
+
+func (r *SQLiteRepo) SetPayoutTransactionID(ctx context.Context, id string, transactionID string) error {
+	return r.q.SetPayoutTransactionID(ctx, SetPayoutTransactionIDParams{
+		ID:            id,
+		TransactionID: sql.NullString{String: transactionID, Valid: transactionID != ""},
+	})
+}
+
+func (r *SQLiteRepo) SetPayoutCost(ctx context.Context, id string, cost domain.PayoutCost) error {
+	return r.q.SetPayoutCost(ctx, SetPayoutCostParams{
+		ID:             id,
+		SourceAmount:   sql.NullInt64{Int64: cost.Source.Amount, Valid: true},
+		SourceCurrency: sql.NullString{String: cost.Source.Currency, Valid: true},
+		FeeAmount:      sql.NullInt64{Int64: cost.Fee.Amount, Valid: true},
+		EffectiveRate:  sql.NullString{String: cost.EffectiveRate, Valid: cost.EffectiveRate != ""},
+	})
+}
+
+// ReversePayout marks a SUCCESS payout REVERSED. It fails with
+// domain.ErrNotReversible if the payout is in any other state, so a webhook
+// and a reconciliation run reporting the same return can't both apply it.
+func (r *SQLiteRepo) ReversePayout(ctx context.Context, id string, rev domain.Reversal) error {
+	n, err := r.q.ReversePayout(ctx, ReversePayoutParams{
+		ID:             id,
+		ReversalSource: sql.NullString{String: rev.Source, Valid: true},
+		ReversalReason: sql.NullString{String: rev.Reason, Valid: rev.Reason != ""},
+		ReversedBy:     sql.NullString{String: rev.Actor, Valid: rev.Actor != ""},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotReversible
+	}
+	return nil
+}
+
+// ClaimReissue links a reversed payout to its replacement. Only one claim
+// wins; the rest get domain.ErrAlreadyReissued.
+func (r *SQLiteRepo) ClaimReissue(ctx context.Context, id, reissueID string) error {
+	n, err := r.q.ClaimPayoutReissue(ctx, ClaimPayoutReissueParams{
+		ID:         id,
+		ReissuedAs: sql.NullString{String: reissueID, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrAlreadyReissued
+	}
+	return nil
+}
+
+// ReleaseReissue undoes ClaimReissue when the replacement could not be created.
+func (r *SQLiteRepo) ReleaseReissue(ctx context.Context, id, reissueID string) error {
+	return r.q.ReleasePayoutReissue(ctx, ReleasePayoutReissueParams{
+		ID:         id,
+		ReissuedAs: sql.NullString{String: reissueID, Valid: true},
+	})
+}
+
+func (r *SQLiteRepo) ListPayouts(ctx context.Context, tenantID string, limit int) ([]domain.Payout, error) {
+	rows, err := r.q.ListPayouts(ctx, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
-    var payouts []domain.Payout
-    for _, row := range rows {
-        payouts = append(payouts, domain.Payout{
-            ID:             row.ID,
-            BatchID:        row.BatchID.String,
-            ReferenceID:    row.ReferenceID,
-            RecipientName:  row.RecipientName,
-            RecipientPhone: row.RecipientPhone,
-            RecipientEmail: row.RecipientEmail.String,
-            CountryCode:    row.CountryCode,
-            BankCode:       row.BankCode.String,
-            AccountNumber:  row.AccountNumber.String,
-            BankName:       row.BankName.String,
-            Amount:         row.Amount,
-            Currency:       row.Currency,
-            Status:         row.Status,
-        })
-    }
+	return r.payouts(ctx, rows)
+}
 
-	return payouts, nil
+// FindRecentDuplicate returns the newest live payout with the same
+// fingerprint created since the given time, or nil if there is none.
+func (u txStore) FindRecentDuplicate(ctx context.Context, tenantID, fingerprint string, since time.Time) (*domain.Payout, error) {
+	row, err := u.q.FindRecentDuplicate(ctx, FindRecentDuplicateParams{
+		TenantID:         tenantID,
+		Fingerprint:      u.pii.fingerprint(fingerprint),
+		PlainFingerprint: sql.NullString{String: fingerprint, Valid: true},
+		Since:            sql.NullTime{Time: since.UTC(), Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	p := toDomainPayout(row)
+	if err := u.pii.openPayout(ctx, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
 }
 
 // New, efficient method to get payouts by BatchID
-func (r *SQLiteRepo) ListPayoutsByBatchID(ctx context.Context, batchID string) ([]domain.Payout, error) {
+func (r *SQLiteRepo) ListPayoutsByBatchID(ctx context.Context, tenantID, batchID string) ([]domain.Payout, error) {
     // Call the SQLC-generated function directly
-    rows, err := r.q.ListPayoutsByBatchID(ctx, sql.NullString{String: batchID, Valid: true})
+    rows, err := r.q.ListPayoutsByBatchID(ctx, ListPayoutsByBatchIDParams{
+        TenantID: tenantID,
+        BatchID:  sql.NullString{String: batchID, Valid: true},
+    })
     if err != nil {
         return nil, err
     }
 
-    // Map rows to domain.Payout (you must write the mapping logic here)
-    var payouts []domain.Payout
-    for _, row := range rows {
-        payouts = append(payouts, domain.Payout{
-            ID:             row.ID,
-            BatchID:        row.BatchID.String,
-            ReferenceID:    row.ReferenceID,
-            RecipientName:  row.RecipientName,
-            RecipientPhone: row.RecipientPhone,
-            RecipientEmail: row.RecipientEmail.String,
-            CountryCode:    row.CountryCode,
-            BankCode:       row.BankCode.String,
-            AccountNumber:  row.AccountNumber.String,
-            BankName:       row.BankName.String,
-            Amount:         row.Amount,
-            Currency:       row.Currency,
-            Status:         row.Status,
-        })
-    }
-
-    if len(payouts) == 0 {
+    if len(rows) == 0 {
         return nil, fmt.Errorf("not found") // Return error on empty set
     }
-    return payouts, nil
-}
\ No newline at end of file
+    return r.payouts(ctx, rows)
+}
+
+// ListPayoutsByRecipient finds the tenant's payouts to an account number or
+// phone (E.164), newest first, through their blind indexes. Either may be
+// empty.
+func (r *SQLiteRepo) ListPayoutsByRecipient(ctx context.Context, tenantID string, recipient domain.RecipientLookup, limit int) ([]domain.Payout, error) {
+	rows, err := r.q.ListPayoutsByRecipient(ctx, ListPayoutsByRecipientParams{
+		TenantID:      tenantID,
+		AccountBidx:   r.pii.blindIndex(bidxAccount, recipient.AccountNumber),
+		AccountNumber: nullString(recipient.AccountNumber),
+		PhoneBidx:     r.pii.blindIndex(bidxPhone, recipient.Phone),
+		Phone:         nullString(recipient.Phone),
+		RowLimit:      int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.payouts(ctx, rows)
+}
+
+// payout maps a row to the domain model and decrypts its recipient details.
+func (r *SQLiteRepo) payout(ctx context.Context, row Payout) (domain.Payout, error) {
+	p := toDomainPayout(row)
+	if err := r.pii.openPayout(ctx, &p); err != nil {
+		return domain.Payout{}, err
+	}
+	return p, nil
+}
+
+func (r *SQLiteRepo) payouts(ctx context.Context, rows []Payout) ([]domain.Payout, error) {
+	payouts := make([]domain.Payout, 0, len(rows))
+	for _, row := range rows {
+		p, err := r.payout(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		payouts = append(payouts, p)
+	}
+	return payouts, nil
+}
+
+// toDomainPayout maps a DB row to the domain model, flattening NULLs.
+func toDomainPayout(row Payout) domain.Payout {
+	return domain.Payout{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		BatchID:        row.BatchID.String,
+		ReferenceID:    row.ReferenceID,
+		RecipientName:  row.RecipientName,
+		RecipientPhone: row.RecipientPhone,
+		RecipientEmail: row.RecipientEmail.String,
+		RecipientTag:   row.RecipientTag.String,
+
+		RecipientPhoneE164: row.RecipientPhoneE164.String,
+
+		CountryCode:    row.CountryCode,
+		BankCode:       row.BankCode.String,
+		AccountNumber:  row.AccountNumber.String,
+		BankName:       row.BankName.String,
+		Channel:        row.Channel,
+
+		ResolvedAccountName: row.ResolvedAccountName.String,
+		NameMatchScore:      row.NameMatchScore.Float64,
+
+		ScreeningListVersion: row.ScreeningListVersion.String,
+
+		Fingerprint: row.Fingerprint.String,
+		DuplicateOf: row.DuplicateOf.String,
+
+		ServiceFee: row.ServiceFee,
+
+		TransactionID:  row.TransactionID.String,
+		SourceAmount:   row.SourceAmount.Int64,
+		SourceCurrency: row.SourceCurrency.String,
+		FeeAmount:      row.FeeAmount.Int64,
+		EffectiveRate:  row.EffectiveRate.String,
+
+		ReversalSource: row.ReversalSource.String,
+		ReversalReason: row.ReversalReason.String,
+		ReversedBy:     row.ReversedBy.String,
+		ReversedAt:     row.ReversedAt.Time,
+		ReissueOf:      row.ReissueOf.String,
+		ReissuedAs:     row.ReissuedAs.String,
+
+		PIIErasedAt: row.PiiErasedAt.Time,
+
+		Amount:         row.Amount,
+		Currency:       row.Currency,
+		Status:         row.Status,
+		ErrorMessage:   row.ErrorMessage.String,
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
@@ -10,41 +10,78 @@ import (
 	"database/sql"
 )
 
+const claimPayoutReissue = `-- name: ClaimPayoutReissue :execrows
+UPDATE payouts
+SET reissued_as = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'REVERSED' AND reissued_as IS NULL
+`
+
+type ClaimPayoutReissueParams struct {
+	ReissuedAs sql.NullString `json:"reissued_as"`
+	ID         string         `json:"id"`
+}
+
+func (q *Queries) ClaimPayoutReissue(ctx context.Context, arg ClaimPayoutReissueParams) (int64, error) {
+	result, err := q.exec(ctx, q.claimPayoutReissueStmt, claimPayoutReissue, arg.ReissuedAs, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createPayout = `-- name: CreatePayout :one
 INSERT INTO payouts (
-  id, batch_id, reference_id, 
+  id, tenant_id, batch_id, reference_id, 
   recipient_name, recipient_phone, recipient_email, recipient_tag,
   country_code, bank_code, account_number, bank_name,
-  amount, currency, status
+  amount, currency, status, channel,
+  recipient_phone_e164, error_message, screening_list_version,
+  fingerprint, duplicate_of, service_fee,
+  reissue_of, account_number_bidx, recipient_phone_bidx
 ) VALUES (
-  ?, ?, ?, 
+  ?, ?, ?, ?, 
+  ?, ?, ?, ?,
   ?, ?, ?, ?,
   ?, ?, ?, ?,
+  ?, ?, ?,
+  ?, ?, ?,
   ?, ?, ?
 )
-RETURNING id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at
+RETURNING id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at
 `
 
 type CreatePayoutParams struct {
-	ID             string         `json:"id"`
-	BatchID        sql.NullString `json:"batch_id"`
-	ReferenceID    string         `json:"reference_id"`
-	RecipientName  string         `json:"recipient_name"`
-	RecipientPhone string         `json:"recipient_phone"`
-	RecipientEmail sql.NullString `json:"recipient_email"`
-	RecipientTag   sql.NullString `json:"recipient_tag"`
-	CountryCode    string         `json:"country_code"`
-	BankCode       sql.NullString `json:"bank_code"`
-	AccountNumber  sql.NullString `json:"account_number"`
-	BankName       sql.NullString `json:"bank_name"`
-	Amount         int64          `json:"amount"`
-	Currency       string         `json:"currency"`
-	Status         string         `json:"status"`
+	ID                   string         `json:"id"`
+	TenantID             string         `json:"tenant_id"`
+	BatchID              sql.NullString `json:"batch_id"`
+	ReferenceID          string         `json:"reference_id"`
+	RecipientName        string         `json:"recipient_name"`
+	RecipientPhone       string         `json:"recipient_phone"`
+	RecipientEmail       sql.NullString `json:"recipient_email"`
+	RecipientTag         sql.NullString `json:"recipient_tag"`
+	CountryCode          string         `json:"country_code"`
+	BankCode             sql.NullString `json:"bank_code"`
+	AccountNumber        sql.NullString `json:"account_number"`
+	BankName             sql.NullString `json:"bank_name"`
+	Amount               int64          `json:"amount"`
+	Currency             string         `json:"currency"`
+	Status               string         `json:"status"`
+	Channel              string         `json:"channel"`
+	RecipientPhoneE164   sql.NullString `json:"recipient_phone_e164"`
+	ErrorMessage         sql.NullString `json:"error_message"`
+	ScreeningListVersion sql.NullString `json:"screening_list_version"`
+	Fingerprint          sql.NullString `json:"fingerprint"`
+	DuplicateOf          sql.NullString `json:"duplicate_of"`
+	ServiceFee           int64          `json:"service_fee"`
+	ReissueOf            sql.NullString `json:"reissue_of"`
+	AccountNumberBidx    sql.NullString `json:"account_number_bidx"`
+	RecipientPhoneBidx   sql.NullString `json:"recipient_phone_bidx"`
 }
 
 func (q *Queries) CreatePayout(ctx context.Context, arg CreatePayoutParams) (Payout, error) {
 	row := q.queryRow(ctx, q.createPayoutStmt, createPayout,
 		arg.ID,
+		arg.TenantID,
 		arg.BatchID,
 		arg.ReferenceID,
 		arg.RecipientName,
@@ -58,6 +95,16 @@ func (q *Queries) CreatePayout(ctx context.Context, arg CreatePayoutParams) (Pay
 		arg.Amount,
 		arg.Currency,
 		arg.Status,
+		arg.Channel,
+		arg.RecipientPhoneE164,
+		arg.ErrorMessage,
+		arg.ScreeningListVersion,
+		arg.Fingerprint,
+		arg.DuplicateOf,
+		arg.ServiceFee,
+		arg.ReissueOf,
+		arg.AccountNumberBidx,
+		arg.RecipientPhoneBidx,
 	)
 	var i Payout
 	err := row.Scan(
@@ -78,17 +125,40 @@ func (q *Queries) CreatePayout(ctx context.Context, arg CreatePayoutParams) (Pay
 		&i.ErrorMessage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Channel,
+		&i.ResolvedAccountName,
+		&i.NameMatchScore,
+		&i.RecipientPhoneE164,
+		&i.ScreeningListVersion,
+		&i.Fingerprint,
+		&i.DuplicateOf,
+		&i.SourceAmount,
+		&i.SourceCurrency,
+		&i.FeeAmount,
+		&i.EffectiveRate,
+		&i.ServiceFee,
+		&i.TransactionID,
+		&i.ReversalSource,
+		&i.ReversalReason,
+		&i.ReversedBy,
+		&i.ReversedAt,
+		&i.ReissueOf,
+		&i.ReissuedAs,
+		&i.TenantID,
+		&i.AccountNumberBidx,
+		&i.RecipientPhoneBidx,
+		&i.PiiErasedAt,
 	)
 	return i, err
 }
 
-const getPayout = `-- name: GetPayout :one
-SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at FROM payouts 
+const findPayoutByID = `-- name: FindPayoutByID :one
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts
 WHERE id = ? LIMIT 1
 `
 
-func (q *Queries) GetPayout(ctx context.Context, id string) (Payout, error) {
-	row := q.queryRow(ctx, q.getPayoutStmt, getPayout, id)
+func (q *Queries) FindPayoutByID(ctx context.Context, id string) (Payout, error) {
+	row := q.queryRow(ctx, q.findPayoutByIDStmt, findPayoutByID, id)
 	var i Payout
 	err := row.Scan(
 		&i.ID,
@@ -108,17 +178,169 @@ func (q *Queries) GetPayout(ctx context.Context, id string) (Payout, error) {
 		&i.ErrorMessage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Channel,
+		&i.ResolvedAccountName,
+		&i.NameMatchScore,
+		&i.RecipientPhoneE164,
+		&i.ScreeningListVersion,
+		&i.Fingerprint,
+		&i.DuplicateOf,
+		&i.SourceAmount,
+		&i.SourceCurrency,
+		&i.FeeAmount,
+		&i.EffectiveRate,
+		&i.ServiceFee,
+		&i.TransactionID,
+		&i.ReversalSource,
+		&i.ReversalReason,
+		&i.ReversedBy,
+		&i.ReversedAt,
+		&i.ReissueOf,
+		&i.ReissuedAs,
+		&i.TenantID,
+		&i.AccountNumberBidx,
+		&i.RecipientPhoneBidx,
+		&i.PiiErasedAt,
+	)
+	return i, err
+}
+
+const findRecentDuplicate = `-- name: FindRecentDuplicate :one
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts
+WHERE tenant_id = ?
+  AND (fingerprint = ? OR fingerprint = ?)
+  AND created_at >= ?
+  AND status NOT IN ('FAILED', 'REJECTED', 'REVERSED')
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type FindRecentDuplicateParams struct {
+	TenantID         string         `json:"tenant_id"`
+	Fingerprint      sql.NullString `json:"fingerprint"`
+	PlainFingerprint sql.NullString `json:"plain_fingerprint"`
+	Since            sql.NullTime   `json:"since"`
+}
+
+func (q *Queries) FindRecentDuplicate(ctx context.Context, arg FindRecentDuplicateParams) (Payout, error) {
+	row := q.queryRow(ctx, q.findRecentDuplicateStmt, findRecentDuplicate,
+		arg.TenantID,
+		arg.Fingerprint,
+		arg.PlainFingerprint,
+		arg.Since,
+	)
+	var i Payout
+	err := row.Scan(
+		&i.ID,
+		&i.BatchID,
+		&i.ReferenceID,
+		&i.RecipientName,
+		&i.RecipientPhone,
+		&i.RecipientEmail,
+		&i.RecipientTag,
+		&i.CountryCode,
+		&i.BankCode,
+		&i.BankName,
+		&i.AccountNumber,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.ErrorMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Channel,
+		&i.ResolvedAccountName,
+		&i.NameMatchScore,
+		&i.RecipientPhoneE164,
+		&i.ScreeningListVersion,
+		&i.Fingerprint,
+		&i.DuplicateOf,
+		&i.SourceAmount,
+		&i.SourceCurrency,
+		&i.FeeAmount,
+		&i.EffectiveRate,
+		&i.ServiceFee,
+		&i.TransactionID,
+		&i.ReversalSource,
+		&i.ReversalReason,
+		&i.ReversedBy,
+		&i.ReversedAt,
+		&i.ReissueOf,
+		&i.ReissuedAs,
+		&i.TenantID,
+		&i.AccountNumberBidx,
+		&i.RecipientPhoneBidx,
+		&i.PiiErasedAt,
+	)
+	return i, err
+}
+
+const getPayout = `-- name: GetPayout :one
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts 
+WHERE tenant_id = ? AND id = ? LIMIT 1
+`
+
+type GetPayoutParams struct {
+	TenantID string `json:"tenant_id"`
+	ID       string `json:"id"`
+}
+
+func (q *Queries) GetPayout(ctx context.Context, arg GetPayoutParams) (Payout, error) {
+	row := q.queryRow(ctx, q.getPayoutStmt, getPayout, arg.TenantID, arg.ID)
+	var i Payout
+	err := row.Scan(
+		&i.ID,
+		&i.BatchID,
+		&i.ReferenceID,
+		&i.RecipientName,
+		&i.RecipientPhone,
+		&i.RecipientEmail,
+		&i.RecipientTag,
+		&i.CountryCode,
+		&i.BankCode,
+		&i.BankName,
+		&i.AccountNumber,
+		&i.Amount,
+		&i.Currency,
+		&i.Status,
+		&i.ErrorMessage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Channel,
+		&i.ResolvedAccountName,
+		&i.NameMatchScore,
+		&i.RecipientPhoneE164,
+		&i.ScreeningListVersion,
+		&i.Fingerprint,
+		&i.DuplicateOf,
+		&i.SourceAmount,
+		&i.SourceCurrency,
+		&i.FeeAmount,
+		&i.EffectiveRate,
+		&i.ServiceFee,
+		&i.TransactionID,
+		&i.ReversalSource,
+		&i.ReversalReason,
+		&i.ReversedBy,
+		&i.ReversedAt,
+		&i.ReissueOf,
+		&i.ReissuedAs,
+		&i.TenantID,
+		&i.AccountNumberBidx,
+		&i.RecipientPhoneBidx,
+		&i.PiiErasedAt,
 	)
 	return i, err
 }
 
 const listPayouts = `-- name: ListPayouts :many
-SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at FROM payouts 
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts 
+WHERE tenant_id = ?
 ORDER BY created_at DESC
 `
 
-func (q *Queries) ListPayouts(ctx context.Context) ([]Payout, error) {
-	rows, err := q.query(ctx, q.listPayoutsStmt, listPayouts)
+func (q *Queries) ListPayouts(ctx context.Context, tenantID string) ([]Payout, error) {
+	rows, err := q.query(ctx, q.listPayoutsStmt, listPayouts, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +366,29 @@ func (q *Queries) ListPayouts(ctx context.Context) ([]Payout, error) {
 			&i.ErrorMessage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Channel,
+			&i.ResolvedAccountName,
+			&i.NameMatchScore,
+			&i.RecipientPhoneE164,
+			&i.ScreeningListVersion,
+			&i.Fingerprint,
+			&i.DuplicateOf,
+			&i.SourceAmount,
+			&i.SourceCurrency,
+			&i.FeeAmount,
+			&i.EffectiveRate,
+			&i.ServiceFee,
+			&i.TransactionID,
+			&i.ReversalSource,
+			&i.ReversalReason,
+			&i.ReversedBy,
+			&i.ReversedAt,
+			&i.ReissueOf,
+			&i.ReissuedAs,
+			&i.TenantID,
+			&i.AccountNumberBidx,
+			&i.RecipientPhoneBidx,
+			&i.PiiErasedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -159,13 +404,109 @@ func (q *Queries) ListPayouts(ctx context.Context) ([]Payout, error) {
 }
 
 const listPayoutsByBatchID = `-- name: ListPayoutsByBatchID :many
-SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at FROM payouts 
-WHERE batch_id = ?
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts 
+WHERE tenant_id = ? AND batch_id = ?
+ORDER BY created_at DESC
+`
+
+type ListPayoutsByBatchIDParams struct {
+	TenantID string         `json:"tenant_id"`
+	BatchID  sql.NullString `json:"batch_id"`
+}
+
+func (q *Queries) ListPayoutsByBatchID(ctx context.Context, arg ListPayoutsByBatchIDParams) ([]Payout, error) {
+	rows, err := q.query(ctx, q.listPayoutsByBatchIDStmt, listPayoutsByBatchID, arg.TenantID, arg.BatchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payout
+	for rows.Next() {
+		var i Payout
+		if err := rows.Scan(
+			&i.ID,
+			&i.BatchID,
+			&i.ReferenceID,
+			&i.RecipientName,
+			&i.RecipientPhone,
+			&i.RecipientEmail,
+			&i.RecipientTag,
+			&i.CountryCode,
+			&i.BankCode,
+			&i.BankName,
+			&i.AccountNumber,
+			&i.Amount,
+			&i.Currency,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Channel,
+			&i.ResolvedAccountName,
+			&i.NameMatchScore,
+			&i.RecipientPhoneE164,
+			&i.ScreeningListVersion,
+			&i.Fingerprint,
+			&i.DuplicateOf,
+			&i.SourceAmount,
+			&i.SourceCurrency,
+			&i.FeeAmount,
+			&i.EffectiveRate,
+			&i.ServiceFee,
+			&i.TransactionID,
+			&i.ReversalSource,
+			&i.ReversalReason,
+			&i.ReversedBy,
+			&i.ReversedAt,
+			&i.ReissueOf,
+			&i.ReissuedAs,
+			&i.TenantID,
+			&i.AccountNumberBidx,
+			&i.RecipientPhoneBidx,
+			&i.PiiErasedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPayoutsByRecipient = `-- name: ListPayoutsByRecipient :many
+SELECT id, batch_id, reference_id, recipient_name, recipient_phone, recipient_email, recipient_tag, country_code, bank_code, bank_name, account_number, amount, currency, status, error_message, created_at, updated_at, channel, resolved_account_name, name_match_score, recipient_phone_e164, screening_list_version, fingerprint, duplicate_of, source_amount, source_currency, fee_amount, effective_rate, service_fee, transaction_id, reversal_source, reversal_reason, reversed_by, reversed_at, reissue_of, reissued_as, tenant_id, account_number_bidx, recipient_phone_bidx, pii_erased_at FROM payouts
+WHERE tenant_id = ?
+  AND (account_number_bidx = ?
+    OR account_number = ?
+    OR recipient_phone_bidx = ?
+    OR recipient_phone_e164 = ?)
 ORDER BY created_at DESC
+LIMIT ?
 `
 
-func (q *Queries) ListPayoutsByBatchID(ctx context.Context, batchID sql.NullString) ([]Payout, error) {
-	rows, err := q.query(ctx, nil, listPayoutsByBatchID, batchID)
+type ListPayoutsByRecipientParams struct {
+	TenantID      string         `json:"tenant_id"`
+	AccountBidx   sql.NullString `json:"account_bidx"`
+	AccountNumber sql.NullString `json:"account_number"`
+	PhoneBidx     sql.NullString `json:"phone_bidx"`
+	Phone         sql.NullString `json:"phone"`
+	RowLimit      int64          `json:"row_limit"`
+}
+
+func (q *Queries) ListPayoutsByRecipient(ctx context.Context, arg ListPayoutsByRecipientParams) ([]Payout, error) {
+	rows, err := q.query(ctx, q.listPayoutsByRecipientStmt, listPayoutsByRecipient,
+		arg.TenantID,
+		arg.AccountBidx,
+		arg.AccountNumber,
+		arg.PhoneBidx,
+		arg.Phone,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +532,29 @@ func (q *Queries) ListPayoutsByBatchID(ctx context.Context, batchID sql.NullStri
 			&i.ErrorMessage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Channel,
+			&i.ResolvedAccountName,
+			&i.NameMatchScore,
+			&i.RecipientPhoneE164,
+			&i.ScreeningListVersion,
+			&i.Fingerprint,
+			&i.DuplicateOf,
+			&i.SourceAmount,
+			&i.SourceCurrency,
+			&i.FeeAmount,
+			&i.EffectiveRate,
+			&i.ServiceFee,
+			&i.TransactionID,
+			&i.ReversalSource,
+			&i.ReversalReason,
+			&i.ReversedBy,
+			&i.ReversedAt,
+			&i.ReissueOf,
+			&i.ReissuedAs,
+			&i.TenantID,
+			&i.AccountNumberBidx,
+			&i.RecipientPhoneBidx,
+			&i.PiiErasedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -205,6 +569,172 @@ func (q *Queries) ListPayoutsByBatchID(ctx context.Context, batchID sql.NullStri
 	return items, nil
 }
 
+const releaseHeldPayout = `-- name: ReleaseHeldPayout :execrows
+UPDATE payouts
+SET status = ?, error_message = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status IN ('HELD_REVIEW', 'HELD_COMPLIANCE')
+`
+
+type ReleaseHeldPayoutParams struct {
+	Status       string         `json:"status"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	ID           string         `json:"id"`
+}
+
+func (q *Queries) ReleaseHeldPayout(ctx context.Context, arg ReleaseHeldPayoutParams) (int64, error) {
+	result, err := q.exec(ctx, q.releaseHeldPayoutStmt, releaseHeldPayout, arg.Status, arg.ErrorMessage, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releasePayoutReissue = `-- name: ReleasePayoutReissue :exec
+UPDATE payouts
+SET reissued_as = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND reissued_as = ?;
+
+-- Legacy rows written before encryption have no blind index, so the
+-- plaintext columns are matched too
+`
+
+type ReleasePayoutReissueParams struct {
+	ID         string         `json:"id"`
+	ReissuedAs sql.NullString `json:"reissued_as"`
+}
+
+func (q *Queries) ReleasePayoutReissue(ctx context.Context, arg ReleasePayoutReissueParams) error {
+	_, err := q.exec(ctx, q.releasePayoutReissueStmt, releasePayoutReissue, arg.ID, arg.ReissuedAs)
+	return err
+}
+
+const reversePayout = `-- name: ReversePayout :execrows
+UPDATE payouts
+SET status = 'REVERSED', reversal_source = ?, reversal_reason = ?, reversed_by = ?,
+    reversed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'SUCCESS'
+`
+
+type ReversePayoutParams struct {
+	ReversalSource sql.NullString `json:"reversal_source"`
+	ReversalReason sql.NullString `json:"reversal_reason"`
+	ReversedBy     sql.NullString `json:"reversed_by"`
+	ID             string         `json:"id"`
+}
+
+func (q *Queries) ReversePayout(ctx context.Context, arg ReversePayoutParams) (int64, error) {
+	result, err := q.exec(ctx, q.reversePayoutStmt, reversePayout,
+		arg.ReversalSource,
+		arg.ReversalReason,
+		arg.ReversedBy,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setPayoutCost = `-- name: SetPayoutCost :exec
+UPDATE payouts
+SET source_amount = ?, source_currency = ?, fee_amount = ?, effective_rate = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type SetPayoutCostParams struct {
+	SourceAmount   sql.NullInt64  `json:"source_amount"`
+	SourceCurrency sql.NullString `json:"source_currency"`
+	FeeAmount      sql.NullInt64  `json:"fee_amount"`
+	EffectiveRate  sql.NullString `json:"effective_rate"`
+	ID             string         `json:"id"`
+}
+
+func (q *Queries) SetPayoutCost(ctx context.Context, arg SetPayoutCostParams) error {
+	_, err := q.exec(ctx, q.setPayoutCostStmt, setPayoutCost,
+		arg.SourceAmount,
+		arg.SourceCurrency,
+		arg.FeeAmount,
+		arg.EffectiveRate,
+		arg.ID,
+	)
+	return err
+}
+
+const setPayoutTransactionID = `-- name: SetPayoutTransactionID :exec
+UPDATE payouts
+SET transaction_id = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type SetPayoutTransactionIDParams struct {
+	TransactionID sql.NullString `json:"transaction_id"`
+	ID            string         `json:"id"`
+}
+
+func (q *Queries) SetPayoutTransactionID(ctx context.Context, arg SetPayoutTransactionIDParams) error {
+	_, err := q.exec(ctx, q.setPayoutTransactionIDStmt, setPayoutTransactionID, arg.TransactionID, arg.ID)
+	return err
+}
+
+const setResolvedAccountName = `-- name: SetResolvedAccountName :exec
+UPDATE payouts
+SET resolved_account_name = ?, name_match_score = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type SetResolvedAccountNameParams struct {
+	ResolvedAccountName sql.NullString  `json:"resolved_account_name"`
+	NameMatchScore      sql.NullFloat64 `json:"name_match_score"`
+	ID                  string          `json:"id"`
+}
+
+func (q *Queries) SetResolvedAccountName(ctx context.Context, arg SetResolvedAccountNameParams) error {
+	_, err := q.exec(ctx, q.setResolvedAccountNameStmt, setResolvedAccountName, arg.ResolvedAccountName, arg.NameMatchScore, arg.ID)
+	return err
+}
+
+const setScreeningListVersion = `-- name: SetScreeningListVersion :exec
+UPDATE payouts
+SET screening_list_version = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type SetScreeningListVersionParams struct {
+	ScreeningListVersion sql.NullString `json:"screening_list_version"`
+	ID                   string         `json:"id"`
+}
+
+func (q *Queries) SetScreeningListVersion(ctx context.Context, arg SetScreeningListVersionParams) error {
+	_, err := q.exec(ctx, q.setScreeningListVersionStmt, setScreeningListVersion, arg.ScreeningListVersion, arg.ID)
+	return err
+}
+
+const transitionBatchPayouts = `-- name: TransitionBatchPayouts :exec
+UPDATE payouts
+SET status = ?, error_message = ?, updated_at = CURRENT_TIMESTAMP
+WHERE batch_id = ? AND status = ?;
+
+-- Rows written before fingerprints were keyed hold the plain one until
+-- pii reencrypt keys them, so it is matched too
+`
+
+type TransitionBatchPayoutsParams struct {
+	NewStatus    string         `json:"new_status"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	BatchID      sql.NullString `json:"batch_id"`
+	OldStatus    string         `json:"old_status"`
+}
+
+func (q *Queries) TransitionBatchPayouts(ctx context.Context, arg TransitionBatchPayoutsParams) error {
+	_, err := q.exec(ctx, q.transitionBatchPayoutsStmt, transitionBatchPayouts,
+		arg.NewStatus,
+		arg.ErrorMessage,
+		arg.BatchID,
+		arg.OldStatus,
+	)
+	return err
+}
+
 const updatePayoutStatus = `-- name: UpdatePayoutStatus :exec
 UPDATE payouts 
 SET status = ?, error_message = ?, updated_at = CURRENT_TIMESTAMP
@@ -0,0 +1,521 @@
+package db
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"waya/internal/config"
+	"waya/internal/core/domain"
+)
+
+// Purposes of the keys in pii_keys
+const (
+	piiKeyData  = "DATA"  // Seals column values; the newest unretired one seals new values
+	piiKeyIndex = "INDEX" // Keys the blind indexes; never rotated, or lookups of older rows would miss
+)
+
+// sealedPrefix starts every encrypted column value:
+// "enc:<key id>:<base64 of nonce and ciphertext>". A value without it was
+// written before encryption was enabled and is read as it is.
+const sealedPrefix = "enc:"
+
+var (
+	ErrNoMasterKey      = errors.New("recipient details are encrypted but no PII master key is configured")
+	ErrUnknownMasterKey = errors.New("PII key is sealed with a master key that is not configured")
+)
+
+// MasterKey seals the keys in pii_keys. It lives in a file or the
+// environment, never in the database; its ID, a hash prefix, records which
+// master sealed each key.
+type MasterKey struct {
+	ID  string
+	key []byte
+}
+
+// LoadMasterKeys reads the master keys from PII_MASTER_KEY_FILE or, failing
+// that, PII_MASTER_KEY. The first key seals new keys; any after it are old
+// masters kept only until rotation has re-sealed everything. No key
+// configured returns none, and recipient details stay in plaintext.
+func LoadMasterKeys(cfg config.PIIConfig) ([]MasterKey, error) {
+	raw := cfg.MasterKey
+	if cfg.MasterKeyFile != "" {
+		b, err := os.ReadFile(cfg.MasterKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read PII master key file: %w", err)
+		}
+		raw = string(b)
+	}
+	return ParseMasterKeys(raw)
+}
+
+// ParseMasterKeys parses base64 256-bit keys separated by commas or lines.
+// Blank lines and lines starting with # are skipped.
+func ParseMasterKeys(raw string) ([]MasterKey, error) {
+	var keys []MasterKey
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, field := range strings.Split(line, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			key, err := base64.StdEncoding.DecodeString(field)
+			if err != nil || len(key) != 32 {
+				return nil, fmt.Errorf("PII master key %d must be 32 bytes in base64", len(keys)+1)
+			}
+			sum := sha256.Sum256(key)
+			keys = append(keys, MasterKey{ID: "mk_" + hex.EncodeToString(sum[:6]), key: key})
+		}
+	}
+	return keys, nil
+}
+
+// GenerateMasterKey returns a new random master key in the form
+// ParseMasterKeys reads.
+func GenerateMasterKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// PIICipher encrypts recipient details on their way into the database and
+// decrypts them on the way out (envelope encryption). Values are sealed
+// with AES-256-GCM under a random data key; data keys are stored sealed by
+// the master key. Each value's column and row are authenticated with it, so
+// a ciphertext moved to another column or row fails to decrypt.
+//
+// A nil *PIICipher stores and reads plaintext.
+type PIICipher struct {
+	conn    *sql.DB
+	q       *Queries
+	masters map[string]cipher.AEAD
+	sealer  MasterKey // masters[0], which seals new keys
+
+	mu     sync.RWMutex
+	keys   map[string]cipher.AEAD // Every unsealed DATA key, by ID
+	active string                 // The DATA key new values are sealed with
+	index  []byte                 // HMAC key of the blind indexes
+}
+
+// NewPIICipher unseals the keys in pii_keys, creating the first data key
+// and the index key on first use.
+func NewPIICipher(ctx context.Context, database *Database, masters []MasterKey) (*PIICipher, error) {
+	if len(masters) == 0 {
+		return nil, ErrNoMasterKey
+	}
+	c := &PIICipher{
+		conn:    database.Conn,
+		q:       database.Q,
+		masters: make(map[string]cipher.AEAD, len(masters)),
+		sealer:  masters[0],
+		keys:    make(map[string]cipher.AEAD),
+	}
+	for _, m := range masters {
+		aead, err := newGCM(m.key)
+		if err != nil {
+			return nil, err
+		}
+		c.masters[m.ID] = aead
+	}
+
+	// Keys are loaded only once the transaction has committed, so a failed
+	// start never leaves the cipher holding a key the database lacks.
+	var rows []PiiKey
+	err := c.withTx(ctx, func(q *Queries) error {
+		var err error
+		if rows, err = q.ListPIIKeys(ctx); err != nil {
+			return err
+		}
+		hasIndex := slices.ContainsFunc(rows, func(k PiiKey) bool { return k.Purpose == piiKeyIndex })
+		hasActive := slices.ContainsFunc(rows, func(k PiiKey) bool { return k.Purpose == piiKeyData && !k.RetiredAt.Valid })
+		if !hasIndex {
+			key, err := c.create(ctx, q, piiKeyIndex)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, key)
+		}
+		if !hasActive {
+			key, err := c.create(ctx, q, piiKeyData)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err := c.add(row); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *PIICipher) withTx(ctx context.Context, fn func(q *Queries) error) error {
+	tx, err := c.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(c.q.WithTx(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// ActiveKeyID is the data key new values are sealed with.
+func (c *PIICipher) ActiveKeyID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.active
+}
+
+// Refresh reloads the keys, so a data key rotated in by another process
+// seals this one's new values too.
+func (c *PIICipher) Refresh(ctx context.Context) error {
+	rows, err := c.q.ListPIIKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := c.add(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshEvery runs Refresh on a ticker until ctx is cancelled.
+func (c *PIICipher) RefreshEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Refresh(ctx); err != nil {
+				slog.Error("Failed to reload PII keys", "err", err)
+			}
+		}
+	}
+}
+
+// add unseals a pii_keys row into the cipher. Rows come oldest first, so
+// the last unretired DATA key ends up active.
+func (c *PIICipher) add(row PiiKey) error {
+	key, err := c.unwrap(row)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if row.Purpose == piiKeyIndex {
+		c.index = key
+		return nil
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	c.keys[row.ID] = aead
+	if !row.RetiredAt.Valid {
+		c.active = row.ID
+	}
+	return nil
+}
+
+// create stores a new random key of the given purpose, sealed with the
+// current master, and returns its row. The cipher only uses it once the
+// caller adds it after the transaction commits; a new DATA key then becomes
+// the active one.
+func (c *PIICipher) create(ctx context.Context, q *Queries, purpose string) (PiiKey, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return PiiKey{}, err
+	}
+	prefix := "dk_"
+	if purpose == piiKeyIndex {
+		prefix = "ik_"
+	}
+	id := prefix + randomHex(8)
+	wrapped, err := c.wrap(id, key)
+	if err != nil {
+		return PiiKey{}, err
+	}
+	if err := q.CreatePIIKey(ctx, CreatePIIKeyParams{ID: id, Purpose: purpose, WrappedKey: wrapped, MasterKeyID: c.sealer.ID}); err != nil {
+		return PiiKey{}, err
+	}
+	return PiiKey{ID: id, Purpose: purpose, WrappedKey: wrapped, MasterKeyID: c.sealer.ID}, nil
+}
+
+// wrap seals a key with the current master. The key's ID is authenticated
+// with it, so sealed keys cannot be swapped between rows.
+func (c *PIICipher) wrap(id string, key []byte) (string, error) {
+	return sealWith(c.masters[c.sealer.ID], key, id)
+}
+
+func (c *PIICipher) unwrap(row PiiKey) ([]byte, error) {
+	master, ok := c.masters[row.MasterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: key %s needs master %s", ErrUnknownMasterKey, row.ID, row.MasterKeyID)
+	}
+	key, err := openWith(master, row.WrappedKey, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unseal PII key %s: %w", row.ID, err)
+	}
+	return key, nil
+}
+
+// dataKey finds a data key by ID, loading it if another process (the
+// rotation command) created it after this cipher started.
+func (c *PIICipher) dataKey(ctx context.Context, id string) (cipher.AEAD, error) {
+	c.mu.RLock()
+	aead, ok := c.keys[id]
+	c.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	row, err := c.q.GetPIIKey(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("PII data key %s not found", id)
+		}
+		return nil, err
+	}
+	key, err := c.unwrap(row)
+	if err != nil {
+		return nil, err
+	}
+	if aead, err = newGCM(key); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.keys[id] = aead
+	c.mu.Unlock()
+	return aead, nil
+}
+
+// seal encrypts a column value under the active data key. aad names the
+// column and row, e.g. "payouts.account_number/<id>". Empty values stay
+// empty so NULL columns stay NULL.
+func (c *PIICipher) seal(value, aad string) (string, error) {
+	if c == nil || value == "" {
+		return value, nil
+	}
+	c.mu.RLock()
+	id, aead := c.active, c.keys[c.active]
+	c.mu.RUnlock()
+	sealed, err := sealWith(aead, []byte(value), aad)
+	if err != nil {
+		return "", err
+	}
+	return sealedPrefix + id + ":" + sealed, nil
+}
+
+// open decrypts what seal produced. Values without the prefix are
+// plaintext from before encryption and are returned as they are.
+func (c *PIICipher) open(ctx context.Context, value, aad string) (string, error) {
+	if !strings.HasPrefix(value, sealedPrefix) {
+		return value, nil
+	}
+	if c == nil {
+		return "", ErrNoMasterKey
+	}
+	id, sealed, ok := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	if !ok {
+		return "", fmt.Errorf("malformed encrypted value in %s", aad)
+	}
+	aead, err := c.dataKey(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	plain, err := openWith(aead, sealed, aad)
+	if err != nil {
+		return "", fmt.Errorf("decrypt %s: %w", aad, err)
+	}
+	return string(plain), nil
+}
+
+// sealedWith names the data key a stored value is sealed with, or "" for a
+// plaintext or empty value.
+func sealedWith(value string) string {
+	if !strings.HasPrefix(value, sealedPrefix) {
+		return ""
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	return id
+}
+
+// Blind index kinds, part of the hashed input so an account number and a
+// phone with the same digits index differently
+const (
+	bidxAccount = "account"
+	bidxPhone   = "phone"
+)
+
+// blindIndex is a keyed hash of a normalized value, stored next to its
+// ciphertext so rows can be found by exact value. Without a cipher, or for
+// an empty value, it is NULL.
+func (c *PIICipher) blindIndex(kind, value string) sql.NullString {
+	if c == nil {
+		return sql.NullString{}
+	}
+	norm := normalizeIndexed(kind, value)
+	if norm == "" {
+		return sql.NullString{}
+	}
+	c.mu.RLock()
+	mac := hmac.New(sha256.New, c.index)
+	c.mu.RUnlock()
+	mac.Write([]byte(kind + ":" + norm))
+	return sql.NullString{String: hex.EncodeToString(mac.Sum(nil)), Valid: true}
+}
+
+// fingerprint keys a payout's duplicate fingerprint (see
+// domain.PayoutFingerprint) with the blind-index key, so a stored one cannot
+// be matched against guessed account numbers. A keyed fingerprint is a full
+// HMAC-SHA256, twice the length of a plain one, which tells rows written
+// before keying apart. Without a cipher the plain fingerprint is stored.
+func (c *PIICipher) fingerprint(plain string) sql.NullString {
+	if plain == "" {
+		return sql.NullString{}
+	}
+	if c == nil {
+		return sql.NullString{String: plain, Valid: true}
+	}
+	c.mu.RLock()
+	mac := hmac.New(sha256.New, c.index)
+	c.mu.RUnlock()
+	mac.Write([]byte("fingerprint:" + plain))
+	return sql.NullString{String: hex.EncodeToString(mac.Sum(nil)), Valid: true}
+}
+
+// fingerprintKeyed reports whether a stored fingerprint needs no keying
+func fingerprintKeyed(stored string) bool {
+	return stored == "" || len(stored) == 2*sha256.Size
+}
+
+// normalizeIndexed makes spellings of the same account or phone index alike:
+// account numbers go through domain.NormalizeAccountNumber, as they do for
+// duplicate fingerprints; phones keep their digits only, so "+234 803-123"
+// and "234803123" match.
+func normalizeIndexed(kind, value string) string {
+	if kind == bidxAccount {
+		return domain.NormalizeAccountNumber(value)
+	}
+	keep := func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}
+	return strings.Map(keep, value)
+}
+
+// piiField is one encrypted column of a row and its place in the domain model
+type piiField struct {
+	column string
+	value  *string
+}
+
+// payoutPII lists the encrypted payout columns.
+func payoutPII(p *domain.Payout) []piiField {
+	return []piiField{
+		{"payouts.recipient_name", &p.RecipientName},
+		{"payouts.recipient_phone", &p.RecipientPhone},
+		{"payouts.recipient_email", &p.RecipientEmail},
+		{"payouts.recipient_tag", &p.RecipientTag},
+		{"payouts.account_number", &p.AccountNumber},
+		{"payouts.recipient_phone_e164", &p.RecipientPhoneE164},
+		{"payouts.resolved_account_name", &p.ResolvedAccountName},
+	}
+}
+
+func (c *PIICipher) sealPayout(p *domain.Payout) error {
+	for _, f := range payoutPII(p) {
+		sealed, err := c.seal(*f.value, f.column+"/"+p.ID)
+		if err != nil {
+			return err
+		}
+		*f.value = sealed
+	}
+	return nil
+}
+
+func (c *PIICipher) openPayout(ctx context.Context, p *domain.Payout) error {
+	for _, f := range payoutPII(p) {
+		plain, err := c.open(ctx, *f.value, f.column+"/"+p.ID)
+		if err != nil {
+			return err
+		}
+		*f.value = plain
+	}
+	return nil
+}
+
+// payoutIndexes are the blind indexes of a payout in plaintext. The phone
+// is indexed in its E.164 form when there is one.
+func (c *PIICipher) payoutIndexes(p domain.Payout) (account, phone sql.NullString) {
+	ph := p.RecipientPhoneE164
+	if ph == "" {
+		ph = p.RecipientPhone
+	}
+	return c.blindIndex(bidxAccount, p.AccountNumber), c.blindIndex(bidxPhone, ph)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealWith encrypts under a fresh random nonce, returned in front of the
+// ciphertext, base64 encoded.
+func sealWith(aead cipher.AEAD, plain []byte, aad string) (string, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plain)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(aead.Seal(nonce, nonce, plain, []byte(aad))), nil
+}
+
+func openWith(aead cipher.AEAD, sealed, aad string) ([]byte, error) {
+	raw, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < aead.NonceSize() {
+		return nil, errors.New("malformed ciphertext")
+	}
+	return aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], []byte(aad))
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,319 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"waya/internal/core/domain"
+)
+
+// Rows re-encrypted per transaction, so the API keeps writing in between
+const piiReencryptPage = 200
+
+// Rotate creates a new data key for new values, retires the others and
+// re-seals every key with the current master. Retired keys stay, so rows
+// not yet re-encrypted remain readable; ReencryptPII moves them over. New
+// values switch to the key only after the transaction commits.
+func (c *PIICipher) Rotate(ctx context.Context) (string, error) {
+	var key PiiKey
+	err := c.withTx(ctx, func(q *Queries) error {
+		var err error
+		if key, err = c.create(ctx, q, piiKeyData); err != nil {
+			return err
+		}
+		if err := q.RetirePIIDataKeys(ctx, key.ID); err != nil {
+			return err
+		}
+		_, err = c.rewrap(ctx, q)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := c.add(key); err != nil {
+		return "", err
+	}
+	return key.ID, nil
+}
+
+// Rewrap re-seals every key still sealed by an older master with the
+// current one, after which the older master can be removed. Column values
+// are untouched.
+func (c *PIICipher) Rewrap(ctx context.Context) (int, error) {
+	var n int
+	err := c.withTx(ctx, func(q *Queries) error {
+		var err error
+		n, err = c.rewrap(ctx, q)
+		return err
+	})
+	return n, err
+}
+
+func (c *PIICipher) rewrap(ctx context.Context, q *Queries) (int, error) {
+	rows, err := q.ListPIIKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, row := range rows {
+		if row.MasterKeyID == c.sealer.ID {
+			continue
+		}
+		key, err := c.unwrap(row)
+		if err != nil {
+			return n, err
+		}
+		wrapped, err := c.wrap(row.ID, key)
+		if err != nil {
+			return n, err
+		}
+		if err := q.RewrapPIIKey(ctx, RewrapPIIKeyParams{WrappedKey: wrapped, MasterKeyID: c.sealer.ID, ID: row.ID}); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// ReencryptPII brings every stored recipient detail under the active data
+// key: values sealed with retired keys and plaintext from before encryption
+// was enabled. Blind indexes missing on older rows are filled in, and plain
+// duplicate fingerprints are keyed. It is safe to stop and run again; rows
+// already current are skipped. It returns how many payouts and reviews were
+// rewritten.
+func (r *SQLiteRepo) ReencryptPII(ctx context.Context) (payouts, reviews int, err error) {
+	if r.pii == nil {
+		return 0, 0, ErrNoMasterKey
+	}
+	active := r.pii.ActiveKeyID()
+
+	for after := ""; ; {
+		var rows []ListPayoutPIIRow
+		err := r.withTx(ctx, func(q *Queries) error {
+			var err error
+			rows, err = q.ListPayoutPII(ctx, ListPayoutPIIParams{ID: after, Limit: piiReencryptPage})
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				p := payoutFromPIIRow(row)
+				if piiCurrent(p, active, row.AccountNumberBidx.Valid, row.RecipientPhoneBidx.Valid) && fingerprintKeyed(row.Fingerprint.String) {
+					continue
+				}
+				if err := r.pii.openPayout(ctx, &p); err != nil {
+					return err
+				}
+				account, phone := r.pii.payoutIndexes(p)
+				fingerprint := row.Fingerprint
+				if !fingerprintKeyed(fingerprint.String) {
+					fingerprint = r.pii.fingerprint(fingerprint.String)
+				}
+				if err := r.pii.sealPayout(&p); err != nil {
+					return err
+				}
+				err := q.UpdatePayoutPII(ctx, UpdatePayoutPIIParams{
+					RecipientName:       p.RecipientName,
+					RecipientPhone:      p.RecipientPhone,
+					RecipientEmail:      nullString(p.RecipientEmail),
+					RecipientTag:        nullString(p.RecipientTag),
+					AccountNumber:       nullString(p.AccountNumber),
+					RecipientPhoneE164:  nullString(p.RecipientPhoneE164),
+					ResolvedAccountName: nullString(p.ResolvedAccountName),
+					AccountNumberBidx:   account,
+					RecipientPhoneBidx:  phone,
+					Fingerprint:         fingerprint,
+					ID:                  p.ID,
+				})
+				if err != nil {
+					return err
+				}
+				payouts++
+			}
+			return nil
+		})
+		if err != nil {
+			return payouts, reviews, err
+		}
+		if len(rows) < piiReencryptPage {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+
+	for after := ""; ; {
+		var rows []ListReviewDetailsRow
+		err := r.withTx(ctx, func(q *Queries) error {
+			var err error
+			rows, err = q.ListReviewDetails(ctx, ListReviewDetailsParams{ID: after, Limit: piiReencryptPage})
+			if err != nil {
+				return err
+			}
+			for _, row := range rows {
+				if row.Detail == "" || sealedWith(row.Detail) == active {
+					continue
+				}
+				aad := "reviews.detail/" + row.ID
+				detail, err := r.pii.open(ctx, row.Detail, aad)
+				if err != nil {
+					return err
+				}
+				if detail, err = r.pii.seal(detail, aad); err != nil {
+					return err
+				}
+				if err := q.UpdateReviewDetail(ctx, UpdateReviewDetailParams{Detail: detail, ID: row.ID}); err != nil {
+					return err
+				}
+				reviews++
+			}
+			return nil
+		})
+		if err != nil {
+			return payouts, reviews, err
+		}
+		if len(rows) < piiReencryptPage {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+	return payouts, reviews, nil
+}
+
+// ReencryptSigningSecrets moves the API keys' request signing secrets
+// sealed with retired data keys onto the active one, and returns how many
+// it rewrote.
+func (r *SQLiteRepo) ReencryptSigningSecrets(ctx context.Context) (int, error) {
+	if r.pii == nil {
+		return 0, ErrNoMasterKey
+	}
+	active := r.pii.ActiveKeyID()
+
+	n := 0
+	err := r.withTx(ctx, func(q *Queries) error {
+		rows, err := q.ListAPIKeySigningSecrets(ctx)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if sealedWith(row.SigningSecret.String) == active {
+				continue
+			}
+			key, err := r.pii.openSigningKey(ctx, row.ID, row.SigningSecret)
+			if err != nil {
+				return err
+			}
+			secret, err := r.pii.sealSigningKey(row.ID, key)
+			if err != nil {
+				return err
+			}
+			if err := q.SetAPIKeySigningSecret(ctx, SetAPIKeySigningSecretParams{SigningSecret: secret, ID: row.ID}); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// PIIKeyUsage is a key in pii_keys and how many stored values it seals.
+type PIIKeyUsage struct {
+	ID          string
+	Purpose     string
+	MasterKeyID string
+	CreatedAt   time.Time
+	RetiredAt   time.Time
+	Values      int
+}
+
+// PIIUsage lists the keys and counts the values each one seals, plus the
+// values still in plaintext. A retired key sealing nothing is no longer
+// needed to read anything.
+func (r *SQLiteRepo) PIIUsage(ctx context.Context) ([]PIIKeyUsage, int, error) {
+	counts := make(map[string]int)
+	count := func(value string) {
+		if value != "" {
+			counts[sealedWith(value)]++
+		}
+	}
+
+	for after := ""; ; {
+		rows, err := r.q.ListPayoutPII(ctx, ListPayoutPIIParams{ID: after, Limit: piiReencryptPage})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, row := range rows {
+			p := payoutFromPIIRow(row)
+			for _, f := range payoutPII(&p) {
+				count(*f.value)
+			}
+		}
+		if len(rows) < piiReencryptPage {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+	for after := ""; ; {
+		rows, err := r.q.ListReviewDetails(ctx, ListReviewDetailsParams{ID: after, Limit: piiReencryptPage})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, row := range rows {
+			count(row.Detail)
+		}
+		if len(rows) < piiReencryptPage {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+	secrets, err := r.q.ListAPIKeySigningSecrets(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, row := range secrets {
+		count(row.SigningSecret.String)
+	}
+
+	keys, err := r.q.ListPIIKeys(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	usage := make([]PIIKeyUsage, 0, len(keys))
+	for _, k := range keys {
+		usage = append(usage, PIIKeyUsage{
+			ID:          k.ID,
+			Purpose:     k.Purpose,
+			MasterKeyID: k.MasterKeyID,
+			CreatedAt:   k.CreatedAt,
+			RetiredAt:   k.RetiredAt.Time,
+			Values:      counts[k.ID],
+		})
+	}
+	return usage, counts[""], nil
+}
+
+func payoutFromPIIRow(row ListPayoutPIIRow) domain.Payout {
+	return domain.Payout{
+		ID:                  row.ID,
+		RecipientName:       row.RecipientName,
+		RecipientPhone:      row.RecipientPhone,
+		RecipientEmail:      row.RecipientEmail.String,
+		RecipientTag:        row.RecipientTag.String,
+		AccountNumber:       row.AccountNumber.String,
+		RecipientPhoneE164:  row.RecipientPhoneE164.String,
+		ResolvedAccountName: row.ResolvedAccountName.String,
+	}
+}
+
+// piiCurrent reports whether a stored payout needs no re-encryption: every
+// value sealed with the active key and the blind indexes present.
+func piiCurrent(p domain.Payout, active string, accountIndexed, phoneIndexed bool) bool {
+	for _, f := range payoutPII(&p) {
+		if *f.value != "" && sealedWith(*f.value) != active {
+			return false
+		}
+	}
+	return (p.AccountNumber == "" || accountIndexed) && (p.RecipientPhone == "" || phoneIndexed)
+}
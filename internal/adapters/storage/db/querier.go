@@ -7,14 +7,112 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
+	ClaimPayoutReissue(ctx context.Context, arg ClaimPayoutReissueParams) (int64, error)
+	CountKeyPayoutItems(ctx context.Context, arg CountKeyPayoutItemsParams) (int64, error)
+	CountTenantPayoutItems(ctx context.Context, arg CountTenantPayoutItemsParams) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error
+	CreateBatch(ctx context.Context, arg CreateBatchParams) error
+	CreateBatchEvent(ctx context.Context, arg CreateBatchEventParams) error
+	CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) error
+	CreatePIIKey(ctx context.Context, arg CreatePIIKeyParams) error
 	CreatePayout(ctx context.Context, arg CreatePayoutParams) (Payout, error)
-	GetPayout(ctx context.Context, id string) (Payout, error)
-	ListPayouts(ctx context.Context) ([]Payout, error)
-	ListPayoutsByBatchID(ctx context.Context, batchID sql.NullString) ([]Payout, error)
+	CreatePosting(ctx context.Context, arg CreatePostingParams) error
+	CreateReconciliationItem(ctx context.Context, arg CreateReconciliationItemParams) error
+	CreateReconciliationRun(ctx context.Context, arg CreateReconciliationRunParams) error
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) error
+	CreateReview(ctx context.Context, arg CreateReviewParams) error
+	CreateTenant(ctx context.Context, arg CreateTenantParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) error
+	DecideBatchApproval(ctx context.Context, arg DecideBatchApprovalParams) (int64, error)
+	DecideReview(ctx context.Context, arg DecideReviewParams) (int64, error)
+	DeleteExpiredNonces(ctx context.Context, before time.Time) (int64, error)
+	DeletePayoutQuota(ctx context.Context, arg DeletePayoutQuotaParams) (int64, error)
+	DeleteRateLimit(ctx context.Context, arg DeleteRateLimitParams) (int64, error)
+	DisableUser(ctx context.Context, arg DisableUserParams) (int64, error)
+	EnsureLedgerAccount(ctx context.Context, arg EnsureLedgerAccountParams) error
+	ErasePayoutPII(ctx context.Context, arg ErasePayoutPIIParams) (int64, error)
+	EraseReviewDetails(ctx context.Context, payoutID string) error
+	FindPayoutByID(ctx context.Context, id string) (Payout, error)
+	FindRecentDuplicate(ctx context.Context, arg FindRecentDuplicateParams) (Payout, error)
+	GetAPIKey(ctx context.Context, arg GetAPIKeyParams) (GetAPIKeyRow, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (GetAPIKeyByHashRow, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (GetAPIKeyByPrefixRow, error)
+	GetAccountBalance(ctx context.Context, accountID string) (int64, error)
+	GetBatch(ctx context.Context, arg GetBatchParams) (Batch, error)
+	GetPIIKey(ctx context.Context, id string) (PiiKey, error)
+	GetPayout(ctx context.Context, arg GetPayoutParams) (Payout, error)
+	GetPayoutByTransactionID(ctx context.Context, transactionID sql.NullString) (Payout, error)
+	GetReconciliationRun(ctx context.Context, id string) (ReconciliationRun, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (GetRefreshTokenByHashRow, error)
+	GetReview(ctx context.Context, arg GetReviewParams) (GetReviewRow, error)
+	GetTenant(ctx context.Context, id string) (GetTenantRow, error)
+	GetUser(ctx context.Context, arg GetUserParams) (GetUserRow, error)
+	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
+	GetUserByID(ctx context.Context, id string) (GetUserByIDRow, error)
+	GetUserPasswordHash(ctx context.Context, arg GetUserPasswordHashParams) (string, error)
+	InsertAuditEntry(ctx context.Context, arg InsertAuditEntryParams) error
+	InsertRequestNonce(ctx context.Context, arg InsertRequestNonceParams) error
+	LastAuditEntry(ctx context.Context) (LastAuditEntryRow, error)
+	ListAPIKeySigningSecrets(ctx context.Context) ([]ListAPIKeySigningSecretsRow, error)
+	ListAPIKeys(ctx context.Context, tenantID string) ([]ListAPIKeysRow, error)
+	ListAccountBalances(ctx context.Context, tenantID string) ([]ListAccountBalancesRow, error)
+	ListApprovedReviewReasons(ctx context.Context, payoutID string) ([]string, error)
+	ListAuditEntries(ctx context.Context, arg ListAuditEntriesParams) ([]AuditLog, error)
+	ListBatchEvents(ctx context.Context, batchID string) ([]BatchEvent, error)
+	ListExpiredPayouts(ctx context.Context, arg ListExpiredPayoutsParams) ([]ListExpiredPayoutsRow, error)
+	ListJournalEntriesByReference(ctx context.Context, reference string) ([]JournalEntry, error)
+	ListPIIKeys(ctx context.Context) ([]PiiKey, error)
+	ListPayoutPII(ctx context.Context, arg ListPayoutPIIParams) ([]ListPayoutPIIRow, error)
+	ListPayoutQuotas(ctx context.Context, tenantID string) ([]PayoutQuota, error)
+	ListPayouts(ctx context.Context, tenantID string) ([]Payout, error)
+	ListPayoutsByBatchID(ctx context.Context, arg ListPayoutsByBatchIDParams) ([]Payout, error)
+	ListPayoutsByRecipient(ctx context.Context, arg ListPayoutsByRecipientParams) ([]Payout, error)
+	ListPostingsByEntry(ctx context.Context, entryID string) ([]ListPostingsByEntryRow, error)
+	ListRateLimits(ctx context.Context, tenantID string) ([]RateLimit, error)
+	ListRecipientPayoutIDs(ctx context.Context, arg ListRecipientPayoutIDsParams) ([]ListRecipientPayoutIDsRow, error)
+	ListReconciliationItems(ctx context.Context, runID string) ([]ReconciliationItem, error)
+	ListReconciliationRuns(ctx context.Context, limit int64) ([]ReconciliationRun, error)
+	ListReviewDetails(ctx context.Context, arg ListReviewDetailsParams) ([]ListReviewDetailsRow, error)
+	ListReviewsByStatus(ctx context.Context, arg ListReviewsByStatusParams) ([]ListReviewsByStatusRow, error)
+	ListSuccessfulPayoutsBetween(ctx context.Context, arg ListSuccessfulPayoutsBetweenParams) ([]Payout, error)
+	ListTenantFeeCharges(ctx context.Context, arg ListTenantFeeChargesParams) ([]ListTenantFeeChargesRow, error)
+	ListTenantPostingsBetween(ctx context.Context, arg ListTenantPostingsBetweenParams) ([]ListTenantPostingsBetweenRow, error)
+	ListTenants(ctx context.Context) ([]ListTenantsRow, error)
+	ListUsers(ctx context.Context, tenantID string) ([]ListUsersRow, error)
+	ReleaseHeldPayout(ctx context.Context, arg ReleaseHeldPayoutParams) (int64, error)
+	ReleasePayoutReissue(ctx context.Context, arg ReleasePayoutReissueParams) error
+	ReplaceAPIKey(ctx context.Context, arg ReplaceAPIKeyParams) (int64, error)
+	ReplaceRefreshToken(ctx context.Context, arg ReplaceRefreshTokenParams) (int64, error)
+	RetirePIIDataKeys(ctx context.Context, id string) error
+	ReversePayout(ctx context.Context, arg ReversePayoutParams) (int64, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error)
+	RevokeRefreshToken(ctx context.Context, arg RevokeRefreshTokenParams) error
+	RevokeUserRefreshTokens(ctx context.Context, arg RevokeUserRefreshTokensParams) error
+	RewrapPIIKey(ctx context.Context, arg RewrapPIIKeyParams) error
+	ScanAuditLog(ctx context.Context, arg ScanAuditLogParams) ([]AuditLog, error)
+	SetAPIKeySigningSecret(ctx context.Context, arg SetAPIKeySigningSecretParams) error
+	SetPayoutCost(ctx context.Context, arg SetPayoutCostParams) error
+	SetPayoutTransactionID(ctx context.Context, arg SetPayoutTransactionIDParams) error
+	SetResolvedAccountName(ctx context.Context, arg SetResolvedAccountNameParams) error
+	SetScreeningListVersion(ctx context.Context, arg SetScreeningListVersionParams) error
+	SetTenantWebhookURL(ctx context.Context, arg SetTenantWebhookURLParams) (int64, error)
+	SetUserPassword(ctx context.Context, arg SetUserPasswordParams) (int64, error)
+	SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error)
+	SumCorridorPayouts(ctx context.Context, arg SumCorridorPayoutsParams) (int64, error)
+	SumRecipientPayouts(ctx context.Context, arg SumRecipientPayoutsParams) (int64, error)
+	SumTenantPostingsBefore(ctx context.Context, arg SumTenantPostingsBeforeParams) ([]SumTenantPostingsBeforeRow, error)
+	TouchAPIKey(ctx context.Context, arg TouchAPIKeyParams) error
+	TouchUserLogin(ctx context.Context, arg TouchUserLoginParams) error
+	TransitionBatchPayouts(ctx context.Context, arg TransitionBatchPayoutsParams) error
+	UpdatePayoutPII(ctx context.Context, arg UpdatePayoutPIIParams) error
 	UpdatePayoutStatus(ctx context.Context, arg UpdatePayoutStatusParams) error
+	UpdateReviewDetail(ctx context.Context, arg UpdateReviewDetailParams) error
+	UpsertPayoutQuota(ctx context.Context, arg UpsertPayoutQuotaParams) error
+	UpsertRateLimit(ctx context.Context, arg UpsertRateLimitParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,49 @@
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (id, tenant_id, name, prefix, key_hash, scopes, created_by, expires_at, signed_only, signing_secret)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAPIKey :one
+SELECT id, tenant_id, name, prefix, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, revoked_by, replaced_by, signed_only
+FROM api_keys
+WHERE tenant_id = ? AND id = ?;
+
+-- name: GetAPIKeyByHash :one
+SELECT id, tenant_id, name, prefix, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, revoked_by, replaced_by, signed_only
+FROM api_keys
+WHERE key_hash = ?;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT id, tenant_id, name, prefix, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, revoked_by, replaced_by, signed_only, signing_secret
+FROM api_keys
+WHERE prefix = ? AND prefix != '';
+
+-- name: ListAPIKeys :many
+SELECT id, tenant_id, name, prefix, scopes, created_by, created_at, expires_at, last_used_at, revoked_at, revoked_by, replaced_by, signed_only
+FROM api_keys
+WHERE tenant_id = ?
+ORDER BY created_at DESC, id;
+
+-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = sqlc.arg(used_at)
+WHERE id = sqlc.arg(id);
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = CURRENT_TIMESTAMP, revoked_by = ?
+WHERE tenant_id = ? AND id = ? AND revoked_at IS NULL;
+
+-- name: ReplaceAPIKey :execrows
+UPDATE api_keys
+SET replaced_by = ?, expires_at = ?
+WHERE tenant_id = ? AND id = ? AND revoked_at IS NULL AND replaced_by IS NULL;
+
+-- name: SetAPIKeySigningSecret :exec
+UPDATE api_keys
+SET signing_secret = sqlc.arg(signing_secret)
+WHERE id = sqlc.arg(id);
+
+-- name: ListAPIKeySigningSecrets :many
+SELECT id, signing_secret FROM api_keys
+WHERE signing_secret IS NOT NULL
+ORDER BY id;
@@ -0,0 +1,31 @@
+-- name: LastAuditEntry :one
+SELECT id, hash FROM audit_log
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: InsertAuditEntry :exec
+INSERT INTO audit_log (
+  id, at, tenant_id, actor, principal, action, target_type, target_id,
+  request_id, ip, before_state, after_state, prev_hash, hash
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ListAuditEntries :many
+SELECT * FROM audit_log
+WHERE (CAST(sqlc.arg(tenant_id) AS TEXT) = '' OR tenant_id = sqlc.arg(tenant_id))
+  AND (CAST(sqlc.arg(actor) AS TEXT) = '' OR actor = sqlc.arg(actor))
+  AND (CAST(sqlc.arg(action) AS TEXT) = '' OR action = sqlc.arg(action))
+  AND (CAST(sqlc.arg(target_type) AS TEXT) = '' OR target_type = sqlc.arg(target_type))
+  AND (CAST(sqlc.arg(target_id) AS TEXT) = '' OR target_id = sqlc.arg(target_id))
+  AND (CAST(sqlc.arg(request_id) AS TEXT) = '' OR request_id = sqlc.arg(request_id))
+  AND at >= sqlc.arg(since) AND at < sqlc.arg(until)
+  AND id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit);
+
+-- name: ScanAuditLog :many
+SELECT * FROM audit_log
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit);
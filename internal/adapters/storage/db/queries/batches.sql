@@ -0,0 +1,29 @@
+-- name: CreateBatch :exec
+INSERT INTO batches (
+  id, tenant_id, total_amount, total_count, status,
+  reference, submitted_by, approval_status, approval_reason, api_key_id
+) VALUES (
+  ?, ?, ?, ?, ?,
+  ?, ?, ?, ?, ?
+);
+
+-- name: GetBatch :one
+SELECT * FROM batches
+WHERE tenant_id = ? AND id = ? LIMIT 1;
+
+-- name: DecideBatchApproval :execrows
+UPDATE batches
+SET approval_status = ?, status = ?
+WHERE id = ? AND approval_status = 'PENDING';
+
+-- name: CreateBatchEvent :exec
+INSERT INTO batch_events (
+  id, batch_id, actor, action, comment
+) VALUES (
+  ?, ?, ?, ?, ?
+);
+
+-- name: ListBatchEvents :many
+SELECT * FROM batch_events
+WHERE batch_id = ?
+ORDER BY created_at ASC, rowid ASC;
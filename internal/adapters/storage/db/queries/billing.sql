@@ -0,0 +1,9 @@
+-- name: ListTenantFeeCharges :many
+SELECT e.reference, e.created_at, p.currency, p.amount, py.batch_id, py.country_code, CAST(py.amount AS INTEGER) AS payout_amount
+FROM postings p
+JOIN journal_entries e ON e.id = p.entry_id
+JOIN ledger_accounts a ON a.id = p.account_id
+JOIN payouts py ON py.id = e.reference
+WHERE e.tenant_id = sqlc.arg(tenant_id) AND a.type = 'FEES'
+  AND e.created_at >= sqlc.arg(from_time) AND e.created_at < sqlc.arg(to_time)
+ORDER BY e.created_at, e.rowid;
@@ -0,0 +1,53 @@
+-- name: EnsureLedgerAccount :exec
+INSERT INTO ledger_accounts (id, tenant_id, type, currency, normal_balance)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: CreateJournalEntry :exec
+INSERT INTO journal_entries (id, tenant_id, kind, reference, description)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: CreatePosting :exec
+INSERT INTO postings (entry_id, account_id, amount, currency)
+VALUES (?, ?, ?, ?);
+
+-- name: GetAccountBalance :one
+SELECT CAST(COALESCE(SUM(amount), 0) AS INTEGER) AS total FROM postings
+WHERE account_id = ?;
+
+-- name: ListAccountBalances :many
+SELECT a.id, a.tenant_id, a.type, a.currency, CAST(COALESCE(SUM(p.amount), 0) AS INTEGER) AS total
+FROM ledger_accounts a
+LEFT JOIN postings p ON p.account_id = a.id
+WHERE a.tenant_id = ?
+GROUP BY a.id
+ORDER BY a.currency, a.type;
+
+-- name: ListJournalEntriesByReference :many
+SELECT * FROM journal_entries
+WHERE reference = ?
+ORDER BY created_at, rowid;
+
+-- name: ListPostingsByEntry :many
+SELECT p.id, p.entry_id, p.account_id, a.type, p.amount, p.currency
+FROM postings p
+JOIN ledger_accounts a ON a.id = p.account_id
+WHERE p.entry_id = ?
+ORDER BY p.id;
+
+-- name: SumTenantPostingsBefore :many
+SELECT a.type, CAST(COALESCE(SUM(p.amount), 0) AS INTEGER) AS total
+FROM postings p
+JOIN journal_entries e ON e.id = p.entry_id
+JOIN ledger_accounts a ON a.id = p.account_id
+WHERE e.tenant_id = sqlc.arg(tenant_id) AND p.currency = sqlc.arg(currency) AND e.created_at < sqlc.arg(before)
+GROUP BY a.type;
+
+-- name: ListTenantPostingsBetween :many
+SELECT e.id, e.kind, e.reference, e.description, e.created_at, a.type, p.amount
+FROM postings p
+JOIN journal_entries e ON e.id = p.entry_id
+JOIN ledger_accounts a ON a.id = p.account_id
+WHERE e.tenant_id = sqlc.arg(tenant_id) AND p.currency = sqlc.arg(currency)
+  AND e.created_at >= sqlc.arg(from_time) AND e.created_at < sqlc.arg(to_time)
+ORDER BY e.created_at, e.rowid, p.id;
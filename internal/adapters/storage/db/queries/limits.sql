@@ -0,0 +1,21 @@
+-- bank_code uses IS so mobile money payouts without a bank code still match.
+-- Legacy rows written before encryption have no blind index, so the
+-- plaintext account number is matched too
+
+-- name: SumRecipientPayouts :one
+SELECT CAST(COALESCE(SUM(amount), 0) AS INTEGER) AS total FROM payouts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND country_code = sqlc.arg(country_code)
+  AND bank_code IS sqlc.arg(bank_code)
+  AND (account_number_bidx = sqlc.arg(account_bidx) OR account_number = sqlc.arg(account_number))
+  AND currency = sqlc.arg(currency)
+  AND created_at >= sqlc.arg(since)
+  AND status NOT IN ('FAILED', 'REJECTED', 'REVERSED');
+
+-- name: SumCorridorPayouts :one
+SELECT CAST(COALESCE(SUM(amount), 0) AS INTEGER) AS total FROM payouts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND country_code = sqlc.arg(country_code)
+  AND currency = sqlc.arg(currency)
+  AND created_at >= sqlc.arg(since)
+  AND status NOT IN ('FAILED', 'REJECTED', 'REVERSED');
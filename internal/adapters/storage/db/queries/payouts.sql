@@ -1,23 +1,34 @@
 -- name: CreatePayout :one
 INSERT INTO payouts (
-  id, batch_id, reference_id, 
+  id, tenant_id, batch_id, reference_id, 
   recipient_name, recipient_phone, recipient_email, recipient_tag,
   country_code, bank_code, account_number, bank_name,
-  amount, currency, status
+  amount, currency, status, channel,
+  recipient_phone_e164, error_message, screening_list_version,
+  fingerprint, duplicate_of, service_fee,
+  reissue_of, account_number_bidx, recipient_phone_bidx
 ) VALUES (
-  ?, ?, ?, 
+  ?, ?, ?, ?, 
   ?, ?, ?, ?,
   ?, ?, ?, ?,
+  ?, ?, ?, ?,
+  ?, ?, ?,
+  ?, ?, ?,
   ?, ?, ?
 )
 RETURNING *;
 
 -- name: GetPayout :one
 SELECT * FROM payouts 
+WHERE tenant_id = ? AND id = ? LIMIT 1;
+
+-- name: FindPayoutByID :one
+SELECT * FROM payouts
 WHERE id = ? LIMIT 1;
 
 -- name: ListPayouts :many
 SELECT * FROM payouts 
+WHERE tenant_id = ?
 ORDER BY created_at DESC;
 
 -- name: UpdatePayoutStatus :exec
@@ -25,7 +36,76 @@ UPDATE payouts
 SET status = ?, error_message = ?, updated_at = CURRENT_TIMESTAMP
 WHERE id = ?;
 
+-- name: ReleaseHeldPayout :execrows
+UPDATE payouts
+SET status = ?, error_message = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status IN ('HELD_REVIEW', 'HELD_COMPLIANCE');
+
 -- name: ListPayoutsByBatchID :many
 SELECT * FROM payouts 
-WHERE batch_id = ?
-ORDER BY created_at DESC;
\ No newline at end of file
+WHERE tenant_id = ? AND batch_id = ?
+ORDER BY created_at DESC;
+-- name: SetResolvedAccountName :exec
+UPDATE payouts
+SET resolved_account_name = ?, name_match_score = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: SetScreeningListVersion :exec
+UPDATE payouts
+SET screening_list_version = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: TransitionBatchPayouts :exec
+UPDATE payouts
+SET status = sqlc.arg(new_status), error_message = sqlc.arg(error_message), updated_at = CURRENT_TIMESTAMP
+WHERE batch_id = sqlc.arg(batch_id) AND status = sqlc.arg(old_status);
+
+-- Rows written before fingerprints were keyed hold the plain one until
+-- pii reencrypt keys them, so it is matched too
+-- name: FindRecentDuplicate :one
+SELECT * FROM payouts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND (fingerprint = sqlc.arg(fingerprint) OR fingerprint = sqlc.arg(plain_fingerprint))
+  AND created_at >= sqlc.arg(since)
+  AND status NOT IN ('FAILED', 'REJECTED', 'REVERSED')
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: SetPayoutCost :exec
+UPDATE payouts
+SET source_amount = ?, source_currency = ?, fee_amount = ?, effective_rate = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: SetPayoutTransactionID :exec
+UPDATE payouts
+SET transaction_id = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: ReversePayout :execrows
+UPDATE payouts
+SET status = 'REVERSED', reversal_source = ?, reversal_reason = ?, reversed_by = ?,
+    reversed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'SUCCESS';
+
+-- name: ClaimPayoutReissue :execrows
+UPDATE payouts
+SET reissued_as = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'REVERSED' AND reissued_as IS NULL;
+
+-- name: ReleasePayoutReissue :exec
+UPDATE payouts
+SET reissued_as = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND reissued_as = ?;
+
+-- Legacy rows written before encryption have no blind index, so the
+-- plaintext columns are matched too
+
+-- name: ListPayoutsByRecipient :many
+SELECT * FROM payouts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND (account_number_bidx = sqlc.arg(account_bidx)
+    OR account_number = sqlc.arg(account_number)
+    OR recipient_phone_bidx = sqlc.arg(phone_bidx)
+    OR recipient_phone_e164 = sqlc.arg(phone))
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit);
@@ -0,0 +1,51 @@
+-- name: ListPIIKeys :many
+SELECT * FROM pii_keys
+ORDER BY created_at, id;
+
+-- name: GetPIIKey :one
+SELECT * FROM pii_keys
+WHERE id = ? LIMIT 1;
+
+-- name: CreatePIIKey :exec
+INSERT INTO pii_keys (
+  id, purpose, wrapped_key, master_key_id
+) VALUES (
+  ?, ?, ?, ?
+);
+
+-- name: RewrapPIIKey :exec
+UPDATE pii_keys
+SET wrapped_key = ?, master_key_id = ?
+WHERE id = ?;
+
+-- name: RetirePIIDataKeys :exec
+UPDATE pii_keys
+SET retired_at = CURRENT_TIMESTAMP
+WHERE purpose = 'DATA' AND retired_at IS NULL AND id != ?;
+
+-- name: ListPayoutPII :many
+SELECT id, recipient_name, recipient_phone, recipient_email, recipient_tag,
+       account_number, recipient_phone_e164, resolved_account_name,
+       account_number_bidx, recipient_phone_bidx, fingerprint
+FROM payouts
+WHERE id > ?
+ORDER BY id
+LIMIT ?;
+
+-- name: UpdatePayoutPII :exec
+UPDATE payouts
+SET recipient_name = ?, recipient_phone = ?, recipient_email = ?, recipient_tag = ?,
+    account_number = ?, recipient_phone_e164 = ?, resolved_account_name = ?,
+    account_number_bidx = ?, recipient_phone_bidx = ?, fingerprint = ?
+WHERE id = ?;
+
+-- name: ListReviewDetails :many
+SELECT id, detail FROM reviews
+WHERE id > ?
+ORDER BY id
+LIMIT ?;
+
+-- name: UpdateReviewDetail :exec
+UPDATE reviews
+SET detail = ?
+WHERE id = ?;
@@ -0,0 +1,43 @@
+-- name: ListRateLimits :many
+SELECT * FROM rate_limits
+WHERE tenant_id = ?
+ORDER BY key_id, route;
+
+-- name: UpsertRateLimit :exec
+INSERT INTO rate_limits (tenant_id, key_id, route, per_minute, burst)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (tenant_id, key_id, route) DO UPDATE SET
+  per_minute = excluded.per_minute,
+  burst = excluded.burst,
+  updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteRateLimit :execrows
+DELETE FROM rate_limits
+WHERE tenant_id = ? AND key_id = ? AND route = ?;
+
+-- name: ListPayoutQuotas :many
+SELECT * FROM payout_quotas
+WHERE tenant_id = ?
+ORDER BY key_id;
+
+-- name: UpsertPayoutQuota :exec
+INSERT INTO payout_quotas (tenant_id, key_id, daily_items)
+VALUES (?, ?, ?)
+ON CONFLICT (tenant_id, key_id) DO UPDATE SET
+  daily_items = excluded.daily_items,
+  updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeletePayoutQuota :execrows
+DELETE FROM payout_quotas
+WHERE tenant_id = ? AND key_id = ?;
+
+-- name: CountTenantPayoutItems :one
+SELECT CAST(COALESCE(SUM(total_count), 0) AS INTEGER) AS total FROM batches
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND created_at >= sqlc.arg(since);
+
+-- name: CountKeyPayoutItems :one
+SELECT CAST(COALESCE(SUM(total_count), 0) AS INTEGER) AS total FROM batches
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND api_key_id = sqlc.arg(api_key_id)
+  AND created_at >= sqlc.arg(since);
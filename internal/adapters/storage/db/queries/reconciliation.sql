@@ -0,0 +1,31 @@
+-- name: CreateReconciliationRun :exec
+INSERT INTO reconciliation_runs (id, source, period_from, period_to, records, matched, missing_ours, missing_theirs, amount_mismatches, reversed)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateReconciliationItem :exec
+INSERT INTO reconciliation_items (id, run_id, result, payout_id, transaction_id, reference, our_amount, their_amount, currency, detail)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetReconciliationRun :one
+SELECT * FROM reconciliation_runs
+WHERE id = ?;
+
+-- name: ListReconciliationRuns :many
+SELECT * FROM reconciliation_runs
+ORDER BY created_at DESC, rowid DESC
+LIMIT ?;
+
+-- name: ListReconciliationItems :many
+SELECT * FROM reconciliation_items
+WHERE run_id = ?
+ORDER BY rowid;
+
+-- name: ListSuccessfulPayoutsBetween :many
+SELECT * FROM payouts
+WHERE status = 'SUCCESS' AND created_at >= sqlc.arg(from_time) AND created_at < sqlc.arg(to_time)
+ORDER BY created_at;
+
+-- name: GetPayoutByTransactionID :one
+SELECT * FROM payouts
+WHERE transaction_id = ?
+LIMIT 1;
@@ -0,0 +1,7 @@
+-- name: InsertRequestNonce :exec
+INSERT INTO request_nonces (key_id, nonce, expires_at)
+VALUES (?, ?, ?);
+
+-- name: DeleteExpiredNonces :execrows
+DELETE FROM request_nonces
+WHERE expires_at < sqlc.arg(before);
@@ -0,0 +1,33 @@
+-- An account number is only the same account at the same bank in the same
+-- country; bank_code uses IS as in the limit queries
+-- name: ListRecipientPayoutIDs :many
+SELECT id, status FROM payouts
+WHERE tenant_id = sqlc.arg(tenant_id) AND pii_erased_at IS NULL
+  AND (id = sqlc.arg(payout_id)
+    OR (country_code = sqlc.arg(country_code) AND bank_code IS sqlc.arg(bank_code)
+      AND (account_number_bidx = sqlc.arg(account_bidx) OR account_number = sqlc.arg(account_number)))
+    OR recipient_phone_bidx = sqlc.arg(phone_bidx)
+    OR recipient_phone_e164 = sqlc.arg(phone)
+    OR recipient_phone = sqlc.arg(phone))
+ORDER BY created_at, id;
+
+-- name: ListExpiredPayouts :many
+SELECT id, tenant_id FROM payouts
+WHERE pii_erased_at IS NULL AND created_at < sqlc.arg(cutoff)
+  AND status IN ('SUCCESS', 'FAILED', 'REJECTED', 'REVERSED')
+ORDER BY created_at, id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ErasePayoutPII :execrows
+UPDATE payouts
+SET recipient_name = '', recipient_phone = '', recipient_email = NULL, recipient_tag = NULL,
+    account_number = NULL, recipient_phone_e164 = NULL, resolved_account_name = NULL,
+    account_number_bidx = NULL, recipient_phone_bidx = NULL, fingerprint = NULL,
+    error_message = NULL, pii_erased_at = sqlc.arg(erased_at), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id) AND pii_erased_at IS NULL
+  AND status IN ('SUCCESS', 'FAILED', 'REJECTED', 'REVERSED');
+
+-- name: EraseReviewDetails :exec
+UPDATE reviews
+SET detail = ''
+WHERE payout_id = ?;
@@ -0,0 +1,29 @@
+-- name: CreateReview :exec
+INSERT INTO reviews (
+  id, payout_id, batch_id, reason, detail, status
+) VALUES (
+  ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetReview :one
+SELECT r.*, p.tenant_id, p.recipient_name, p.country_code, p.amount, p.currency
+FROM reviews r
+JOIN payouts p ON p.id = r.payout_id
+WHERE p.tenant_id = ? AND r.id = ? LIMIT 1;
+
+-- name: ListReviewsByStatus :many
+SELECT r.*, p.tenant_id, p.recipient_name, p.country_code, p.amount, p.currency
+FROM reviews r
+JOIN payouts p ON p.id = r.payout_id
+WHERE p.tenant_id = ? AND r.status = ?
+ORDER BY r.created_at ASC
+LIMIT ?;
+
+-- name: DecideReview :execrows
+UPDATE reviews
+SET status = ?, reviewer = ?, decision_reason = ?, decided_at = CURRENT_TIMESTAMP
+WHERE id = ? AND status = 'PENDING';
+
+-- name: ListApprovedReviewReasons :many
+SELECT reason FROM reviews
+WHERE payout_id = ? AND status = 'APPROVED';
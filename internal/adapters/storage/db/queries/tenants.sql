@@ -0,0 +1,16 @@
+-- name: CreateTenant :exec
+INSERT INTO tenants (id, name, webhook_url)
+VALUES (?, ?, ?);
+
+-- name: GetTenant :one
+SELECT id, name, webhook_url, created_at FROM tenants
+WHERE id = ?;
+
+-- name: ListTenants :many
+SELECT id, name, webhook_url, created_at FROM tenants
+ORDER BY created_at, id;
+
+-- name: SetTenantWebhookURL :execrows
+UPDATE tenants
+SET webhook_url = ?
+WHERE id = ?;
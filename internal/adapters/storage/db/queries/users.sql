@@ -0,0 +1,66 @@
+-- name: CreateUser :exec
+INSERT INTO users (id, tenant_id, email, name, role, password_hash, created_by)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetUser :one
+SELECT id, tenant_id, email, name, role, created_by, created_at, last_login_at, disabled_at
+FROM users
+WHERE tenant_id = ? AND id = ?;
+
+-- name: GetUserByID :one
+SELECT id, tenant_id, email, name, role, created_by, created_at, last_login_at, disabled_at
+FROM users
+WHERE id = ?;
+
+-- name: GetUserByEmail :one
+SELECT id, tenant_id, email, name, role, created_by, created_at, last_login_at, disabled_at, password_hash
+FROM users
+WHERE email = ?;
+
+-- name: GetUserPasswordHash :one
+SELECT password_hash FROM users
+WHERE tenant_id = ? AND id = ?;
+
+-- name: ListUsers :many
+SELECT id, tenant_id, email, name, role, created_by, created_at, last_login_at, disabled_at
+FROM users
+WHERE tenant_id = ?
+ORDER BY email;
+
+-- name: SetUserRole :execrows
+UPDATE users SET role = ?
+WHERE tenant_id = ? AND id = ?;
+
+-- name: SetUserPassword :execrows
+UPDATE users SET password_hash = ?
+WHERE tenant_id = ? AND id = ?;
+
+-- name: DisableUser :execrows
+UPDATE users SET disabled_at = CURRENT_TIMESTAMP
+WHERE tenant_id = ? AND id = ? AND disabled_at IS NULL;
+
+-- name: TouchUserLogin :exec
+UPDATE users SET last_login_at = sqlc.arg(login_at)
+WHERE id = sqlc.arg(id);
+
+-- name: CreateRefreshToken :exec
+INSERT INTO refresh_tokens (id, user_id, token_hash, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetRefreshTokenByHash :one
+SELECT id, user_id, created_at, expires_at, revoked_at, replaced_by
+FROM refresh_tokens
+WHERE token_hash = ?;
+
+-- name: ReplaceRefreshToken :execrows
+UPDATE refresh_tokens
+SET replaced_by = sqlc.arg(replaced_by), revoked_at = sqlc.arg(revoked_at)
+WHERE id = sqlc.arg(id) AND revoked_at IS NULL;
+
+-- name: RevokeRefreshToken :exec
+UPDATE refresh_tokens SET revoked_at = sqlc.arg(revoked_at)
+WHERE id = sqlc.arg(id) AND revoked_at IS NULL;
+
+-- name: RevokeUserRefreshTokens :exec
+UPDATE refresh_tokens SET revoked_at = sqlc.arg(revoked_at)
+WHERE user_id = sqlc.arg(user_id) AND revoked_at IS NULL;
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var (
+	_ ports.RateLimitStore   = (*SQLiteRepo)(nil)
+	_ ports.QuotaUsageReader = (*SQLiteRepo)(nil)
+)
+
+func (r *SQLiteRepo) ListRateLimits(ctx context.Context, tenantID string) ([]domain.RateLimitRule, error) {
+	rows, err := r.q.ListRateLimits(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]domain.RateLimitRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, domain.RateLimitRule{
+			TenantID: row.TenantID,
+			KeyID:    row.KeyID,
+			Route:    row.Route,
+			RateLimit: domain.RateLimit{
+				PerMinute: int(row.PerMinute),
+				Burst:     int(row.Burst),
+			},
+		})
+	}
+	return rules, nil
+}
+
+func (r *SQLiteRepo) SetRateLimit(ctx context.Context, rule domain.RateLimitRule) error {
+	return r.q.UpsertRateLimit(ctx, UpsertRateLimitParams{
+		TenantID:  rule.TenantID,
+		KeyID:     rule.KeyID,
+		Route:     rule.Route,
+		PerMinute: int64(rule.PerMinute),
+		Burst:     int64(rule.Burst),
+	})
+}
+
+// DeleteRateLimit is a no-op when no such rule is set.
+func (r *SQLiteRepo) DeleteRateLimit(ctx context.Context, tenantID, keyID, route string) error {
+	_, err := r.q.DeleteRateLimit(ctx, DeleteRateLimitParams{TenantID: tenantID, KeyID: keyID, Route: route})
+	return err
+}
+
+func (r *SQLiteRepo) ListPayoutQuotas(ctx context.Context, tenantID string) ([]domain.PayoutQuota, error) {
+	rows, err := r.q.ListPayoutQuotas(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]domain.PayoutQuota, 0, len(rows))
+	for _, row := range rows {
+		quotas = append(quotas, domain.PayoutQuota{
+			TenantID:   row.TenantID,
+			KeyID:      row.KeyID,
+			DailyItems: int(row.DailyItems),
+		})
+	}
+	return quotas, nil
+}
+
+func (r *SQLiteRepo) SetPayoutQuota(ctx context.Context, quota domain.PayoutQuota) error {
+	return r.q.UpsertPayoutQuota(ctx, UpsertPayoutQuotaParams{
+		TenantID:   quota.TenantID,
+		KeyID:      quota.KeyID,
+		DailyItems: int64(quota.DailyItems),
+	})
+}
+
+// DeletePayoutQuota is a no-op when no such quota is set.
+func (r *SQLiteRepo) DeletePayoutQuota(ctx context.Context, tenantID, keyID string) error {
+	_, err := r.q.DeletePayoutQuota(ctx, DeletePayoutQuotaParams{TenantID: tenantID, KeyID: keyID})
+	return err
+}
+
+func (r *SQLiteRepo) PayoutItemsSince(ctx context.Context, tenantID, keyID string, since time.Time) (int64, error) {
+	return txStore{q: r.q}.PayoutItemsSince(ctx, tenantID, keyID, since)
+}
+
+// PayoutItemsSince sums the item counts of batches created since the given
+// time, whatever became of them.
+func (u txStore) PayoutItemsSince(ctx context.Context, tenantID, keyID string, since time.Time) (int64, error) {
+	at := sql.NullTime{Time: since.UTC(), Valid: true}
+	if keyID == "" {
+		return u.q.CountTenantPayoutItems(ctx, CountTenantPayoutItemsParams{TenantID: tenantID, Since: at})
+	}
+	return u.q.CountKeyPayoutItems(ctx, CountKeyPayoutItemsParams{TenantID: tenantID, ApiKeyID: keyID, Since: at})
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"waya/internal/core/domain"
+)
+
+func (r *SQLiteRepo) SaveReconciliation(ctx context.Context, run domain.ReconciliationRun) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		err := q.CreateReconciliationRun(ctx, CreateReconciliationRunParams{
+			ID:               run.ID,
+			Source:           run.Source,
+			PeriodFrom:       run.From.UTC(),
+			PeriodTo:         run.To.UTC(),
+			Records:          int64(run.Records),
+			Matched:          int64(run.Matched),
+			MissingOurs:      int64(run.MissingOurs),
+			MissingTheirs:    int64(run.MissingTheirs),
+			AmountMismatches: int64(run.AmountMismatches),
+			Reversed:         int64(run.Reversed),
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range run.Exceptions {
+			currency := e.Ours.Currency
+			if currency == "" {
+				currency = e.Theirs.Currency
+			}
+			err := q.CreateReconciliationItem(ctx, CreateReconciliationItemParams{
+				ID:            e.ID,
+				RunID:         run.ID,
+				Result:        e.Result,
+				PayoutID:      sql.NullString{String: e.PayoutID, Valid: e.PayoutID != ""},
+				TransactionID: sql.NullString{String: e.TransactionID, Valid: e.TransactionID != ""},
+				Reference:     sql.NullString{String: e.Reference, Valid: e.Reference != ""},
+				OurAmount:     sql.NullInt64{Int64: e.Ours.Amount, Valid: e.Ours.Currency != ""},
+				TheirAmount:   sql.NullInt64{Int64: e.Theirs.Amount, Valid: e.Theirs.Currency != ""},
+				Currency:      currency,
+				Detail:        e.Detail,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *SQLiteRepo) GetReconciliation(ctx context.Context, id string) (*domain.ReconciliationRun, error) {
+	row, err := r.q.GetReconciliationRun(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrReconciliationNotFound
+		}
+		return nil, err
+	}
+	items, err := r.q.ListReconciliationItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	run := toDomainReconciliation(row)
+	for _, it := range items {
+		e := domain.ReconciliationItem{
+			ID:            it.ID,
+			RunID:         it.RunID,
+			Result:        it.Result,
+			PayoutID:      it.PayoutID.String,
+			TransactionID: it.TransactionID.String,
+			Reference:     it.Reference.String,
+			Detail:        it.Detail,
+		}
+		if it.OurAmount.Valid {
+			e.Ours = domain.NewMoney(it.OurAmount.Int64, it.Currency)
+		}
+		if it.TheirAmount.Valid {
+			e.Theirs = domain.NewMoney(it.TheirAmount.Int64, it.Currency)
+		}
+		run.Exceptions = append(run.Exceptions, e)
+	}
+	return &run, nil
+}
+
+func (r *SQLiteRepo) ListReconciliations(ctx context.Context, limit int) ([]domain.ReconciliationRun, error) {
+	rows, err := r.q.ListReconciliationRuns(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]domain.ReconciliationRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, toDomainReconciliation(row))
+	}
+	return runs, nil
+}
+
+func (r *SQLiteRepo) ListSuccessfulPayouts(ctx context.Context, from, to time.Time) ([]domain.Payout, error) {
+	rows, err := r.q.ListSuccessfulPayoutsBetween(ctx, ListSuccessfulPayoutsBetweenParams{
+		FromTime: sql.NullTime{Time: from.UTC(), Valid: true},
+		ToTime:   sql.NullTime{Time: to.UTC(), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.payouts(ctx, rows)
+}
+
+func (r *SQLiteRepo) FindPayoutByTransactionID(ctx context.Context, transactionID string) (*domain.Payout, error) {
+	row, err := r.q.GetPayoutByTransactionID(ctx, sql.NullString{String: transactionID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	p, err := r.payout(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func toDomainReconciliation(row ReconciliationRun) domain.ReconciliationRun {
+	return domain.ReconciliationRun{
+		ID:               row.ID,
+		Source:           row.Source,
+		From:             row.PeriodFrom,
+		To:               row.PeriodTo,
+		Records:          int(row.Records),
+		Matched:          int(row.Matched),
+		MissingOurs:      int(row.MissingOurs),
+		MissingTheirs:    int(row.MissingTheirs),
+		AmountMismatches: int(row.AmountMismatches),
+		Reversed:         int(row.Reversed),
+		CreatedAt:        row.CreatedAt,
+	}
+}
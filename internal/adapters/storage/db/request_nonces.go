@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.NonceStore = (*SQLiteRepo)(nil)
+
+// UseNonce records the nonce; the primary key refuses a second use.
+func (r *SQLiteRepo) UseNonce(ctx context.Context, keyID, nonce string, expiresAt time.Time) error {
+	err := r.q.InsertRequestNonce(ctx, InsertRequestNonceParams{
+		KeyID:     keyID,
+		Nonce:     nonce,
+		ExpiresAt: expiresAt.UTC(),
+	})
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return domain.ErrNonceReused
+	}
+	return err
+}
+
+func (r *SQLiteRepo) DeleteExpiredNonces(ctx context.Context, before time.Time) (int64, error) {
+	return r.q.DeleteExpiredNonces(ctx, before.UTC())
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"waya/internal/core/domain"
+)
+
+// EraseRecipient blanks the recipient details of payoutID and of every
+// other payout of the tenant to the same account (the number at the same
+// bank in the same country) or phone, matched by blind index (or as
+// plaintext on rows stored before encryption), along with their review
+// details. Payouts still pending, held, awaiting approval or processing
+// need their details to be paid, so they are left alone and returned as
+// live. Both lists are oldest first.
+func (r *SQLiteRepo) EraseRecipient(ctx context.Context, tenantID, payoutID string, account domain.RecipientAccount, phone string, at time.Time) (erased, live []string, err error) {
+	err = r.withTx(ctx, func(q *Queries) error {
+		rows, err := q.ListRecipientPayoutIDs(ctx, ListRecipientPayoutIDsParams{
+			TenantID:      tenantID,
+			PayoutID:      payoutID,
+			CountryCode:   account.Country,
+			BankCode:      nullString(account.BankCode),
+			AccountBidx:   r.pii.blindIndex(bidxAccount, account.AccountNumber),
+			AccountNumber: nullString(account.AccountNumber),
+			PhoneBidx:     r.pii.blindIndex(bidxPhone, phone),
+			Phone:         nullString(phone),
+		})
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if !(domain.Payout{Status: row.Status}).IsFinished() {
+				live = append(live, row.ID)
+				continue
+			}
+			n, err := erasePayout(ctx, q, row.ID, at)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				erased = append(erased, row.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return erased, live, nil
+}
+
+// EraseExpired blanks the recipient details of up to limit payouts created
+// before cutoff that are finished: paid, failed, rejected or reversed.
+// Payouts still pending, held or awaiting approval keep theirs until they
+// finish. It returns how many were erased per tenant; call it again until
+// it erases none.
+func (r *SQLiteRepo) EraseExpired(ctx context.Context, cutoff, at time.Time, limit int) (map[string]int, error) {
+	erased := make(map[string]int)
+	err := r.withTx(ctx, func(q *Queries) error {
+		rows, err := q.ListExpiredPayouts(ctx, ListExpiredPayoutsParams{Cutoff: nullTime(cutoff), RowLimit: int64(limit)})
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			n, err := erasePayout(ctx, q, row.ID, at)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				erased[row.TenantID]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return erased, nil
+}
+
+func erasePayout(ctx context.Context, q *Queries, id string, at time.Time) (int64, error) {
+	n, err := q.ErasePayoutPII(ctx, ErasePayoutPIIParams{ErasedAt: nullTime(at), ID: id})
+	if err != nil || n == 0 {
+		return n, err
+	}
+	return n, q.EraseReviewDetails(ctx, id)
+}
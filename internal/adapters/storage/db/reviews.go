@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.ReviewRepository = (*SQLiteRepo)(nil)
+
+// CreateReview encrypts the detail, which can quote the recipient's name.
+func (r *SQLiteRepo) CreateReview(ctx context.Context, rv domain.Review) error {
+	detail, err := r.pii.seal(rv.Detail, "reviews.detail/"+rv.ID)
+	if err != nil {
+		return err
+	}
+	return r.q.CreateReview(ctx, CreateReviewParams{
+		ID:       rv.ID,
+		PayoutID: rv.PayoutID,
+		BatchID:  rv.BatchID,
+		Reason:   rv.Reason,
+		Detail:   detail,
+		Status:   rv.Status,
+	})
+}
+
+func (r *SQLiteRepo) GetReview(ctx context.Context, tenantID, id string) (*domain.Review, error) {
+	row, err := r.q.GetReview(ctx, GetReviewParams{TenantID: tenantID, ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrReviewNotFound
+		}
+		return nil, err
+	}
+	rv, err := r.review(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+func (r *SQLiteRepo) ListReviews(ctx context.Context, tenantID, status string, limit int) ([]domain.Review, error) {
+	rows, err := r.q.ListReviewsByStatus(ctx, ListReviewsByStatusParams{TenantID: tenantID, Status: status, Limit: int64(limit)})
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]domain.Review, 0, len(rows))
+	for _, row := range rows {
+		rv, err := r.review(ctx, GetReviewRow(row))
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, rv)
+	}
+	return reviews, nil
+}
+
+// DecideReview records the decision only if the review is still pending, so
+// two reviewers racing on the same item cannot both win.
+func (r *SQLiteRepo) DecideReview(ctx context.Context, id, status, reviewer, reason string) error {
+	n, err := r.q.DecideReview(ctx, DecideReviewParams{
+		ID:             id,
+		Status:         status,
+		Reviewer:       sql.NullString{String: reviewer, Valid: reviewer != ""},
+		DecisionReason: sql.NullString{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrReviewAlreadyDecided
+	}
+	return nil
+}
+
+func (r *SQLiteRepo) ApprovedReviewReasons(ctx context.Context, payoutID string) ([]string, error) {
+	return r.q.ListApprovedReviewReasons(ctx, payoutID)
+}
+
+// review maps a row and decrypts the detail and the recipient's name.
+func (r *SQLiteRepo) review(ctx context.Context, row GetReviewRow) (domain.Review, error) {
+	rv := toDomainReview(row)
+	var err error
+	if rv.Detail, err = r.pii.open(ctx, rv.Detail, "reviews.detail/"+rv.ID); err != nil {
+		return domain.Review{}, err
+	}
+	if rv.RecipientName, err = r.pii.open(ctx, rv.RecipientName, "payouts.recipient_name/"+rv.PayoutID); err != nil {
+		return domain.Review{}, err
+	}
+	return rv, nil
+}
+
+func toDomainReview(row GetReviewRow) domain.Review {
+	return domain.Review{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		PayoutID:       row.PayoutID,
+		BatchID:        row.BatchID,
+		Reason:         row.Reason,
+		Detail:         row.Detail,
+		Status:         row.Status,
+		Reviewer:       row.Reviewer.String,
+		DecisionReason: row.DecisionReason.String,
+		CreatedAt:      row.CreatedAt,
+		DecidedAt:      row.DecidedAt.Time,
+
+		RecipientName: row.RecipientName,
+		CountryCode:   row.CountryCode,
+		Amount:        row.Amount,
+		Currency:      row.Currency,
+	}
+}
@@ -2,9 +2,12 @@ package db
 
 import (
 	"database/sql"
-	_ "embed"
+	"embed"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"sort"
+	"strings"
 	"time"
 
     // Import the generated SQLC code
@@ -13,8 +16,8 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 )
 
-//go:embed migrations/001_initial_schema.sql
-var schema string
+//go:embed migrations/*.sql
+var migrations embed.FS
 
 type Database struct {
 	Conn *sql.DB
@@ -24,8 +27,9 @@ type Database struct {
 func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
 	var dsn string
 	if cfg.Driver == "sqlite3" {
-		// Enable Write-Ahead Logging (WAL) for concurrency speed
-		dsn = fmt.Sprintf("%s?_journal_mode=WAL&_busy_timeout=5000", cfg.Source)
+		// Enable Write-Ahead Logging (WAL) for concurrency speed. Transactions
+		// take the write lock up front so read-then-insert checks (limits) are atomic.
+		dsn = fmt.Sprintf("%s?_journal_mode=WAL&_busy_timeout=5000&_txlock=immediate", cfg.Source)
 	} else {
 		dsn = cfg.Source
 	}
@@ -46,16 +50,96 @@ func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
 
 	slog.Info("✅ Database connected", "driver", cfg.Driver)
 
-	// AUTO-MIGRATE (Run schema on startup)
-	if _, err := conn.Exec(schema); err != nil {
-		// In production, use a real migration tool. For hackathon, this is fine.
-		slog.Warn("⚠️ Migration warning (tables might exist):", "err", err)
-	} else {
-        slog.Info("✅ Schema applied successfully")
-    }
+	// AUTO-MIGRATE (Run pending migrations on startup)
+	if err := migrate(conn); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
 
 	return &Database{
 		Conn: conn,
 		Q:    New(conn),
 	}, nil
-}
\ No newline at end of file
+}
+
+// migrate applies every embedded migration that has not been recorded in
+// schema_migrations yet, in file name order, each in its own transaction.
+func migrate(conn *sql.DB) error {
+	if _, err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version TEXT PRIMARY KEY,
+		applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return err
+	}
+
+	if err := adoptInitialSchema(conn); err != nil {
+		return err
+	}
+
+	files, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		version := strings.TrimSuffix(strings.TrimPrefix(file, "migrations/"), ".sql")
+
+		var applied int
+		if err := conn.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, version).Scan(&applied); err != nil {
+			return err
+		}
+		if applied > 0 {
+			continue
+		}
+
+		body, err := migrations.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		tx, err := conn.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(string(body)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		slog.Info("✅ Migration applied", "version", version)
+	}
+	return nil
+}
+
+// initialSchema is the first migration, which databases created before
+// migrations were tracked already have
+const initialSchema = "001_initial_schema"
+
+// adoptInitialSchema records initialSchema as applied on a database that
+// predates schema_migrations: nothing recorded yet, but its payouts and
+// batches tables exist. Any other migration that fails is an error.
+func adoptInitialSchema(conn *sql.DB) error {
+	var recorded int
+	if err := conn.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&recorded); err != nil {
+		return err
+	}
+	if recorded > 0 {
+		return nil
+	}
+	var tables int
+	if err := conn.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name IN ('payouts', 'batches')`).Scan(&tables); err != nil {
+		return err
+	}
+	if tables < 2 {
+		return nil
+	}
+	slog.Warn("⚠️ Database predates tracked migrations, recording the initial schema as applied", "version", initialSchema)
+	_, err := conn.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, initialSchema)
+	return err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.TenantStore = (*SQLiteRepo)(nil)
+
+func (r *SQLiteRepo) CreateTenant(ctx context.Context, t domain.Tenant) error {
+	err := r.q.CreateTenant(ctx, CreateTenantParams{
+		ID:         t.ID,
+		Name:       t.Name,
+		WebhookUrl: t.WebhookURL,
+	})
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed: tenants.id") {
+		return domain.ErrTenantExists
+	}
+	return err
+}
+
+func (r *SQLiteRepo) GetTenant(ctx context.Context, id string) (*domain.Tenant, error) {
+	row, err := r.q.GetTenant(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, err
+	}
+	t := toDomainTenant(row)
+	return &t, nil
+}
+
+func (r *SQLiteRepo) ListTenants(ctx context.Context) ([]domain.Tenant, error) {
+	rows, err := r.q.ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenants := make([]domain.Tenant, 0, len(rows))
+	for _, row := range rows {
+		tenants = append(tenants, toDomainTenant(GetTenantRow(row)))
+	}
+	return tenants, nil
+}
+
+func (r *SQLiteRepo) SetTenantWebhookURL(ctx context.Context, id, url string) error {
+	n, err := r.q.SetTenantWebhookURL(ctx, SetTenantWebhookURLParams{ID: id, WebhookUrl: url})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrTenantNotFound
+	}
+	return nil
+}
+
+func toDomainTenant(row GetTenantRow) domain.Tenant {
+	return domain.Tenant{
+		ID:         row.ID,
+		Name:       row.Name,
+		WebhookURL: row.WebhookUrl,
+		CreatedAt:  row.CreatedAt,
+	}
+}
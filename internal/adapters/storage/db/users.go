@@ -0,0 +1,203 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+var _ ports.UserStore = (*SQLiteRepo)(nil)
+
+func (r *SQLiteRepo) CreateUser(ctx context.Context, u domain.User, passwordHash string) error {
+	err := r.q.CreateUser(ctx, CreateUserParams{
+		ID:           u.ID,
+		TenantID:     u.TenantID,
+		Email:        u.Email,
+		Name:         u.Name,
+		Role:         u.Role,
+		PasswordHash: passwordHash,
+		CreatedBy:    u.CreatedBy,
+	})
+	if err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed: users.email") {
+		return domain.ErrUserExists
+	}
+	return err
+}
+
+func (r *SQLiteRepo) GetUser(ctx context.Context, tenantID, id string) (*domain.User, error) {
+	row, err := r.q.GetUser(ctx, GetUserParams{TenantID: tenantID, ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	u := toDomainUser(row)
+	return &u, nil
+}
+
+func (r *SQLiteRepo) FindUser(ctx context.Context, id string) (*domain.User, error) {
+	row, err := r.q.GetUserByID(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	u := toDomainUser(GetUserRow(row))
+	return &u, nil
+}
+
+func (r *SQLiteRepo) FindUserByEmail(ctx context.Context, email string) (*domain.User, string, error) {
+	row, err := r.q.GetUserByEmail(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, "", domain.ErrUserNotFound
+		}
+		return nil, "", err
+	}
+	u := toDomainUser(GetUserRow{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		Email:       row.Email,
+		Name:        row.Name,
+		Role:        row.Role,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+		LastLoginAt: row.LastLoginAt,
+		DisabledAt:  row.DisabledAt,
+	})
+	return &u, row.PasswordHash, nil
+}
+
+func (r *SQLiteRepo) UserPasswordHash(ctx context.Context, tenantID, id string) (string, error) {
+	hash, err := r.q.GetUserPasswordHash(ctx, GetUserPasswordHashParams{TenantID: tenantID, ID: id})
+	if err == sql.ErrNoRows {
+		return "", domain.ErrUserNotFound
+	}
+	return hash, err
+}
+
+func (r *SQLiteRepo) ListUsers(ctx context.Context, tenantID string) ([]domain.User, error) {
+	rows, err := r.q.ListUsers(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]domain.User, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, toDomainUser(GetUserRow(row)))
+	}
+	return users, nil
+}
+
+func (r *SQLiteRepo) SetUserRole(ctx context.Context, tenantID, id, role string) error {
+	n, err := r.q.SetUserRole(ctx, SetUserRoleParams{Role: role, TenantID: tenantID, ID: id})
+	return userUpdated(n, err)
+}
+
+func (r *SQLiteRepo) SetUserPassword(ctx context.Context, tenantID, id, passwordHash string) error {
+	n, err := r.q.SetUserPassword(ctx, SetUserPasswordParams{PasswordHash: passwordHash, TenantID: tenantID, ID: id})
+	return userUpdated(n, err)
+}
+
+func (r *SQLiteRepo) DisableUser(ctx context.Context, tenantID, id string) error {
+	n, err := r.q.DisableUser(ctx, DisableUserParams{TenantID: tenantID, ID: id})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrUserDisabled
+	}
+	return nil
+}
+
+func (r *SQLiteRepo) TouchUserLogin(ctx context.Context, id string, at time.Time) error {
+	return r.q.TouchUserLogin(ctx, TouchUserLoginParams{ID: id, LoginAt: nullTime(at)})
+}
+
+func (r *SQLiteRepo) CreateRefreshToken(ctx context.Context, t domain.RefreshToken, tokenHash string) error {
+	return insertRefreshToken(ctx, r.q, t, tokenHash)
+}
+
+func insertRefreshToken(ctx context.Context, q *Queries, t domain.RefreshToken, tokenHash string) error {
+	return q.CreateRefreshToken(ctx, CreateRefreshTokenParams{
+		ID:        t.ID,
+		UserID:    t.UserID,
+		TokenHash: tokenHash,
+		CreatedAt: t.CreatedAt.UTC(),
+		ExpiresAt: t.ExpiresAt.UTC(),
+	})
+}
+
+func (r *SQLiteRepo) FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	row, err := r.q.GetRefreshTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrRefreshInvalid
+		}
+		return nil, err
+	}
+	return &domain.RefreshToken{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		CreatedAt:  row.CreatedAt,
+		ExpiresAt:  row.ExpiresAt,
+		RevokedAt:  row.RevokedAt.Time,
+		ReplacedBy: row.ReplacedBy.String,
+	}, nil
+}
+
+// RotateRefreshToken revokes old and stores next in one transaction, so two
+// concurrent refreshes with the same token cannot both succeed.
+func (r *SQLiteRepo) RotateRefreshToken(ctx context.Context, oldID string, next domain.RefreshToken, nextHash string) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		n, err := q.ReplaceRefreshToken(ctx, ReplaceRefreshTokenParams{
+			ReplacedBy: sql.NullString{String: next.ID, Valid: true},
+			RevokedAt:  nullTime(next.CreatedAt),
+			ID:         oldID,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return domain.ErrRefreshReused
+		}
+		return insertRefreshToken(ctx, q, next, nextHash)
+	})
+}
+
+func (r *SQLiteRepo) RevokeRefreshToken(ctx context.Context, id string) error {
+	return r.q.RevokeRefreshToken(ctx, RevokeRefreshTokenParams{ID: id, RevokedAt: nullTime(time.Now())})
+}
+
+func (r *SQLiteRepo) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	return r.q.RevokeUserRefreshTokens(ctx, RevokeUserRefreshTokensParams{UserID: userID, RevokedAt: nullTime(time.Now())})
+}
+
+func userUpdated(n int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func toDomainUser(row GetUserRow) domain.User {
+	return domain.User{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		Email:       row.Email,
+		Name:        row.Name,
+		Role:        row.Role,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+		LastLoginAt: row.LastLoginAt.Time,
+		DisabledAt:  row.DisabledAt.Time,
+	}
+}
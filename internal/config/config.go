@@ -10,11 +10,21 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig   `mapstructure:",squash"`
-	Database DatabaseConfig `mapstructure:",squash"`
-	Afriex   AfriexConfig   `mapstructure:",squash"`
-	AI       AIConfig       `mapstructure:",squash"`
-	Waya     WayaConfig     `mapstructure:",squash"`
+	Server   ServerConfig    `mapstructure:",squash"`
+	Database DatabaseConfig  `mapstructure:",squash"`
+	Afriex   AfriexConfig    `mapstructure:",squash"`
+	AI       AIConfig        `mapstructure:",squash"`
+	Waya     WayaConfig      `mapstructure:",squash"`
+	Registry RegistryConfig  `mapstructure:",squash"`
+	Checks   ChecksConfig    `mapstructure:",squash"`
+	Approval ApprovalConfig  `mapstructure:",squash"`
+	Dupes    DuplicateConfig `mapstructure:",squash"`
+	Ledger   LedgerConfig    `mapstructure:",squash"`
+	Recon    ReconConfig     `mapstructure:",squash"`
+	Rate     RateLimitConfig `mapstructure:",squash"`
+	Auth     AuthConfig      `mapstructure:",squash"`
+	PII      PIIConfig       `mapstructure:",squash"`
+	Log      LogConfig       `mapstructure:",squash"`
 }
 
 type ServerConfig struct {
@@ -38,6 +48,97 @@ type AfriexConfig struct {
 type WayaConfig struct {
     APIKey string `mapstructure:"WAYA_API_KEY"`
     BETAWORKOSWebhookURL string `mapstructure:"BETAWORKOS_WEBHOOK_URL"` // New field
+	// How far a signed request's timestamp may be from the server clock
+	SignatureMaxSkew time.Duration `mapstructure:"SIGNATURE_MAX_SKEW"`
+}
+
+// ChecksConfig toggles the pre-payment checks run on every payout
+type ChecksConfig struct {
+	NameEnquiryEnabled bool    `mapstructure:"NAME_ENQUIRY_ENABLED"`
+	NameMatchThreshold float64 `mapstructure:"NAME_MATCH_THRESHOLD"` // 0..1, below this the payout is held
+
+	// Directory of OFAC/UN/EU list files; empty disables sanctions screening
+	SanctionsListsDir       string  `mapstructure:"SANCTIONS_LISTS_DIR"`
+	SanctionsMatchThreshold float64 `mapstructure:"SANCTIONS_MATCH_THRESHOLD"` // 0..1, at or above this the payout is held
+}
+
+// ApprovalConfig is the maker-checker policy for new batches
+type ApprovalConfig struct {
+	Enabled bool `mapstructure:"APPROVAL_ENABLED"`
+	// Per-currency batch totals that trigger approval, e.g. "NGN:5000000.00,KES:500000".
+	// Empty means every batch needs approval.
+	Thresholds string `mapstructure:"APPROVAL_THRESHOLDS"`
+	// Comma-separated users allowed to approve; empty means anyone but the submitter
+	Approvers string `mapstructure:"APPROVAL_APPROVERS"`
+}
+
+// DuplicateConfig controls duplicate payout detection across batches
+type DuplicateConfig struct {
+	Policy string        `mapstructure:"DUPLICATE_POLICY"` // off, flag or block
+	Window time.Duration `mapstructure:"DUPLICATE_WINDOW"` // How far back to compare, e.g. 72h
+}
+
+// LedgerConfig controls how payouts draw on tenant funds
+type LedgerConfig struct {
+	// Reject batches larger than the available balance. false lets balances
+	// go negative, and has to be set explicitly.
+	PrefundingRequired bool `mapstructure:"PREFUNDING_REQUIRED"`
+}
+
+// ReconConfig runs settlement reconciliation on Afriex exports dropped in a folder
+type ReconConfig struct {
+	Dir      string        `mapstructure:"RECONCILIATION_DIR"`      // Empty disables the folder job; the API still works
+	Interval time.Duration `mapstructure:"RECONCILIATION_INTERVAL"` // How often to look for new exports
+}
+
+// RateLimitConfig is the request rate and payout quota of tenants and keys
+// without their own, which the tenants command sets
+type RateLimitConfig struct {
+	PerMinute        int `mapstructure:"RATE_LIMIT_PER_MINUTE"` // Requests per key per route; 0 disables the default limit
+	Burst            int `mapstructure:"RATE_LIMIT_BURST"`
+	PayoutsPerMinute int `mapstructure:"RATE_LIMIT_PAYOUTS_PER_MINUTE"` // POST /payouts, which calls Afriex; 0 uses the above
+	PayoutsBurst     int `mapstructure:"RATE_LIMIT_PAYOUTS_BURST"`
+	DailyPayoutQuota int `mapstructure:"DAILY_PAYOUT_QUOTA"` // Payout items per tenant per UTC day; 0 is unlimited
+}
+
+// AuthConfig signs dashboard users' sessions
+type AuthConfig struct {
+	// HMAC key of access tokens, at least 32 bytes; empty disables user login
+	JWTSecret  string        `mapstructure:"JWT_SECRET"`
+	AccessTTL  time.Duration `mapstructure:"JWT_ACCESS_TTL"`  // Lifetime of access tokens
+	RefreshTTL time.Duration `mapstructure:"JWT_REFRESH_TTL"` // Lifetime of refresh tokens, until used
+}
+
+// PIIConfig holds the master key that seals the keys encrypting recipient
+// details. Either setting takes base64 256-bit keys, comma- or line-separated:
+// the first seals new keys, any after it are old masters still being rotated out.
+type PIIConfig struct {
+	MasterKey     string `mapstructure:"PII_MASTER_KEY"`
+	MasterKeyFile string `mapstructure:"PII_MASTER_KEY_FILE"` // Takes precedence over PII_MASTER_KEY
+
+	// How long recipient details are kept after a payout is created, e.g.
+	// 17520h for two years; 0 keeps them until a recipient asks for erasure
+	Retention         time.Duration `mapstructure:"PII_RETENTION"`
+	RetentionInterval time.Duration `mapstructure:"PII_RETENTION_INTERVAL"` // How often to erase expired details
+}
+
+// LogConfig controls what the API server logs. Recipient details and
+// credentials are masked whatever the level.
+type LogConfig struct {
+	Level string `mapstructure:"LOG_LEVEL"` // debug, info, warn or error; debug adds Afriex response bodies
+	// Comma-separated fields to mask besides the defaults; "-name" unmasks a default
+	RedactFields string `mapstructure:"LOG_REDACT_FIELDS"`
+}
+
+// RegistryConfig points at reference data files. Empty paths use the
+// defaults embedded in the binary.
+type RegistryConfig struct {
+	CorridorsFile string `mapstructure:"CORRIDORS_FILE"`
+	BanksFile     string `mapstructure:"BANKS_FILE"`
+	LimitsFile    string `mapstructure:"LIMITS_FILE"`
+	FeesFile      string `mapstructure:"FEES_FILE"`
+	// How often to top up the bank directory from Afriex (0 disables it)
+	BanksRefreshInterval time.Duration `mapstructure:"BANKS_REFRESH_INTERVAL"`
 }
 
 type AIConfig struct {
@@ -56,6 +157,38 @@ func LoadConfig(path string) (*Config, error) {
 	v.SetDefault("DB_DRIVER", "sqlite3")
 	v.SetDefault("DB_SOURCE", "./waya.db")
 	v.SetDefault("AFRIEX_BASE_URL", "https://staging.afx-server.com") // Mock URL for now
+	v.SetDefault("CORRIDORS_FILE", "")
+	v.SetDefault("BANKS_FILE", "")
+	v.SetDefault("LIMITS_FILE", "")
+	v.SetDefault("FEES_FILE", "")
+	v.SetDefault("BANKS_REFRESH_INTERVAL", time.Duration(0))
+	v.SetDefault("NAME_ENQUIRY_ENABLED", false)
+	v.SetDefault("NAME_MATCH_THRESHOLD", 0.85)
+	v.SetDefault("SANCTIONS_LISTS_DIR", "")
+	v.SetDefault("SANCTIONS_MATCH_THRESHOLD", 0.88)
+	v.SetDefault("APPROVAL_ENABLED", false)
+	v.SetDefault("APPROVAL_THRESHOLDS", "")
+	v.SetDefault("APPROVAL_APPROVERS", "")
+	v.SetDefault("DUPLICATE_POLICY", "flag")
+	v.SetDefault("DUPLICATE_WINDOW", 72*time.Hour)
+	v.SetDefault("PREFUNDING_REQUIRED", true)
+	v.SetDefault("RECONCILIATION_DIR", "")
+	v.SetDefault("RECONCILIATION_INTERVAL", 15*time.Minute)
+	v.SetDefault("SIGNATURE_MAX_SKEW", 5*time.Minute)
+	v.SetDefault("RATE_LIMIT_PER_MINUTE", 600)
+	v.SetDefault("RATE_LIMIT_BURST", 100)
+	v.SetDefault("RATE_LIMIT_PAYOUTS_PER_MINUTE", 30)
+	v.SetDefault("RATE_LIMIT_PAYOUTS_BURST", 10)
+	v.SetDefault("DAILY_PAYOUT_QUOTA", 0)
+	v.SetDefault("JWT_SECRET", "")
+	v.SetDefault("JWT_ACCESS_TTL", 15*time.Minute)
+	v.SetDefault("JWT_REFRESH_TTL", 7*24*time.Hour)
+	v.SetDefault("PII_MASTER_KEY", "")
+	v.SetDefault("PII_MASTER_KEY_FILE", "")
+	v.SetDefault("PII_RETENTION", time.Duration(0))
+	v.SetDefault("PII_RETENTION_INTERVAL", 24*time.Hour)
+	v.SetDefault("LOG_LEVEL", "info")
+	v.SetDefault("LOG_REDACT_FIELDS", "")
 
 	// 2. Read from .env file
 	v.AddConfigPath(path)
@@ -84,6 +217,44 @@ func LoadConfig(path string) (*Config, error) {
 	if cfg.Afriex.APIKey == "" && cfg.Server.Environment != "development" {
 		return nil, errors.New("AFRIEX_API_KEY is required in production")
 	}
+	if cfg.PII.MasterKey == "" && cfg.PII.MasterKeyFile == "" && cfg.Server.Environment != "development" {
+		return nil, errors.New("PII_MASTER_KEY or PII_MASTER_KEY_FILE is required in production")
+	}
+
+	if cfg.PII.Retention < 0 || (cfg.PII.Retention > 0 && cfg.PII.RetentionInterval <= 0) {
+		return nil, errors.New("PII_RETENTION must not be negative and PII_RETENTION_INTERVAL must be positive")
+	}
+
+	if cfg.Checks.NameMatchThreshold < 0 || cfg.Checks.NameMatchThreshold > 1 {
+		return nil, errors.New("NAME_MATCH_THRESHOLD must be between 0 and 1")
+	}
+	if cfg.Checks.SanctionsMatchThreshold <= 0 || cfg.Checks.SanctionsMatchThreshold > 1 {
+		return nil, errors.New("SANCTIONS_MATCH_THRESHOLD must be between 0 and 1")
+	}
+	switch cfg.Dupes.Policy {
+	case "off", "flag", "block":
+	default:
+		return nil, errors.New("DUPLICATE_POLICY must be off, flag or block")
+	}
+	if cfg.Dupes.Policy != "off" && cfg.Dupes.Window <= 0 {
+		return nil, errors.New("DUPLICATE_WINDOW must be positive")
+	}
+
+	if (cfg.Rate.PerMinute > 0 && cfg.Rate.Burst <= 0) || (cfg.Rate.PayoutsPerMinute > 0 && cfg.Rate.PayoutsBurst <= 0) {
+		return nil, errors.New("RATE_LIMIT_BURST and RATE_LIMIT_PAYOUTS_BURST must be positive when their limit is set")
+	}
+	if cfg.Waya.SignatureMaxSkew <= 0 {
+		return nil, errors.New("SIGNATURE_MAX_SKEW must be positive")
+	}
+	if cfg.Rate.DailyPayoutQuota < 0 {
+		return nil, errors.New("DAILY_PAYOUT_QUOTA must not be negative")
+	}
+	if cfg.Auth.JWTSecret != "" && len(cfg.Auth.JWTSecret) < 32 {
+		return nil, errors.New("JWT_SECRET must be at least 32 bytes")
+	}
+	if cfg.Auth.AccessTTL <= 0 || cfg.Auth.RefreshTTL <= cfg.Auth.AccessTTL {
+		return nil, errors.New("JWT_ACCESS_TTL must be positive and shorter than JWT_REFRESH_TTL")
+	}
 
 	// --- Init Waya Config (Need this for Notifier and Auth) ---
     // You'll need to create a WayaConfig loader in internal/config
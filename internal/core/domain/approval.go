@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Batch approval states (maker-checker)
+const (
+	ApprovalNotRequired = "NOT_REQUIRED"
+	ApprovalPending     = "PENDING"
+	ApprovalApproved    = "APPROVED"
+	ApprovalRejected    = "REJECTED"
+)
+
+// Batch audit trail actions
+const (
+	BatchEventSubmitted = "SUBMITTED"
+	BatchEventApproved  = "APPROVED"
+	BatchEventRejected  = "REJECTED"
+)
+
+var (
+	ErrBatchNotFound            = errors.New("batch not found")
+	ErrBatchNotAwaitingApproval = errors.New("batch is not awaiting approval")
+	ErrSelfApproval             = errors.New("a batch cannot be approved by whoever submitted it")
+	ErrNotApprover              = errors.New("user is not an authorized approver")
+)
+
+// BatchEvent is one entry of a batch's approval audit trail.
+type BatchEvent struct {
+	ID        string
+	BatchID   string
+	Actor     string // Who did it
+	Action    string // SUBMITTED, APPROVED, REJECTED
+	Comment   string
+	CreatedAt time.Time
+}
+
+// ApprovalPolicy decides which batches need a second person to sign off
+// before any money moves.
+type ApprovalPolicy struct {
+	Enabled bool
+	// Per-currency batch total above which approval is needed. With no
+	// thresholds every batch needs approval; a currency without a threshold
+	// always does.
+	Thresholds map[string]Money
+	// Who may approve, lowercased. Empty means anyone except the submitter.
+	Approvers map[string]bool
+}
+
+// Requires reports whether a batch needs approval and why.
+func (p ApprovalPolicy) Requires(payouts []Payout) (bool, string) {
+	if !p.Enabled {
+		return false, ""
+	}
+	if len(p.Thresholds) == 0 {
+		return true, "all batches require approval"
+	}
+
+	totals := make(map[string]int64)
+	for _, po := range payouts {
+		totals[po.Currency] += po.Amount
+	}
+	currencies := make([]string, 0, len(totals))
+	for cur := range totals {
+		currencies = append(currencies, cur)
+	}
+	sort.Strings(currencies)
+
+	var reasons []string
+	for _, cur := range currencies {
+		total := NewMoney(totals[cur], cur)
+		limit, ok := p.Thresholds[cur]
+		switch {
+		case !ok:
+			reasons = append(reasons, fmt.Sprintf("no approval threshold for %s", cur))
+		case total.Amount > limit.Amount:
+			reasons = append(reasons, fmt.Sprintf("%s total %s is above %s", cur, total, limit))
+		}
+	}
+	if len(reasons) == 0 {
+		return false, ""
+	}
+	return true, strings.Join(reasons, "; ")
+}
+
+// ApprovalIdentity is a maker or checker as four eyes sees them: the
+// authenticated actor (a user's email or an API key's key:<prefix>, each
+// unique, never a name taken from a request), the keys it was rotated from
+// and whoever created them.
+type ApprovalIdentity struct {
+	Actor    string
+	Lineage  []string // key:<prefix> of every key an API key was rotated from
+	Creators []string // CreatedBy of the actor and of each key in its lineage
+}
+
+// is reports whether name is the actor or one of the keys it was rotated from.
+func (a ApprovalIdentity) is(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	if strings.EqualFold(strings.TrimSpace(a.Actor), name) {
+		return true
+	}
+	return slices.ContainsFunc(a.Lineage, func(l string) bool { return strings.EqualFold(l, name) })
+}
+
+// CanApprove enforces four eyes: the checker must be someone other than the
+// maker and, when an approver list is configured, on it. A key counts as the
+// same person as the keys it was rotated from, and as whoever created it, so
+// neither rotating a key nor minting a second one makes a second person.
+func (p ApprovalPolicy) CanApprove(maker, checker ApprovalIdentity) error {
+	if maker.is(checker.Actor) || slices.ContainsFunc(checker.Lineage, maker.is) ||
+		slices.ContainsFunc(checker.Creators, maker.is) || slices.ContainsFunc(maker.Creators, checker.is) {
+		return ErrSelfApproval
+	}
+	name := strings.ToLower(strings.TrimSpace(checker.Actor))
+	if len(p.Approvers) > 0 && !p.Approvers[name] {
+		return fmt.Errorf("%w: %s", ErrNotApprover, name)
+	}
+	return nil
+}
+
+// ParseApprovalThresholds reads "NGN:5000000.00,KES:500000" into per-currency limits.
+func ParseApprovalThresholds(spec string) (map[string]Money, error) {
+	out := make(map[string]Money)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		cur, amount, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("approval threshold %q: want CURRENCY:AMOUNT", part)
+		}
+		cur = strings.ToUpper(strings.TrimSpace(cur))
+		m, err := ParseMoney(strings.TrimSpace(amount), cur)
+		if err != nil {
+			return nil, fmt.Errorf("approval threshold %q: %w", part, err)
+		}
+		out[cur] = m
+	}
+	return out, nil
+}
+
+// ParseApprovers reads a comma-separated approver list.
+func ParseApprovers(spec string) map[string]bool {
+	out := make(map[string]bool)
+	for _, name := range strings.Split(spec, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			out[name] = true
+		}
+	}
+	return out
+}
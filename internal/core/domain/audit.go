@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Audited actions, named "<target type>.<what happened>"
+const (
+	AuditBatchCreated         = "batch.created"
+	AuditBatchApproved        = "batch.approved"
+	AuditBatchRejected        = "batch.rejected"
+	AuditPayoutReversed       = "payout.reversed"
+	AuditPayoutReissued       = "payout.reissued"
+	AuditReviewApproved       = "review.approved"
+	AuditReviewRejected       = "review.rejected"
+	AuditFundingRecorded      = "funding.recorded"
+	AuditKeyCreated           = "api_key.created"
+	AuditKeyRotated           = "api_key.rotated"
+	AuditKeyRevoked           = "api_key.revoked"
+	AuditUserCreated          = "user.created"
+	AuditUserRoleChanged      = "user.role_changed"
+	AuditUserDisabled         = "user.disabled"
+	AuditUserPasswordReset    = "user.password_reset"
+	AuditTenantCreated        = "tenant.created"
+	AuditTenantWebhookChanged = "tenant.webhook_changed"
+	AuditRateLimitSet         = "rate_limit.set"
+	AuditPayoutQuotaSet       = "payout_quota.set"
+	AuditRecipientErased      = "recipient.erased"
+	AuditRetentionErased      = "retention.erased"
+)
+
+// GenesisHash is the PrevHash of the first entry
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// AuditEntry is one row of the audit log. Hash covers every other field
+// and PrevHash, chaining each entry to the one before.
+type AuditEntry struct {
+	ID         int64 // Sequence from 1, without gaps
+	At         time.Time
+	TenantID   string
+	Actor      string // The person: a user's email, or the name a key caller gave
+	Principal  string // The credential the request used, e.g. key:wk_3f9a01bc
+	Action     string
+	TargetType string
+	TargetID   string
+	RequestID  string
+	IP         string
+	Before     string // JSON; empty when the target did not exist
+	After      string // JSON; empty when the target is gone
+	PrevHash   string
+	Hash       string
+}
+
+// ComputeHash is the hex SHA-256 of the entry's fields and PrevHash, in a
+// fixed JSON form, so no field can be changed without changing the hash.
+func (e AuditEntry) ComputeHash() string {
+	b, _ := json.Marshal([]any{
+		e.PrevHash,
+		e.ID,
+		e.At.UTC().Format(time.RFC3339Nano),
+		e.TenantID,
+		e.Actor,
+		e.Principal,
+		e.Action,
+		e.TargetType,
+		e.TargetID,
+		e.RequestID,
+		e.IP,
+		e.Before,
+		e.After,
+	})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditEvent is what a service reports; the audit log adds who, when and
+// from where.
+type AuditEvent struct {
+	TenantID   string
+	Actor      string // Empty uses the request's principal
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     any // Marshalled to JSON; nil for none
+	After      any
+}
+
+// AuditFilter narrows an audit log query. Empty fields match everything.
+type AuditFilter struct {
+	TenantID   string // Empty only for the operator, across tenants
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	RequestID  string
+	From       time.Time
+	To         time.Time
+	BeforeID   int64 // Entries older than this ID, for paging; 0 starts at the newest
+	Limit      int
+}
+
+// AuditVerification is the outcome of checking the chain.
+type AuditVerification struct {
+	Checked  int64
+	HeadID   int64  // Last entry checked
+	HeadHash string // Keep this elsewhere to detect truncation later
+	BrokenAt int64  // First bad entry, 0 when the chain is intact
+	Problem  string
+}
+
+// AuditSource says who is behind the request a service is handling, for
+// the audit log.
+type AuditSource struct {
+	Principal string
+	RequestID string
+	IP        string
+}
+
+type auditSourceKey struct{}
+
+// WithAuditSource attaches the request's origin to ctx.
+func WithAuditSource(ctx context.Context, src AuditSource) context.Context {
+	return context.WithValue(ctx, auditSourceKey{}, src)
+}
+
+// AuditSourceFrom returns what WithAuditSource attached, if anything.
+func AuditSourceFrom(ctx context.Context) AuditSource {
+	src, _ := ctx.Value(auditSourceKey{}).(AuditSource)
+	return src
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var ErrUnknownInstitution = errors.New("unknown institution code")
+
+// Bank is a payout institution: a bank, or a mobile money operator.
+type Bank struct {
+	Country string // "NG"
+	Code    string // Institution code Afriex expects, e.g. "058"
+	Name    string // "Guaranty Trust Bank"
+	Channel string // BANK_ACCOUNT or MOBILE_MONEY
+}
+
+// BankDirectory holds the institution codes we accept per country. It is safe
+// for concurrent use so it can be refreshed while payouts are validated.
+type BankDirectory struct {
+	mu        sync.RWMutex
+	byCountry map[string]map[string]Bank
+}
+
+func NewBankDirectory(banks []Bank) *BankDirectory {
+	d := &BankDirectory{byCountry: make(map[string]map[string]Bank)}
+	for _, b := range banks {
+		if d.byCountry[b.Country] == nil {
+			d.byCountry[b.Country] = make(map[string]Bank)
+		}
+		d.byCountry[b.Country][b.Code] = b
+	}
+	return d
+}
+
+// Lookup finds an institution by country and code.
+func (d *BankDirectory) Lookup(country, code string) (Bank, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	b, ok := d.byCountry[country][code]
+	return b, ok
+}
+
+// Covers reports whether we hold a list for the country at all. Countries we
+// know nothing about are not validated, so a missing list never blocks a corridor.
+func (d *BankDirectory) Covers(country string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.byCountry[country]) > 0
+}
+
+// List returns the institutions for a country ordered by name. An empty
+// channel returns every channel.
+func (d *BankDirectory) List(country, channel string) []Bank {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	out := make([]Bank, 0, len(d.byCountry[country]))
+	for _, b := range d.byCountry[country] {
+		if channel == "" || b.Channel == channel {
+			out = append(out, b)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Merge upserts institutions for a country and channel, keeping entries we
+// already know about that the source did not return.
+func (d *BankDirectory) Merge(country string, banks []Bank) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.byCountry[country] == nil {
+		d.byCountry[country] = make(map[string]Bank)
+	}
+	for _, b := range banks {
+		d.byCountry[country][b.Code] = b
+	}
+}
+
+// Validate checks the payout's bank_code against the directory.
+func (d *BankDirectory) Validate(p Payout) ValidationErrors {
+	var errs ValidationErrors
+	if p.BankCode == "" || !d.Covers(p.CountryCode) {
+		return errs
+	}
+	b, ok := d.Lookup(p.CountryCode, p.BankCode)
+	switch {
+	case !ok:
+		errs.Add("bank_code", "%v: %s in %s", ErrUnknownInstitution, p.BankCode, p.CountryCode)
+	case b.Channel != "" && p.Channel != "" && b.Channel != p.Channel:
+		errs.Add("bank_code", "%s (%s) does not support %s", b.Code, b.Name, p.Channel)
+	}
+	return errs
+}
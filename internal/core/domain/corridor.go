@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Payout channels supported by Afriex payment methods
+const (
+	ChannelBankAccount = "BANK_ACCOUNT"
+	ChannelMobileMoney = "MOBILE_MONEY"
+)
+
+var ErrUnsupportedCorridor = errors.New("unsupported corridor")
+
+// Corridor describes one destination country we can pay into.
+type Corridor struct {
+	Country        string // ISO 3166-1 alpha-2, e.g. "NG"
+	Name           string // "Nigeria"
+	Currencies     []CorridorCurrency
+	Channels       []CorridorChannel
+	RequiredFields []string // Payout fields required regardless of channel
+}
+
+// CorridorCurrency is a payable currency and the per-payout amount bounds for it.
+type CorridorCurrency struct {
+	Code        string
+	MinAmount   Money
+	MaxAmount   Money // Zero means no upper bound
+	ReviewAbove Money // Payouts above this wait for a reviewer; zero disables
+}
+
+// CorridorChannel is a delivery channel and the extra fields it needs.
+type CorridorChannel struct {
+	Type           string // BANK_ACCOUNT, MOBILE_MONEY
+	RequiredFields []string
+}
+
+// Currency returns the corridor settings for a currency code.
+func (c Corridor) Currency(code string) (CorridorCurrency, bool) {
+	for _, cur := range c.Currencies {
+		if cur.Code == code {
+			return cur, true
+		}
+	}
+	return CorridorCurrency{}, false
+}
+
+// Channel returns the corridor settings for a channel type.
+func (c Corridor) Channel(channel string) (CorridorChannel, bool) {
+	for _, ch := range c.Channels {
+		if ch.Type == channel {
+			return ch, true
+		}
+	}
+	return CorridorChannel{}, false
+}
+
+// Validate checks a payout against the corridor rules. Field names match the
+// public API (recipient_phone, bank_code, ...).
+func (c Corridor) Validate(p Payout) ValidationErrors {
+	var errs ValidationErrors
+
+	cur, ok := c.Currency(p.Currency)
+	if !ok {
+		errs.Add("currency", "%v: %s cannot be paid out in %s", ErrInvalidCurrency, p.Currency, c.Country)
+	} else {
+		if p.Amount < cur.MinAmount.Amount {
+			errs.Add("amount", "must be at least %s %s", cur.MinAmount, cur.Code)
+		}
+		if cur.MaxAmount.Amount > 0 && p.Amount > cur.MaxAmount.Amount {
+			errs.Add("amount", "must not exceed %s %s", cur.MaxAmount, cur.Code)
+		}
+	}
+
+	required := append([]string{}, c.RequiredFields...)
+	if ch, ok := c.Channel(p.Channel); !ok {
+		errs.Add("channel", "%s is not supported in %s", p.Channel, c.Country)
+	} else {
+		required = append(required, ch.RequiredFields...)
+	}
+
+	for _, field := range required {
+		if v, known := payoutField(p, field); known && v == "" {
+			errs.Add(field, "is required for %s", c.Country)
+		}
+	}
+	return errs
+}
+
+// payoutField maps an API field name to the payout value it populates.
+func payoutField(p Payout, field string) (string, bool) {
+	switch field {
+	case "recipient_name":
+		return p.RecipientName, true
+	case "recipient_phone":
+		return p.RecipientPhone, true
+	case "recipient_email":
+		return p.RecipientEmail, true
+	case "bank_code":
+		return p.BankCode, true
+	case "account_number":
+		return p.AccountNumber, true
+	}
+	return "", false
+}
+
+// CorridorRegistry is the read-only set of corridors Waya supports.
+type CorridorRegistry struct {
+	byCountry map[string]Corridor
+}
+
+// NewCorridorRegistry indexes corridors by country, rejecting duplicates and
+// entries that reference unknown currencies or fields.
+func NewCorridorRegistry(corridors []Corridor) (*CorridorRegistry, error) {
+	r := &CorridorRegistry{byCountry: make(map[string]Corridor, len(corridors))}
+	for _, c := range corridors {
+		if c.Country == "" {
+			return nil, errors.New("corridor without country")
+		}
+		if _, dup := r.byCountry[c.Country]; dup {
+			return nil, fmt.Errorf("duplicate corridor %s", c.Country)
+		}
+		if len(c.Currencies) == 0 || len(c.Channels) == 0 {
+			return nil, fmt.Errorf("corridor %s needs at least one currency and one channel", c.Country)
+		}
+		for _, cur := range c.Currencies {
+			if _, ok := MinorUnits(cur.Code); !ok {
+				return nil, fmt.Errorf("corridor %s: %w: %s", c.Country, ErrUnknownCurrency, cur.Code)
+			}
+		}
+		fields := append([]string{}, c.RequiredFields...)
+		for _, ch := range c.Channels {
+			fields = append(fields, ch.RequiredFields...)
+		}
+		for _, f := range fields {
+			if _, known := payoutField(Payout{}, f); !known {
+				return nil, fmt.Errorf("corridor %s: unknown required field %q", c.Country, f)
+			}
+		}
+		r.byCountry[c.Country] = c
+	}
+	return r, nil
+}
+
+// Get returns the corridor for a destination country.
+func (r *CorridorRegistry) Get(country string) (Corridor, bool) {
+	c, ok := r.byCountry[country]
+	return c, ok
+}
+
+// List returns every corridor ordered by country code.
+func (r *CorridorRegistry) List() []Corridor {
+	out := make([]Corridor, 0, len(r.byCountry))
+	for _, c := range r.byCountry {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Country < out[j].Country })
+	return out
+}
+
+// NeedsReview reports whether a payout is large enough to need a human
+// sign-off, returning the threshold it crossed.
+func (r *CorridorRegistry) NeedsReview(p Payout) (Money, bool) {
+	c, ok := r.Get(p.CountryCode)
+	if !ok {
+		return Money{}, false
+	}
+	cur, ok := c.Currency(p.Currency)
+	if !ok || cur.ReviewAbove.Amount <= 0 {
+		return Money{}, false
+	}
+	return cur.ReviewAbove, p.Amount > cur.ReviewAbove.Amount
+}
+
+// Validate checks a payout against the corridor for its country.
+func (r *CorridorRegistry) Validate(p Payout) ValidationErrors {
+	c, ok := r.Get(p.CountryCode)
+	if !ok {
+		var errs ValidationErrors
+		errs.Add("country_code", "%v: %q", ErrUnsupportedCorridor, p.CountryCode)
+		return errs
+	}
+	return c.Validate(p)
+}
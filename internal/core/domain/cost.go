@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// PayoutCost is what Afriex actually charged to deliver a payout.
+type PayoutCost struct {
+	Source        Money  // Debited to fund the conversion
+	Fee           Money  // Charged on top, in the source currency
+	EffectiveRate string // Destination units received per source unit debited
+}
+
+// Total is the full source-currency cost: conversion plus fee.
+func (c PayoutCost) Total() Money {
+	return NewMoney(c.Source.Amount+c.Fee.Amount, c.Source.Currency)
+}
+
+// CostSummary totals the recorded costs of a batch per destination currency.
+type CostSummary struct {
+	DestinationCurrency string
+	SourceCurrency      string
+	Payouts             int   // Payouts with a recorded cost
+	Delivered           Money // What those payouts delivered
+	Source              Money
+	Fees                Money
+	TotalCost           Money  // Source + Fees
+	EffectiveRate       string // Delivered / Source
+	AllInRate           string // Delivered / TotalCost, i.e. net of fees
+}
+
+// SummarizeCosts groups payouts with a recorded cost by destination currency,
+// in the order the currencies first appear.
+func SummarizeCosts(payouts []Payout) []CostSummary {
+	var order []string
+	byCurrency := make(map[string]*CostSummary)
+	for _, p := range payouts {
+		if p.SourceCurrency == "" {
+			continue
+		}
+		s, ok := byCurrency[p.Currency]
+		if !ok {
+			s = &CostSummary{
+				DestinationCurrency: p.Currency,
+				SourceCurrency:      p.SourceCurrency,
+				Delivered:           NewMoney(0, p.Currency),
+				Source:              NewMoney(0, p.SourceCurrency),
+				Fees:                NewMoney(0, p.SourceCurrency),
+			}
+			byCurrency[p.Currency] = s
+			order = append(order, p.Currency)
+		}
+		s.Payouts++
+		s.Delivered.Amount += p.Amount
+		s.Source.Amount += p.SourceAmount
+		s.Fees.Amount += p.FeeAmount
+	}
+
+	out := make([]CostSummary, 0, len(order))
+	for _, cur := range order {
+		s := byCurrency[cur]
+		s.TotalCost = NewMoney(s.Source.Amount+s.Fees.Amount, s.SourceCurrency)
+		s.EffectiveRate = EffectiveRate(s.Delivered, s.Source)
+		s.AllInRate = EffectiveRate(s.Delivered, s.TotalCost)
+		out = append(out, *s)
+	}
+	return out
+}
+
+// EffectiveRate is dest per one unit of source, to six decimal places, or
+// empty when nothing was debited.
+func EffectiveRate(dest, source Money) string {
+	if source.Amount <= 0 {
+		return ""
+	}
+	d := new(big.Rat).SetFrac(big.NewInt(dest.Amount), pow10(minorUnits[dest.Currency]))
+	s := new(big.Rat).SetFrac(big.NewInt(source.Amount), pow10(minorUnits[source.Currency]))
+	return d.Quo(d, s).FloatString(6)
+}
+
+// ParseMoneyRounded reads a decimal amount from a provider, rounding half up
+// to the currency's minor unit. Use ParseMoney for client input, which must
+// never be rounded.
+func ParseMoneyRounded(amount, currency string) (Money, error) {
+	exp, ok := MinorUnits(currency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	r.Mul(r, new(big.Rat).SetInt(pow10(exp)))
+
+	neg := r.Sign() < 0
+	r.Abs(r)
+	// floor(x + 1/2) rounds half up on the magnitude
+	r.Add(r, big.NewRat(1, 2))
+	q := new(big.Int).Quo(r.Num(), r.Denom())
+	if neg {
+		q.Neg(q)
+	}
+	if !q.IsInt64() {
+		return Money{}, fmt.Errorf("%w: %q is too large", ErrInvalidAmount, amount)
+	}
+	return NewMoney(q.Int64(), currency), nil
+}
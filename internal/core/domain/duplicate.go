@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// What to do with likely duplicate payouts
+const (
+	DuplicatesOff   = "off"
+	DuplicatesFlag  = "flag"  // Save and pay, but mark them
+	DuplicatesBlock = "block" // Reject the whole batch
+)
+
+var ErrDuplicatePayouts = errors.New("batch contains likely duplicate payouts")
+
+// DuplicatePolicy configures the duplicate detector.
+type DuplicatePolicy struct {
+	Mode   string        // off, flag, block
+	Window time.Duration // How far back to look for the same payout
+}
+
+// DuplicateMatch links a payout to the earlier one it looks like a copy of.
+type DuplicateMatch struct {
+	PayoutID        string
+	MatchedPayoutID string
+	MatchedBatchID  string // Same as the payout's own batch for repeats inside one file
+	MatchedAt       time.Time
+}
+
+// DuplicateError is returned when the policy blocks a batch.
+type DuplicateError struct {
+	Matches []DuplicateMatch
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%v: %d match(es)", ErrDuplicatePayouts, len(e.Matches))
+}
+
+func (e *DuplicateError) Unwrap() error { return ErrDuplicatePayouts }
+
+// PayoutFingerprint identifies "the same money to the same account":
+// destination account, amount and currency. The time window is applied when
+// looking fingerprints up, not baked into them. Being unkeyed it could be
+// matched against guessed accounts, so storage keys it before writing.
+func PayoutFingerprint(p Payout) string {
+	norm := func(s string) string { return strings.ToUpper(strings.TrimSpace(s)) }
+	key := strings.Join([]string{
+		norm(p.CountryCode), norm(p.Channel), norm(p.BankCode), NormalizeAccountNumber(p.AccountNumber),
+		fmt.Sprint(p.Amount), norm(p.Currency),
+	}, "|")
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16])
+}
+
+// NormalizeAccountNumber drops the spaces and dashes people type into account
+// numbers and upper-cases the rest, so "0123-456 789" and "0123456789" are the
+// same account.
+func NormalizeAccountNumber(account string) string {
+	keep := func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}
+	return strings.ToUpper(strings.Map(keep, strings.TrimSpace(account)))
+}
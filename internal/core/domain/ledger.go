@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Ledger account types. Each tenant has one of each per currency.
+const (
+	AccountFunding   = "FUNDING"   // Money the tenant has sent us (debit-normal)
+	AccountAvailable = "AVAILABLE" // Funds free to pay out
+	AccountReserved  = "RESERVED"  // Held for accepted payouts that haven't finished
+	AccountSettled   = "SETTLED"   // Paid out to recipients
+	AccountFees      = "FEES"      // Charged by Waya on settled payouts
+)
+
+// Journal entry kinds
+const (
+	EntryFunding  = "FUNDING"  // FUNDING -> AVAILABLE
+	EntryReserve  = "RESERVE"  // AVAILABLE -> RESERVED when a payout is accepted
+	EntrySettle   = "SETTLE"   // RESERVED -> SETTLED (and FEES) when it succeeds
+	EntryRelease  = "RELEASE"  // RESERVED -> AVAILABLE when it fails or is rejected
+	EntryReversal = "REVERSAL" // SETTLED (and FEES) -> AVAILABLE when the bank returns a paid payout
+)
+
+var (
+	ErrUnbalancedEntry = errors.New("journal entry does not balance")
+	ErrDuplicateEntry  = errors.New("journal entry already posted")
+)
+
+// NormalBalance is the side an account type grows on.
+func NormalBalance(accountType string) string {
+	if accountType == AccountFunding {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+// LedgerAccountID is the stable ID of a tenant's account, e.g. "default:NGN:AVAILABLE".
+func LedgerAccountID(tenantID, currency, accountType string) string {
+	return tenantID + ":" + strings.ToUpper(currency) + ":" + accountType
+}
+
+// Posting moves an amount into or out of one account. Debits are positive,
+// credits negative, and the postings of an entry sum to zero per currency.
+type Posting struct {
+	AccountID   string
+	AccountType string
+	Amount      int64
+	Currency    string
+}
+
+// JournalEntry is one balanced movement of funds.
+type JournalEntry struct {
+	ID          string
+	TenantID    string
+	Kind        string
+	Reference   string // Payout ID, or the external reference of a top-up
+	Description string
+	Postings    []Posting
+	CreatedAt   time.Time
+}
+
+// NewTransfer builds an entry moving amount from one of the tenant's accounts
+// to another: the source is debited and the destination credited.
+func NewTransfer(id, tenantID, kind, reference, description, from, to string, amount Money) JournalEntry {
+	return JournalEntry{
+		ID:          id,
+		TenantID:    tenantID,
+		Kind:        kind,
+		Reference:   reference,
+		Description: description,
+		Postings: []Posting{
+			{AccountID: LedgerAccountID(tenantID, amount.Currency, from), AccountType: from, Amount: amount.Amount, Currency: amount.Currency},
+			{AccountID: LedgerAccountID(tenantID, amount.Currency, to), AccountType: to, Amount: -amount.Amount, Currency: amount.Currency},
+		},
+	}
+}
+
+// NewSettlement closes a reservation for a successful payout: the fee goes to
+// FEES and the rest to SETTLED. A fee the reservation can't cover (payouts
+// reserved before pricing) is not charged.
+func NewSettlement(id, tenantID, reference, description string, reserved Money, fee int64) JournalEntry {
+	entry := NewTransfer(id, tenantID, EntrySettle, reference, description, AccountReserved, AccountSettled, reserved)
+	if fee <= 0 || fee >= reserved.Amount {
+		return entry
+	}
+	entry.Postings[1].Amount = -(reserved.Amount - fee)
+	entry.Postings = append(entry.Postings, Posting{
+		AccountID:   LedgerAccountID(tenantID, reserved.Currency, AccountFees),
+		AccountType: AccountFees,
+		Amount:      -fee,
+		Currency:    reserved.Currency,
+	})
+	return entry
+}
+
+// NewReversal undoes a settlement when the bank returns the money: every
+// posting of the SETTLE entry is inverted, and what it took from RESERVED
+// goes back to AVAILABLE, fee included.
+func NewReversal(id, tenantID, reference, description string, settled JournalEntry) JournalEntry {
+	entry := JournalEntry{ID: id, TenantID: tenantID, Kind: EntryReversal, Reference: reference, Description: description}
+	for _, p := range settled.Postings {
+		if p.AccountType == AccountReserved {
+			p.AccountType = AccountAvailable
+			p.AccountID = LedgerAccountID(tenantID, p.Currency, AccountAvailable)
+		}
+		p.Amount = -p.Amount
+		entry.Postings = append(entry.Postings, p)
+	}
+	return entry
+}
+
+// Validate checks the entry has at least two non-zero postings that balance
+// in every currency.
+func (e JournalEntry) Validate() error {
+	if len(e.Postings) < 2 {
+		return fmt.Errorf("%w: needs at least two postings", ErrUnbalancedEntry)
+	}
+	sums := make(map[string]int64)
+	for _, p := range e.Postings {
+		if p.Amount == 0 {
+			return fmt.Errorf("%w: zero posting to %s", ErrUnbalancedEntry, p.AccountID)
+		}
+		sums[p.Currency] += p.Amount
+	}
+	for cur, sum := range sums {
+		if sum != 0 {
+			return fmt.Errorf("%w: %s is off by %d", ErrUnbalancedEntry, cur, sum)
+		}
+	}
+	return nil
+}
+
+// AccountBalance is an account with its balance on its normal side, so a
+// funded tenant sees positive FUNDING and AVAILABLE balances.
+type AccountBalance struct {
+	AccountID   string
+	TenantID    string
+	AccountType string
+	Currency    string
+	Balance     int64
+}
+
+// SignedBalance turns a raw posting sum (debits positive) into a balance on
+// the account's normal side.
+func SignedBalance(accountType string, sum int64) int64 {
+	if NormalBalance(accountType) == "CREDIT" {
+		return -sum
+	}
+	return sum
+}
+
+// Balance summarizes a tenant's funds in one currency.
+type Balance struct {
+	Currency  string
+	Funded    Money
+	Available Money
+	Reserved  Money
+	Settled   Money
+	Fees      Money
+}
+
+// Shortfall is how far a currency's available balance falls short of a batch.
+type Shortfall struct {
+	Currency  string
+	Available Money
+	Required  Money
+}
+
+// InsufficientFundsError is returned when a batch needs more than the
+// available balance in one or more currencies.
+type InsufficientFundsError struct {
+	Shortfalls []Shortfall
+}
+
+func (e *InsufficientFundsError) Error() string {
+	parts := make([]string, 0, len(e.Shortfalls))
+	for _, s := range e.Shortfalls {
+		parts = append(parts, fmt.Sprintf("%s %s required, %s available", s.Currency, s.Required, s.Available))
+	}
+	return fmt.Sprintf("%v: %s", ErrInsufficientFunds, strings.Join(parts, "; "))
+}
+
+func (e *InsufficientFundsError) Unwrap() error { return ErrInsufficientFunds }
+
+// StatementLine is one journal entry's effect on a tenant's balances, each
+// change on the account's normal side (a top-up is +Available).
+type StatementLine struct {
+	EntryID     string
+	Kind        string
+	Reference   string
+	Description string
+	CreatedAt   time.Time
+	Available   Money
+	Reserved    Money
+	Settled     Money
+	Fees        Money
+}
+
+// Statement lists a tenant's entries in one currency over [From, To), with
+// the balances either side of it.
+type Statement struct {
+	Currency string
+	From     time.Time
+	To       time.Time
+	Opening  Balance
+	Closing  Balance
+	Lines    []StatementLine
+}
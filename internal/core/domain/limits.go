@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Limit kinds
+const (
+	LimitPerPayout          = "PER_PAYOUT"           // Largest single payout
+	LimitDailyPerRecipient  = "DAILY_PER_RECIPIENT"  // Total to one account per UTC day
+	LimitMonthlyPerCorridor = "MONTHLY_PER_CORRIDOR" // Total into a corridor per UTC month
+)
+
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// CorridorLimits caps how much may be paid into one corridor currency. A zero
+// amount means that limit is not enforced.
+type CorridorLimits struct {
+	Country           string
+	Currency          string
+	PerPayout         Money
+	DailyPerRecipient Money
+	Monthly           Money
+}
+
+// LimitRegistry holds the configured limits, keyed by country and currency.
+type LimitRegistry struct {
+	limits []CorridorLimits
+	index  map[string]CorridorLimits
+}
+
+func NewLimitRegistry(limits []CorridorLimits) (*LimitRegistry, error) {
+	r := &LimitRegistry{index: make(map[string]CorridorLimits, len(limits))}
+	for _, l := range limits {
+		l.Country = strings.ToUpper(strings.TrimSpace(l.Country))
+		l.Currency = strings.ToUpper(strings.TrimSpace(l.Currency))
+		if l.Country == "" || l.Currency == "" {
+			return nil, fmt.Errorf("limits need a country and a currency")
+		}
+		key := l.Country + "/" + l.Currency
+		if _, dup := r.index[key]; dup {
+			return nil, fmt.Errorf("limits for %s defined twice", key)
+		}
+		r.index[key] = l
+		r.limits = append(r.limits, l)
+	}
+	return r, nil
+}
+
+// Get returns the limits for a corridor currency.
+func (r *LimitRegistry) Get(country, currency string) (CorridorLimits, bool) {
+	l, ok := r.index[strings.ToUpper(country)+"/"+strings.ToUpper(currency)]
+	return l, ok
+}
+
+// List returns every configured corridor in file order.
+func (r *LimitRegistry) List() []CorridorLimits {
+	return r.limits
+}
+
+// RecipientAccount identifies the destination account a daily limit applies to.
+type RecipientAccount struct {
+	Country       string
+	BankCode      string
+	AccountNumber string
+}
+
+// Recipient returns the account the payout is sent to.
+func (p Payout) Recipient() RecipientAccount {
+	return RecipientAccount{Country: p.CountryCode, BankCode: p.BankCode, AccountNumber: p.AccountNumber}
+}
+
+func (a RecipientAccount) String() string {
+	return a.Country + "/" + a.BankCode + "/" + a.AccountNumber
+}
+
+// LimitViolation explains why one payout was refused.
+type LimitViolation struct {
+	PayoutID  string
+	Kind      string
+	Scope     string // "NG/058/0123456789" for a recipient, "NG/NGN" for a corridor
+	Limit     Money
+	Used      Money // Already committed in the current window, excluding this payout
+	Requested Money
+}
+
+// LimitError is returned when a batch would break one or more limits.
+type LimitError struct {
+	Violations []LimitViolation
+}
+
+func (e *LimitError) Error() string {
+	v := e.Violations[0]
+	msg := fmt.Sprintf("%v: %s %s: %s requested, %s used of %s", ErrLimitExceeded, v.Kind, v.Scope, v.Requested, v.Used, v.Limit)
+	if len(e.Violations) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(e.Violations)-1)
+	}
+	return msg
+}
+
+func (e *LimitError) Unwrap() error { return ErrLimitExceeded }
+
+// LimitUsage reports how much of a limit is used in its current window.
+type LimitUsage struct {
+	Kind        string
+	Scope       string
+	Limit       Money
+	Used        Money
+	Remaining   Money
+	WindowStart time.Time // Zero for per-payout limits
+	WindowEnd   time.Time
+}
+
+// DayWindow returns the UTC calendar day containing t.
+func DayWindow(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// MonthWindow returns the UTC calendar month containing t.
+func MonthWindow(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
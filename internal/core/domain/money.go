@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrInvalidAmount   = errors.New("invalid amount")
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// minorUnits is the ISO 4217 exponent for every currency we can quote or pay in.
+// A currency missing from this table is rejected rather than guessed.
+var minorUnits = map[string]int{
+	// Zero-decimal currencies
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+
+	// Three-decimal currencies
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+
+	// Two-decimal currencies (Africa)
+	"AOA": 2, "BWP": 2, "CDF": 2, "CVE": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2,
+	"GHS": 2, "GMD": 2, "KES": 2, "LRD": 2, "LSL": 2, "MAD": 2, "MGA": 2, "MRU": 2,
+	"MUR": 2, "MWK": 2, "MZN": 2, "NAD": 2, "NGN": 2, "SCR": 2, "SDG": 2, "SLE": 2,
+	"SOS": 2, "SSP": 2, "STN": 2, "SZL": 2, "TZS": 2, "ZAR": 2, "ZMW": 2, "ZWL": 2,
+
+	// Two-decimal currencies (source / funding side)
+	"AED": 2, "AUD": 2, "BRL": 2, "CAD": 2, "CHF": 2, "CNY": 2, "EUR": 2, "GBP": 2,
+	"HKD": 2, "INR": 2, "MXN": 2, "NZD": 2, "SEK": 2, "SGD": 2, "USD": 2,
+}
+
+// MinorUnits returns the number of decimal places used by an ISO 4217 currency.
+func MinorUnits(currency string) (int, bool) {
+	n, ok := minorUnits[currency]
+	return n, ok
+}
+
+// Money is an exact amount expressed in the minor unit of its currency
+// (kobo for NGN, cents for USD, whole francs for XOF).
+type Money struct {
+	Amount   int64  // Minor units
+	Currency string // ISO 4217 code, e.g. "NGN"
+}
+
+// NewMoney wraps an amount that is already in minor units.
+func NewMoney(minor int64, currency string) Money {
+	return Money{Amount: minor, Currency: currency}
+}
+
+// ParseMoney converts a decimal string such as "19.99" into minor units without
+// going through floating point. Amounts with more fractional digits than the
+// currency allows are rejected instead of being rounded.
+func ParseMoney(amount, currency string) (Money, error) {
+	exp, ok := MinorUnits(currency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+
+	s := strings.TrimSpace(amount)
+	if s == "" {
+		return Money{}, fmt.Errorf("%w: empty", ErrInvalidAmount)
+	}
+
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" || (hasPoint && frac == "") {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	if len(frac) > exp {
+		return Money{}, fmt.Errorf("%w: %q has more than %d decimal places for %s", ErrInvalidAmount, amount, exp, currency)
+	}
+
+	digits := whole + frac + strings.Repeat("0", exp-len(frac))
+	var minor int64
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+		}
+		d := int64(r - '0')
+		if minor > (math.MaxInt64-d)/10 {
+			return Money{}, fmt.Errorf("%w: %q is too large", ErrInvalidAmount, amount)
+		}
+		minor = minor*10 + d
+	}
+
+	return Money{Amount: minor, Currency: currency}, nil
+}
+
+// IsPositive reports whether the amount is strictly greater than zero.
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// String renders the amount as a plain decimal ("19.99", "5000", "1.250"),
+// which is the format Afriex expects for DestinationAmount.
+func (m Money) String() string {
+	exp := minorUnits[m.Currency]
+
+	sign := ""
+	v := m.Amount
+	if v < 0 {
+		sign = "-"
+	}
+	digits := fmt.Sprintf("%d", v)
+	digits = strings.TrimPrefix(digits, "-")
+	if exp == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	cut := len(digits) - exp
+	return sign + digits[:cut] + "." + digits[cut:]
+}
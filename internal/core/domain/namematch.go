@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// honorifics are dropped before comparing names; banks and HR systems disagree
+// on whether to include them.
+var honorifics = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "miss": true, "dr": true, "prof": true,
+	"chief": true, "alhaji": true, "alhaja": true, "engr": true, "sir": true,
+}
+
+// NormalizeName lowercases a name, strips accents, punctuation and honorifics,
+// and returns its tokens.
+func NormalizeName(name string) []string {
+	decomposed := norm.NFD.String(name)
+	var b strings.Builder
+	for _, r := range decomposed {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// drop combining marks: "Adébáyọ̀" -> "Adebayo"
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	var tokens []string
+	for _, t := range strings.Fields(b.String()) {
+		if !honorifics[t] {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens
+}
+
+// NameSimilarity scores two person names between 0 and 1. It tolerates word
+// order ("Okonkwo Emeka"), extra middle names and small typos, which covers
+// the usual differences between payroll files and bank records.
+func NameSimilarity(a, b string) float64 {
+	ta, tb := NormalizeName(a), NormalizeName(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	// Token sort: compare both names with their words in alphabetical order.
+	sa, sb := append([]string{}, ta...), append([]string{}, tb...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	best := EditSimilarity(strings.Join(sa, " "), strings.Join(sb, " "))
+
+	// Token set: every word of the shorter name has a close match in the
+	// longer one ("Emeka Okonkwo" vs "Emeka Chukwudi Okonkwo").
+	short, long := ta, tb
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	var sum float64
+	for _, s := range short {
+		var top float64
+		for _, l := range long {
+			if r := EditSimilarity(s, l); r > top {
+				top = r
+			}
+		}
+		sum += top
+	}
+	if set := sum / float64(len(short)); len(short) >= 2 && set > best {
+		best = set
+	}
+	return best
+}
+
+// EditSimilarity is the normalised Levenshtein similarity of two strings.
+func EditSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -7,10 +7,26 @@ import (
 
 // PayoutStatus Enum
 const (
-	StatusPending    = "PENDING"
-	StatusProcessing = "PROCESSING"
-	StatusSuccess    = "SUCCESS"
-	StatusFailed     = "FAILED"
+	StatusPending          = "PENDING"
+	StatusProcessing       = "PROCESSING"
+	StatusSuccess          = "SUCCESS"
+	StatusFailed           = "FAILED"
+	StatusHeldReview       = "HELD_REVIEW"       // Waiting on a human decision, nothing sent yet
+	StatusHeldCompliance   = "HELD_COMPLIANCE"   // Possible sanctions match, nothing sent
+	StatusRejected         = "REJECTED"          // Turned down by a reviewer or approver
+	StatusAwaitingApproval = "AWAITING_APPROVAL" // Batch waiting for a second person to approve it
+	StatusReversed         = "REVERSED"          // Paid, then returned by the bank; the funds are back
+)
+
+// Batch aggregate statuses
+const (
+	BatchAwaitingApproval   = "AWAITING_APPROVAL"
+	BatchRejected           = "REJECTED"
+	BatchProcessing         = "PROCESSING"
+	BatchAwaitingReview     = "AWAITING_REVIEW"
+	BatchCompleted          = "COMPLETED"
+	BatchPartiallyCompleted = "PARTIALLY_COMPLETED"
+	BatchFailed             = "FAILED"
 )
 
 var (
@@ -20,22 +36,55 @@ var (
 
 // Payout represents a single money transfer
 type Payout struct {
-	ID           string
-	BatchID      string
-	ReferenceID  string
-	
-	RecipientName  string // "John Doe"
-	RecipientPhone string // "+234..."
-	RecipientEmail string // "john@example.com"
-	RecipientTag   string // Optional: If sending to Afriex Wallet directly
-	
-	CountryCode    string // "NG", "GH", "KE"
-	BankCode       string // "033" (UBA)
-	AccountNumber  string // "2039..."
-	BankName       string // "United Bank for Africa"
+	ID          string
+	TenantID    string
+	BatchID     string
+	ReferenceID string
+
+	RecipientName      string // "John Doe"
+	RecipientPhone     string // As sent by the client: "0801...", "+234 801..."
+	RecipientPhoneE164 string // Normalized: "+2348012345678"
+	RecipientEmail     string // "john@example.com"
+	RecipientTag       string // Optional: If sending to Afriex Wallet directly
+
+	CountryCode   string // "NG", "GH", "KE"
+	BankCode      string // "033" (UBA)
+	AccountNumber string // "2039..."
+	BankName      string // "United Bank for Africa"
+	Channel       string // BANK_ACCOUNT, MOBILE_MONEY
+
+	// Name enquiry result (empty when the check is disabled)
+	ResolvedAccountName string  // Holder name returned by the bank
+	NameMatchScore      float64 // 0..1 similarity with RecipientName
+
+	ScreeningListVersion string // Sanctions list version the recipient was screened against
+
+	Fingerprint string // Account + amount + currency hash used for duplicate detection
+	DuplicateOf string // Earlier payout this one looks like a copy of
+
+	ServiceFee int64 // Waya's fee in Currency minor units, priced at submission
+
+	// What Afriex actually charged, recorded when the payout succeeds
+	TransactionID  string // Afriex transaction ID
+	SourceAmount   int64  // Minor units of SourceCurrency debited for the conversion
+	SourceCurrency string // "USD"
+	FeeAmount      int64  // Minor units of SourceCurrency charged on top
+	EffectiveRate  string // Currency units delivered per SourceCurrency unit
+
+	// Set when a SUCCESS payout comes back
+	ReversalSource string // AFRIEX_WEBHOOK, RECONCILIATION or ADMIN
+	ReversalReason string
+	ReversedBy     string
+	ReversedAt     time.Time
+	ReissueOf      string // Reversed payout this one re-sends
+	ReissuedAs     string // Payout that re-sent this one after it was reversed
+
+	// Set when the recipient details were erased, on request or by the
+	// retention job; the recipient fields are then empty
+	PIIErasedAt time.Time
 	// -----------------------------
 
-	Amount       int64  // Cents
+	Amount       int64  // Minor units of Currency (kobo, cents...)
 	Currency     string // "NGN"
 	Status       string
 	ErrorMessage string
@@ -43,11 +92,81 @@ type Payout struct {
 	UpdatedAt    time.Time
 }
 
+// Money returns the payout amount as an exact Money value.
+func (p Payout) Money() Money {
+	return NewMoney(p.Amount, p.Currency)
+}
+
+// IsFinished reports whether nothing more will happen to the payout's
+// recipient details: it was paid, failed, rejected or reversed.
+func (p Payout) IsFinished() bool {
+	switch p.Status {
+	case StatusSuccess, StatusFailed, StatusRejected, StatusReversed:
+		return true
+	}
+	return false
+}
+
+// IsHeld reports whether the payout is parked waiting on a review.
+func (p Payout) IsHeld() bool {
+	return p.Status == StatusHeldReview || p.Status == StatusHeldCompliance
+}
+
 // Batch represents a bulk transfer request
 type Batch struct {
-	ID          string
-	TotalAmount int64
-	TotalCount  int
-	Status      string
-	Payouts     []Payout
-}
\ No newline at end of file
+	ID           string
+	TenantID     string
+	Reference    string
+	TotalAmount  int64
+	TotalCount   int
+	Status       string
+	StatusCounts map[string]int // Payouts per status, e.g. {"SUCCESS": 48, "HELD_REVIEW": 2}
+	Payouts      []Payout
+	APIKeyID     string // Key that submitted it, counted against its quota
+
+	// Maker-checker
+	SubmittedBy    string
+	ApprovalStatus string       // NOT_REQUIRED, PENDING, APPROVED, REJECTED
+	ApprovalReason string       // Why the policy asked for approval
+	Approvals      []BatchEvent // Audit trail of who submitted, approved or rejected it
+	CreatedAt      time.Time
+
+	Duplicates []DuplicateMatch // Payouts that look like repeats of recent ones
+	Costs      []CostSummary    // Real source cost per destination currency
+}
+
+// AggregateStatus derives a batch status from its payouts. Anything still in
+// flight wins, then anything waiting on a reviewer, then the final outcome.
+func AggregateStatus(payouts []Payout) (string, map[string]int) {
+	counts := make(map[string]int)
+	for _, p := range payouts {
+		counts[p.Status]++
+	}
+
+	done := counts[StatusSuccess] + counts[StatusFailed] + counts[StatusRejected] + counts[StatusReversed]
+	held := counts[StatusHeldReview] + counts[StatusHeldCompliance]
+	switch {
+	case counts[StatusAwaitingApproval] > 0:
+		return BatchAwaitingApproval, counts
+	case counts[StatusRejected] == len(payouts):
+		return BatchRejected, counts
+	case done+held < len(payouts):
+		return BatchProcessing, counts
+	case held > 0:
+		return BatchAwaitingReview, counts
+	case counts[StatusSuccess] == len(payouts):
+		return BatchCompleted, counts
+	case counts[StatusSuccess] == 0:
+		return BatchFailed, counts
+	default:
+		return BatchPartiallyCompleted, counts
+	}
+}
+
+// RecipientLookup finds payouts by where they were sent. Stored recipient
+// details are encrypted, so matching is exact: the account number as given
+// and the phone in E.164. Either may be empty.
+type RecipientLookup struct {
+	AccountNumber string
+	Phone         string
+}
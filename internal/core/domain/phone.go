@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+// numberingPlan describes how phone numbers are written in one country.
+type numberingPlan struct {
+	CallingCode string   // Without "+", e.g. "234"
+	Trunk       string   // National prefix dropped in E.164 ("0"), empty if none
+	Lengths     []int    // Allowed national significant number lengths
+	Prefixes    []string // Allowed leading digits of the national number
+}
+
+// numberingPlans covers the markets we pay into. Prefixes list mobile ranges
+// plus fixed lines where payroll recipients commonly use them.
+var numberingPlans = map[string]numberingPlan{
+	"NG": {CallingCode: "234", Trunk: "0", Lengths: []int{10}, Prefixes: []string{"70", "71", "80", "81", "90", "91"}},
+	"GH": {CallingCode: "233", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"2", "3", "5"}},
+	"KE": {CallingCode: "254", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"1", "7"}},
+	"UG": {CallingCode: "256", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"3", "4", "7"}},
+	"TZ": {CallingCode: "255", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"2", "6", "7"}},
+	"RW": {CallingCode: "250", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"2", "7"}},
+	"CM": {CallingCode: "237", Lengths: []int{9}, Prefixes: []string{"2", "6"}},
+	"SN": {CallingCode: "221", Lengths: []int{9}, Prefixes: []string{"3", "7"}},
+	"CI": {CallingCode: "225", Lengths: []int{10}, Prefixes: []string{"01", "05", "07", "21", "25", "27"}},
+	"ZA": {CallingCode: "27", Trunk: "0", Lengths: []int{9}, Prefixes: []string{"1", "2", "3", "4", "5", "6", "7", "8"}},
+	"EG": {CallingCode: "20", Trunk: "0", Lengths: []int{9, 10}, Prefixes: []string{"1", "2", "3"}},
+}
+
+// HasNumberingPlan reports whether phones for the country can be normalized.
+func HasNumberingPlan(country string) bool {
+	_, ok := numberingPlans[country]
+	return ok
+}
+
+// NormalizePhone converts a phone number as typed ("0801 234 5678",
+// "2348012345678", "+234 (801) 234-5678") into E.164 ("+2348012345678") using
+// the recipient country's numbering plan.
+func NormalizePhone(raw, country string) (string, error) {
+	plan, ok := numberingPlans[country]
+	if !ok {
+		return "", fmt.Errorf("%w: no numbering plan for %q", ErrInvalidPhone, country)
+	}
+
+	s := strings.TrimSpace(raw)
+	international := strings.HasPrefix(s, "+")
+	var digits strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' || r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+			// formatting
+		default:
+			return "", fmt.Errorf("%w: unexpected character %q", ErrInvalidPhone, r)
+		}
+	}
+	d := digits.String()
+	if strings.HasPrefix(d, "00") {
+		d, international = d[2:], true
+	}
+
+	var national string
+	switch {
+	case international:
+		if !strings.HasPrefix(d, plan.CallingCode) {
+			return "", fmt.Errorf("%w: %q is not a %s number", ErrInvalidPhone, raw, country)
+		}
+		national = d[len(plan.CallingCode):]
+	case strings.HasPrefix(d, plan.CallingCode) && plan.validLength(len(d)-len(plan.CallingCode)):
+		national = d[len(plan.CallingCode):]
+	case plan.Trunk != "" && strings.HasPrefix(d, plan.Trunk) && plan.validLength(len(d)-len(plan.Trunk)):
+		national = d[len(plan.Trunk):]
+	default:
+		national = d
+	}
+	// "+234 0801..." is a common mix of both styles
+	if plan.Trunk != "" && !plan.validLength(len(national)) && strings.HasPrefix(national, plan.Trunk) {
+		national = national[len(plan.Trunk):]
+	}
+
+	if !plan.validLength(len(national)) {
+		return "", fmt.Errorf("%w: %q has the wrong length for %s", ErrInvalidPhone, raw, country)
+	}
+	if !plan.validPrefix(national) {
+		return "", fmt.Errorf("%w: %q is not a valid %s number range", ErrInvalidPhone, raw, country)
+	}
+	return "+" + plan.CallingCode + national, nil
+}
+
+func (p numberingPlan) validLength(n int) bool {
+	for _, l := range p.Lengths {
+		if l == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (p numberingPlan) validPrefix(national string) bool {
+	for _, pre := range p.Prefixes {
+		if strings.HasPrefix(national, pre) {
+			return true
+		}
+	}
+	return false
+}
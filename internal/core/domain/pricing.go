@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// FeeAnyCurrency in a fee rule matches every currency. Such a rule can only
+// charge a percentage, since a flat amount needs a currency.
+const FeeAnyCurrency = "*"
+
+var ErrInvalidFeeRule = errors.New("invalid fee rule")
+
+// FeeRule is what Waya charges per payout in one corridor currency: a flat
+// amount plus a percentage of the payout, kept within Min and Max. Fees are
+// charged in the payout's own currency.
+type FeeRule struct {
+	Country  string // Empty matches every corridor paying out in Currency
+	Currency string // Or FeeAnyCurrency
+	Flat     Money
+	Percent  string // Of the payout amount, e.g. "0.5" for 0.5%
+	Min      Money  // Zero means no minimum
+	Max      Money  // Zero means no maximum
+}
+
+// Fee prices a payout of amount. The percentage is rounded half up to the
+// currency's minor unit.
+func (r FeeRule) Fee(amount Money) Money {
+	fee := r.Flat.Amount
+	if pct, ok := new(big.Rat).SetString(r.Percent); ok && pct.Sign() > 0 {
+		v := new(big.Rat).SetInt64(amount.Amount)
+		v.Mul(v, pct)
+		v.Quo(v, big.NewRat(100, 1))
+		v.Add(v, big.NewRat(1, 2))
+		fee += new(big.Int).Quo(v.Num(), v.Denom()).Int64()
+	}
+	if r.Min.Amount > 0 && fee < r.Min.Amount {
+		fee = r.Min.Amount
+	}
+	if r.Max.Amount > 0 && fee > r.Max.Amount {
+		fee = r.Max.Amount
+	}
+	return NewMoney(fee, amount.Currency)
+}
+
+func (r FeeRule) validate() error {
+	if r.Currency == FeeAnyCurrency {
+		if r.Country != "" || r.Flat.Amount != 0 || r.Min.Amount != 0 || r.Max.Amount != 0 {
+			return fmt.Errorf("%w: a %q rule can only set percent", ErrInvalidFeeRule, FeeAnyCurrency)
+		}
+	} else if _, ok := MinorUnits(r.Currency); !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownCurrency, r.Currency)
+	}
+	if r.Percent != "" {
+		pct, ok := new(big.Rat).SetString(r.Percent)
+		if !ok || pct.Sign() < 0 || pct.Cmp(big.NewRat(100, 1)) > 0 {
+			return fmt.Errorf("%w: percent %q must be between 0 and 100", ErrInvalidFeeRule, r.Percent)
+		}
+	}
+	if r.Flat.Amount < 0 || r.Min.Amount < 0 || r.Max.Amount < 0 {
+		return fmt.Errorf("%w: amounts must not be negative", ErrInvalidFeeRule)
+	}
+	if r.Max.Amount > 0 && r.Min.Amount > r.Max.Amount {
+		return fmt.Errorf("%w: min is above max", ErrInvalidFeeRule)
+	}
+	return nil
+}
+
+// FeeSchedule is one tenant's price list.
+type FeeSchedule struct {
+	TenantID string
+	Rules    []FeeRule
+}
+
+// FeeSchedules holds every tenant's schedule. Tenants without their own pay
+// the DefaultTenant's prices.
+type FeeSchedules struct {
+	byTenant map[string]FeeSchedule
+	order    []string
+}
+
+func NewFeeSchedules(schedules []FeeSchedule) (*FeeSchedules, error) {
+	f := &FeeSchedules{byTenant: make(map[string]FeeSchedule, len(schedules))}
+	for _, s := range schedules {
+		if s.TenantID == "" {
+			return nil, fmt.Errorf("%w: schedule without a tenant", ErrInvalidFeeRule)
+		}
+		if _, dup := f.byTenant[s.TenantID]; dup {
+			return nil, fmt.Errorf("%w: duplicate schedule for tenant %s", ErrInvalidFeeRule, s.TenantID)
+		}
+		seen := make(map[string]bool, len(s.Rules))
+		for i, r := range s.Rules {
+			r.Country = strings.ToUpper(r.Country)
+			r.Currency = strings.ToUpper(r.Currency)
+			if err := r.validate(); err != nil {
+				return nil, fmt.Errorf("tenant %s: %w", s.TenantID, err)
+			}
+			key := r.Country + "/" + r.Currency
+			if seen[key] {
+				return nil, fmt.Errorf("%w: tenant %s has two rules for %s", ErrInvalidFeeRule, s.TenantID, key)
+			}
+			seen[key] = true
+			s.Rules[i] = r
+		}
+		f.byTenant[s.TenantID] = s
+		f.order = append(f.order, s.TenantID)
+	}
+	return f, nil
+}
+
+// Schedule returns the tenant's schedule, or the default one.
+func (f *FeeSchedules) Schedule(tenantID string) FeeSchedule {
+	if s, ok := f.byTenant[tenantID]; ok {
+		return s
+	}
+	s := f.byTenant[DefaultTenant]
+	s.TenantID = tenantID
+	return s
+}
+
+// Rule finds the most specific rule for a payout: country and currency, then
+// currency alone, then the catch-all.
+func (f *FeeSchedules) Rule(tenantID, country, currency string) (FeeRule, bool) {
+	var byCurrency, any *FeeRule
+	rules := f.Schedule(tenantID).Rules
+	for i := range rules {
+		r := &rules[i]
+		switch {
+		case r.Currency == currency && r.Country == country:
+			return *r, true
+		case r.Currency == currency && r.Country == "":
+			byCurrency = r
+		case r.Currency == FeeAnyCurrency:
+			any = r
+		}
+	}
+	if byCurrency != nil {
+		return *byCurrency, true
+	}
+	if any != nil {
+		return *any, true
+	}
+	return FeeRule{}, false
+}
+
+// List returns every schedule in file order.
+func (f *FeeSchedules) List() []FeeSchedule {
+	out := make([]FeeSchedule, 0, len(f.order))
+	for _, id := range f.order {
+		out = append(out, f.byTenant[id])
+	}
+	return out
+}
+
+// FeeCharge is Waya's fee on one settled payout, as posted to the ledger.
+type FeeCharge struct {
+	PayoutID  string
+	BatchID   string
+	Country   string
+	Amount    Money // The payout; negative, like Fee, when a reversal refunds it
+	Fee       Money
+	ChargedAt time.Time
+}
+
+// InvoiceLine totals a month's charges for one corridor currency.
+type InvoiceLine struct {
+	Country  string
+	Currency string
+	Payouts  int
+	Volume   Money
+	Fees     Money
+}
+
+// Invoice bills a tenant for the fees charged in one calendar month (UTC).
+type Invoice struct {
+	Number      string
+	TenantID    string
+	PeriodStart time.Time
+	PeriodEnd   time.Time // Exclusive
+	IssuedAt    time.Time
+	Lines       []InvoiceLine
+	Totals      []Money // Fees due per currency
+	Charges     []FeeCharge
+}
+
+// NewInvoice groups the month's charges by corridor currency, in the order
+// they were first charged.
+func NewInvoice(tenantID string, month time.Time, charges []FeeCharge, issuedAt time.Time) Invoice {
+	start, end := MonthWindow(month)
+	inv := Invoice{
+		Number:      fmt.Sprintf("WAYA-%s-%s", strings.ToUpper(tenantID), start.Format("200601")),
+		TenantID:    tenantID,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		IssuedAt:    issuedAt,
+		Charges:     charges,
+	}
+
+	lines := make(map[string]int)
+	totals := make(map[string]int)
+	for _, c := range charges {
+		key := c.Country + "/" + c.Fee.Currency
+		i, ok := lines[key]
+		if !ok {
+			i = len(inv.Lines)
+			lines[key] = i
+			inv.Lines = append(inv.Lines, InvoiceLine{
+				Country:  c.Country,
+				Currency: c.Fee.Currency,
+				Volume:   NewMoney(0, c.Amount.Currency),
+				Fees:     NewMoney(0, c.Fee.Currency),
+			})
+		}
+		if c.Fee.Amount < 0 {
+			inv.Lines[i].Payouts-- // Refund of a charge for a reversed payout
+		} else {
+			inv.Lines[i].Payouts++
+		}
+		inv.Lines[i].Volume.Amount += c.Amount.Amount
+		inv.Lines[i].Fees.Amount += c.Fee.Amount
+
+		j, ok := totals[c.Fee.Currency]
+		if !ok {
+			j = len(inv.Totals)
+			totals[c.Fee.Currency] = j
+			inv.Totals = append(inv.Totals, NewMoney(0, c.Fee.Currency))
+		}
+		inv.Totals[j].Amount += c.Fee.Amount
+	}
+	return inv
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "slices"
+
+// Permissions a route can require. A user's role grants a set of them; an
+// API key's scopes map onto them: payouts:write submits and payouts:approve
+// approves, so the maker's key cannot also be the checker's.
+const (
+	PermRead    = "read"    // Batches, payouts, reviews, balances and invoices
+	PermSubmit  = "submit"  // New batches and re-issues
+	PermApprove = "approve" // Batch approvals and rejections, review decisions
+	PermAdmin   = "admin"   // Users and API keys, and with the operator tenant, platform routes
+)
+
+var rolePermissions = map[string][]string{
+	RoleViewer:   {PermRead},
+	RoleOperator: {PermRead, PermSubmit},
+	RoleApprover: {PermRead, PermApprove},
+	RoleAdmin:    {PermRead, PermSubmit, PermApprove, PermAdmin},
+}
+
+// Principal is who a request acts as: a tenant, through one of its keys or
+// one of its signed-in users.
+type Principal struct {
+	Tenant Tenant
+	Key    APIKey // Zero when User is set
+	User   *User
+}
+
+// Allows reports whether the principal holds the permission.
+func (p Principal) Allows(perm string) bool {
+	if p.User != nil {
+		return slices.Contains(rolePermissions[p.User.Role], perm)
+	}
+	switch perm {
+	case PermRead:
+		return p.Key.Allows(ScopePayoutsRead)
+	case PermSubmit:
+		return p.Key.Allows(ScopePayoutsWrite)
+	case PermApprove:
+		return p.Key.Allows(ScopePayoutsApprove)
+	default:
+		return p.Key.Allows(ScopeAdmin)
+	}
+}
+
+// Actor names the principal in audit trails: a user's email, or the key's
+// prefix.
+func (p Principal) Actor() string {
+	switch {
+	case p.User != nil:
+		return p.User.Email
+	case p.Key.Prefix != "":
+		return "key:" + p.Key.Prefix
+	default:
+		return "key:" + p.Key.Name
+	}
+}
+
+// Subject identifies the caller for rate limiting: the key's ID, or the
+// user's prefixed with "user:".
+func (p Principal) Subject() string {
+	if p.User != nil {
+		return "user:" + p.User.ID
+	}
+	return p.Key.ID
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Quote is the indicative cost of delivering an amount into a corridor.
+type Quote struct {
+	Country     string
+	Destination Money  // What the recipient gets
+	Source      Money  // What we debit, rounded up to the source minor unit
+	Rate        string // Destination units per one source unit, as returned by Afriex
+	Fee         Money  // Waya's fee, in the destination currency
+}
+
+// SourceAmountFor works out how much of sourceCurrency is needed to deliver
+// dest at the given rate. The division is done on exact rationals and the
+// result is rounded up so we never under-fund a payout.
+func SourceAmountFor(dest Money, rate string, sourceCurrency string) (Money, error) {
+	srcExp, ok := MinorUnits(sourceCurrency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, sourceCurrency)
+	}
+	destExp, ok := MinorUnits(dest.Currency)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, dest.Currency)
+	}
+
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Sign() <= 0 {
+		return Money{}, fmt.Errorf("invalid rate %q", rate)
+	}
+
+	// minorSrc = dest.Amount / 10^destExp / rate * 10^srcExp
+	v := new(big.Rat).SetFrac(big.NewInt(dest.Amount), pow10(destExp))
+	v.Quo(v, r)
+	v.Mul(v, new(big.Rat).SetInt(pow10(srcExp)))
+
+	q, m := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if !q.IsInt64() {
+		return Money{}, fmt.Errorf("%w: source amount overflows", ErrInvalidAmount)
+	}
+	return NewMoney(q.Int64(), sourceCurrency), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
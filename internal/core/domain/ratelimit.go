@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// RouteAny matches every route in a RateLimitRule
+const RouteAny = "*"
+
+// Quota scopes
+const (
+	QuotaScopeTenant = "tenant"
+	QuotaScopeAPIKey = "api_key"
+)
+
+var ErrQuotaExceeded = errors.New("daily payout quota exceeded")
+
+// RateLimit is a token bucket: Burst requests at once, refilled at PerMinute.
+type RateLimit struct {
+	PerMinute int
+	Burst     int
+}
+
+func (l RateLimit) Validate() error {
+	var errs ValidationErrors
+	if l.PerMinute <= 0 {
+		errs.Add("per_minute", "must be greater than zero")
+	}
+	if l.Burst <= 0 {
+		errs.Add("burst", "must be greater than zero")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// RateLimitRule limits one tenant's requests. An empty KeyID applies the
+// limit to each of the tenant's keys, and RouteAny to every route without a
+// rule of its own. Built-in defaults have no TenantID.
+type RateLimitRule struct {
+	TenantID string
+	KeyID    string
+	Route    string // Method and route pattern, e.g. "POST /api/v1/payouts"
+	RateLimit
+}
+
+// rank orders rules by how closely they fit a request, or -1 when the rule
+// does not apply. A route's own rule beats a RouteAny one; for the same
+// route a key's rule beats the tenant's, which beats the defaults.
+func (r RateLimitRule) rank(keyID, route string) int {
+	if r.KeyID != "" && r.KeyID != keyID {
+		return -1
+	}
+	if r.Route != RouteAny && r.Route != route {
+		return -1
+	}
+	rank := 0
+	if r.Route != RouteAny {
+		rank += 4
+	}
+	if r.KeyID != "" {
+		rank += 2
+	}
+	if r.TenantID != "" {
+		rank++
+	}
+	return rank
+}
+
+// MatchRateLimit picks the rule that governs a key's requests to a route.
+func MatchRateLimit(rules []RateLimitRule, keyID, route string) (RateLimitRule, bool) {
+	best, bestRank := RateLimitRule{}, -1
+	for _, r := range rules {
+		if rank := r.rank(keyID, route); rank > bestRank {
+			best, bestRank = r, rank
+		}
+	}
+	return best, bestRank >= 0
+}
+
+// RateDecision is the outcome of taking a token.
+type RateDecision struct {
+	Allowed    bool
+	Limit      RateLimit
+	Remaining  int           // Whole tokens left after this request
+	RetryAfter time.Duration // Until the next token, when refused
+	Reset      time.Duration // Until the bucket is full again
+}
+
+// TokenBucket meters one key's requests to one route. It is not safe for
+// concurrent use.
+type TokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket starts full.
+func NewTokenBucket(limit RateLimit, now time.Time) *TokenBucket {
+	return &TokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+}
+
+// SetLimit applies a changed limit, keeping the tokens already earned up to
+// the new burst.
+func (b *TokenBucket) SetLimit(limit RateLimit) {
+	b.limit = limit
+	b.tokens = math.Min(b.tokens, float64(limit.Burst))
+}
+
+// Take spends a token if one is left.
+func (b *TokenBucket) Take(now time.Time) RateDecision {
+	b.refill(now)
+	d := RateDecision{Limit: b.limit}
+	if b.tokens >= 1 {
+		b.tokens--
+		d.Allowed = true
+	} else {
+		d.RetryAfter = b.timeFor(1 - b.tokens)
+	}
+	d.Remaining = int(b.tokens)
+	d.Reset = b.timeFor(float64(b.limit.Burst) - b.tokens)
+	return d
+}
+
+// Full reports whether the bucket has refilled, so forgetting it changes
+// nothing.
+func (b *TokenBucket) Full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= float64(b.limit.Burst)
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed.Minutes()*float64(b.limit.PerMinute))
+		b.last = now
+	}
+}
+
+func (b *TokenBucket) timeFor(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / float64(b.limit.PerMinute) * float64(time.Minute)))
+}
+
+// PayoutQuota caps the payout items submitted per UTC day. An empty KeyID
+// caps the whole tenant.
+type PayoutQuota struct {
+	TenantID   string
+	KeyID      string
+	DailyItems int
+}
+
+// QuotaError is returned when a batch would take the tenant or the key that
+// submits it over its daily quota.
+type QuotaError struct {
+	Scope     string // QuotaScopeTenant or QuotaScopeAPIKey
+	Quota     int
+	Used      int
+	Requested int
+	ResetAt   time.Time // When the next day's quota starts
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("%v: %s quota is %d items, %d used today, %d requested", ErrQuotaExceeded, e.Scope, e.Quota, e.Used, e.Requested)
+}
+
+func (e *QuotaError) Unwrap() error { return ErrQuotaExceeded }
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Reconciliation results
+const (
+	ReconMatched        = "MATCHED"
+	ReconMissingOurs    = "MISSING_OURS"    // Afriex settled it, we have no successful payout for it
+	ReconMissingTheirs  = "MISSING_THEIRS"  // Our SUCCESS payout has no settled Afriex transaction
+	ReconAmountMismatch = "AMOUNT_MISMATCH" // Both sides settled it, for different amounts
+	ReconReversed       = "REVERSED"        // Afriex reports our SUCCESS payout came back from the bank
+)
+
+var (
+	ErrReconciliationNotFound = errors.New("reconciliation run not found")
+	ErrInvalidExport          = errors.New("invalid Afriex export")
+)
+
+// ProviderRecord is one transaction from an Afriex export.
+type ProviderRecord struct {
+	Line          int // Row or array index in the file, for error messages
+	TransactionID string
+	Reference     string // The payout ID we sent as meta.reference, or the client's reference
+	Status        string
+	Amount        Money // Destination amount
+	CreatedAt     time.Time
+}
+
+// Settled reports whether Afriex says the money was delivered.
+func (r ProviderRecord) Settled() bool {
+	switch strings.ToUpper(r.Status) {
+	case "SUCCESS", "SUCCESSFUL", "COMPLETED", "SETTLED", "PAID":
+		return true
+	}
+	return false
+}
+
+// ReconciliationItem is one exception found by a run.
+type ReconciliationItem struct {
+	ID            string
+	RunID         string
+	Result        string // MISSING_OURS, MISSING_THEIRS, AMOUNT_MISMATCH, REVERSED
+	PayoutID      string
+	TransactionID string
+	Reference     string
+	Ours          Money // Zero when we have no payout
+	Theirs        Money // Zero when Afriex has no settled record
+	Detail        string
+}
+
+// ReconciliationRun is one import of an Afriex export, with its totals and
+// the exceptions that need someone to look at them.
+type ReconciliationRun struct {
+	ID               string
+	Source           string // File name of the export
+	From             time.Time
+	To               time.Time // Exclusive
+	Records          int
+	Matched          int
+	MissingOurs      int
+	MissingTheirs    int
+	AmountMismatches int
+	Reversed         int // SUCCESS payouts the export shows as returned; they are reversed by the import
+	CreatedAt        time.Time
+	Exceptions       []ReconciliationItem
+}
+
+// Reconcile matches an export against our payouts. Records are matched by
+// transaction ID, then by payout ID or (if unambiguous) client reference.
+// Every SUCCESS payout created in [from, to) that no settled record accounts
+// for is missing on their side; payouts outside the window are only used to
+// match records. A reversal record for a SUCCESS payout is reported as
+// REVERSED, and one for a payout already REVERSED counts as matched.
+func Reconcile(records []ProviderRecord, payouts []Payout, from, to time.Time) (matched int, exceptions []ReconciliationItem) {
+	byTx := make(map[string]int)
+	byID := make(map[string]int)
+	byRef := make(map[string]int)
+	for i, p := range payouts {
+		if p.TransactionID != "" {
+			byTx[p.TransactionID] = i
+		}
+		byID[p.ID] = i
+		if p.ReferenceID != "" {
+			if _, dup := byRef[p.ReferenceID]; dup {
+				byRef[p.ReferenceID] = -1
+			} else {
+				byRef[p.ReferenceID] = i
+			}
+		}
+	}
+	find := func(r ProviderRecord) (int, bool) {
+		if i, ok := byTx[r.TransactionID]; ok && r.TransactionID != "" {
+			return i, true
+		}
+		if i, ok := byID[r.Reference]; ok {
+			return i, true
+		}
+		if i, ok := byRef[r.Reference]; ok && i >= 0 {
+			return i, true
+		}
+		return 0, false
+	}
+
+	// An export may list a transaction's settlement and its later reversal;
+	// the reversal is what counts
+	returned := make(map[string]bool)
+	for _, r := range records {
+		if IsProviderReversal(r.Status) && r.TransactionID != "" {
+			returned[r.TransactionID] = true
+		}
+	}
+
+	accounted := make(map[int]string) // payout index -> transaction that settled it
+	reported := make(map[int]string)  // payout index -> status of an unsettled record for it
+	for _, r := range records {
+		item := ReconciliationItem{TransactionID: r.TransactionID, Reference: r.Reference, Theirs: r.Amount}
+		if r.Settled() && returned[r.TransactionID] {
+			continue
+		}
+		i, ok := find(r)
+		if !ok {
+			if r.Settled() {
+				item.Result = ReconMissingOurs
+				item.Detail = "no payout with this transaction ID or reference"
+				exceptions = append(exceptions, item)
+			}
+			continue
+		}
+
+		p := payouts[i]
+		item.PayoutID, item.Ours = p.ID, p.Money()
+		prev, settled := accounted[i]
+		switch {
+		case IsProviderReversal(r.Status) && p.Status == StatusReversed:
+			accounted[i] = r.TransactionID
+			matched++
+		case IsProviderReversal(r.Status) && p.Status == StatusSuccess:
+			accounted[i] = r.TransactionID
+			item.Result = ReconReversed
+			item.Detail = "Afriex reports " + strings.ToUpper(r.Status)
+			exceptions = append(exceptions, item)
+		case !r.Settled() && p.Status != StatusSuccess:
+			matched++ // Neither side delivered it
+		case !r.Settled():
+			// Missing-theirs unless another record settles it; see below
+			if reported[i] == "" {
+				reported[i] = strings.ToUpper(r.Status)
+			}
+		case p.Status != StatusSuccess:
+			item.Result = ReconMissingOurs
+			item.Detail = fmt.Sprintf("Afriex settled it but the payout is %s here", p.Status)
+			exceptions = append(exceptions, item)
+		case settled:
+			item.Result = ReconMissingOurs
+			item.Detail = "payout already settled by transaction " + prev
+			exceptions = append(exceptions, item)
+		default:
+			accounted[i] = r.TransactionID
+			if r.Amount != p.Money() {
+				item.Result = ReconAmountMismatch
+				item.Detail = fmt.Sprintf("we paid %s %s, Afriex settled %s %s", p.Money(), p.Currency, r.Amount, r.Amount.Currency)
+				exceptions = append(exceptions, item)
+			} else {
+				matched++
+			}
+		}
+	}
+
+	for i, p := range payouts {
+		if _, ok := accounted[i]; ok || p.Status != StatusSuccess {
+			continue
+		}
+		detail := "not in the Afriex export"
+		if status, ok := reported[i]; ok {
+			detail = "Afriex reports " + status
+		} else if p.CreatedAt.Before(from) || !p.CreatedAt.Before(to) {
+			continue
+		}
+		exceptions = append(exceptions, ReconciliationItem{
+			Result:        ReconMissingTheirs,
+			PayoutID:      p.ID,
+			TransactionID: p.TransactionID,
+			Reference:     p.ReferenceID,
+			Ours:          p.Money(),
+			Detail:        detail,
+		})
+	}
+	return matched, exceptions
+}
+
+// Count tallies the run's exceptions by result.
+func (r *ReconciliationRun) Count() {
+	r.MissingOurs, r.MissingTheirs, r.AmountMismatches, r.Reversed = 0, 0, 0, 0
+	for _, e := range r.Exceptions {
+		switch e.Result {
+		case ReconMissingOurs:
+			r.MissingOurs++
+		case ReconMissingTheirs:
+			r.MissingTheirs++
+		case ReconAmountMismatch:
+			r.AmountMismatches++
+		case ReconReversed:
+			r.Reversed++
+		}
+	}
+}
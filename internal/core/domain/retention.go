@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRecipientErased means the payout's recipient details are gone, so it
+// cannot be sent again
+var ErrRecipientErased = errors.New("recipient details were erased")
+
+// Erasure is the outcome of erasing a recipient's details. Amounts,
+// statuses, references and ledger postings are kept; names, phones, emails,
+// tags, account numbers and review details are blanked.
+type Erasure struct {
+	TenantID  string
+	PayoutID  string   // The payout the request named
+	PayoutIDs []string // Every payout erased: PayoutID and the others to the same account or phone
+	// Matching payouts not yet finished, which still need the details to be
+	// paid or decided; erase again once they are
+	LivePayoutIDs []string
+	ErasedAt      time.Time
+}
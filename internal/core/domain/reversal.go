@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// Where a reversal was reported from
+const (
+	ReversalSourceWebhook        = "AFRIEX_WEBHOOK"
+	ReversalSourceReconciliation = "RECONCILIATION"
+	ReversalSourceAdmin          = "ADMIN"
+)
+
+var (
+	ErrPayoutNotFound  = errors.New("payout not found")
+	ErrNotReversible   = errors.New("only a successful payout can be reversed")
+	ErrNotReissuable   = errors.New("only a reversed payout can be re-issued")
+	ErrAlreadyReissued = errors.New("payout has already been re-issued")
+)
+
+// IsProviderReversal reports whether an Afriex transaction status means the
+// bank sent the money back after it was paid (closed account, wrong name...).
+func IsProviderReversal(status string) bool {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "REVERSED", "RETURNED", "REFUNDED":
+		return true
+	}
+	return false
+}
+
+// Reversal records why and by whom a successful payout was marked REVERSED.
+type Reversal struct {
+	Source string // AFRIEX_WEBHOOK, RECONCILIATION or ADMIN
+	Reason string // e.g. "account closed"
+	Actor  string // Admin user, or the webhook / reconciliation run
+}
+
+// Reissue is the corrected destination for a reversed payout. Empty fields
+// keep the original payout's value.
+type Reissue struct {
+	BankCode      string
+	AccountNumber string
+	Channel       string
+	RecipientName string
+	RequestedBy   string
+	APIKeyID      string // Key asking, counted against its payout quota
+}
+
+// Apply builds the replacement payout: a copy of the reversed one sent to
+// the corrected account, with a fresh ID and none of the original's results.
+func (r Reissue) Apply(id string, original Payout) Payout {
+	p := Payout{
+		ID:             id,
+		ReferenceID:    original.ReferenceID,
+		RecipientName:  original.RecipientName,
+		RecipientPhone: original.RecipientPhone,
+		RecipientEmail: original.RecipientEmail,
+		RecipientTag:   original.RecipientTag,
+		CountryCode:    original.CountryCode,
+		BankCode:       original.BankCode,
+		AccountNumber:  original.AccountNumber,
+		Channel:        original.Channel,
+		Amount:         original.Amount,
+		Currency:       original.Currency,
+		ReissueOf:      original.ID,
+	}
+	if v := strings.TrimSpace(r.BankCode); v != "" && v != original.BankCode {
+		p.BankCode = v
+	} else {
+		p.BankName = original.BankName // Same bank, keep the resolved name
+	}
+	if v := strings.TrimSpace(r.AccountNumber); v != "" {
+		p.AccountNumber = v
+	}
+	if v := strings.TrimSpace(r.Channel); v != "" {
+		p.Channel = strings.ToUpper(v)
+	}
+	if v := strings.TrimSpace(r.RecipientName); v != "" {
+		p.RecipientName = v
+	}
+	return p
+}
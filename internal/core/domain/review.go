@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Why a payout was held for a human decision
+const (
+	ReviewReasonSanctions    = "SANCTIONS"
+	ReviewReasonNameMismatch = "NAME_MISMATCH"
+	ReviewReasonLargeAmount  = "LARGE_AMOUNT"
+)
+
+// Review decision states
+const (
+	ReviewPending  = "PENDING"
+	ReviewApproved = "APPROVED"
+	ReviewRejected = "REJECTED"
+)
+
+var (
+	ErrReviewNotFound       = errors.New("review not found")
+	ErrReviewAlreadyDecided = errors.New("review already decided")
+	ErrPayoutNotHeld        = errors.New("payout is not held for review")
+)
+
+// Review is a held payout waiting on (or decided by) a human.
+type Review struct {
+	ID       string
+	TenantID string // The payout's tenant
+	PayoutID string
+	BatchID  string
+	Reason   string // SANCTIONS, NAME_MISMATCH, LARGE_AMOUNT
+	Detail   string // What the check found, e.g. the matched list entry
+
+	Status         string // PENDING, APPROVED, REJECTED
+	Reviewer       string
+	DecisionReason string
+
+	CreatedAt time.Time
+	DecidedAt time.Time
+
+	// Payout summary for the queue UI
+	RecipientName string
+	CountryCode   string
+	Amount        int64
+	Currency      string
+}
+
+// Hold is the result of a pre-payment check that wants a human decision.
+type Hold struct {
+	Status string // Payout status while held: HELD_REVIEW or HELD_COMPLIANCE
+	Reason string // Review reason
+	Detail string
+}
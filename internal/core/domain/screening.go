@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScreeningHit is a watchlist entry that looks like the recipient.
+type ScreeningHit struct {
+	List    string  // "OFAC", "UN", "EU"
+	EntryID string  // Identifier on the source list
+	Name    string  // The listed name or alias that matched
+	Score   float64 // 0..1
+}
+
+// ScreeningResult is the outcome of screening one name.
+type ScreeningResult struct {
+	ListVersion string // Which list files were used, recorded for audit
+	Hits        []ScreeningHit
+}
+
+// Hit reports whether the name needs a compliance decision.
+func (r ScreeningResult) Hit() bool {
+	return len(r.Hits) > 0
+}
+
+// Summary describes the best hits in one line for the payout record.
+func (r ScreeningResult) Summary() string {
+	parts := make([]string, 0, len(r.Hits))
+	for i, h := range r.Hits {
+		if i == 3 {
+			parts = append(parts, fmt.Sprintf("+%d more", len(r.Hits)-i))
+			break
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %q (%.2f)", h.List, h.EntryID, h.Name, h.Score))
+	}
+	return "sanctions screening hit: " + strings.Join(parts, "; ")
+}
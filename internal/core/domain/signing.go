@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSignatureInvalid  = errors.New("request signature does not match")
+	ErrSignatureExpired  = errors.New("request timestamp is outside the allowed clock skew")
+	ErrNonceReused       = errors.New("request nonce already used")
+	ErrSignatureRequired = errors.New("api key only accepts signed requests")
+)
+
+// SignedRequest is what a request signed with pkg/wayasign claims: the key
+// it was signed with and the parts of the request the signature covers.
+type SignedRequest struct {
+	KeyPrefix  string
+	Method     string
+	RequestURI string // Path and query as sent
+	Timestamp  time.Time
+	Nonce      string
+	BodyHash   string // Hex SHA-256 of the body as received
+	Signature  string
+}
+
+// Validate checks the request names a key and carries a usable nonce.
+func (r SignedRequest) Validate() error {
+	var errs ValidationErrors
+	if r.KeyPrefix == "" {
+		errs.Add("X-Waya-Key", "is required")
+	}
+	if n := len(r.Nonce); n < 16 || n > 128 {
+		errs.Add("X-Waya-Nonce", "must be 16 to 128 characters")
+	}
+	if r.Signature == "" {
+		errs.Add("X-Waya-Signature", "is required")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+// DefaultTenant is the operator's own tenant. Data from before tenants
+// existed belongs to it, and only it may run platform-wide work such as
+// recording funding, reversals and reconciliation.
+const DefaultTenant = "default"
+
+var (
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantExists   = errors.New("tenant already exists")
+)
+
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,62}$`)
+
+// Tenant is a business unit sharing the deployment. Every payout, batch and
+// ledger account belongs to exactly one tenant, resolved from the caller's
+// API key.
+type Tenant struct {
+	ID         string // Slug, e.g. "payroll-ng"
+	Name       string
+	WebhookURL string // Where batch and payout events for this tenant are sent
+	CreatedAt  time.Time
+}
+
+// IsOperator reports whether the tenant runs the deployment.
+func (t Tenant) IsOperator() bool { return t.ID == DefaultTenant }
+
+// Validate checks the tenant's ID and name.
+func (t Tenant) Validate() error {
+	var errs ValidationErrors
+	if !tenantIDPattern.MatchString(t.ID) {
+		errs.Add("id", "must be 2-63 lowercase letters, digits or dashes")
+	}
+	if t.Name == "" {
+		errs.Add("name", "is required")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Roles of dashboard users
+const (
+	RoleViewer   = "viewer"   // Reads everything of the tenant
+	RoleOperator = "operator" // Also submits and re-issues batches
+	RoleApprover = "approver" // Also approves or rejects batches and reviews
+	RoleAdmin    = "admin"    // All of the above, plus users and API keys
+)
+
+var knownRoles = []string{RoleViewer, RoleOperator, RoleApprover, RoleAdmin}
+
+// Password length bounds; bcrypt ignores bytes past 72
+const (
+	MinPasswordLength = 12
+	MaxPasswordLength = 72
+)
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserExists         = errors.New("a user with this email already exists")
+	ErrUserDisabled       = errors.New("user disabled")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrAccessTokenInvalid = errors.New("access token invalid or expired")
+	ErrRefreshInvalid     = errors.New("refresh token invalid or expired")
+	ErrRefreshReused      = errors.New("refresh token already used; every session of the user was signed out")
+	ErrSelfChange         = errors.New("users cannot change their own role or disable themselves")
+)
+
+// User is a person who signs in to the dashboard. Users belong to a tenant
+// and act with their role's permissions; their email names them in audit
+// trails.
+type User struct {
+	ID          string
+	TenantID    string
+	Email       string // Unique across tenants, lower case
+	Name        string
+	Role        string
+	CreatedBy   string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+	DisabledAt  time.Time
+}
+
+func (u User) Validate() error {
+	var errs ValidationErrors
+	if at := strings.Index(u.Email, "@"); at < 1 || at == len(u.Email)-1 || strings.ContainsAny(u.Email, " \t\r\n") {
+		errs.Add("email", "must be an email address")
+	}
+	if u.Name == "" {
+		errs.Add("name", "is required")
+	}
+	if err := ValidateRole(u.Role); err != nil {
+		errs.Add("role", "%v", err)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ValidateRole(role string) error {
+	if !slices.Contains(knownRoles, role) {
+		return fmt.Errorf("must be one of %s", strings.Join(knownRoles, ", "))
+	}
+	return nil
+}
+
+// ValidatePassword checks a new password's length.
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return fmt.Errorf("must be %d to %d characters", MinPasswordLength, MaxPasswordLength)
+	}
+	return nil
+}
+
+// NormalizeEmail is how emails are stored and looked up.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// AccessClaims is what a signed access token asserts.
+type AccessClaims struct {
+	UserID    string
+	TenantID  string
+	Role      string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// RefreshToken lets a user get a new access token without their password.
+// Each is used once: refreshing replaces it, and presenting a replaced one
+// again signs the user out everywhere.
+type RefreshToken struct {
+	ID         string
+	UserID     string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	RevokedAt  time.Time
+	ReplacedBy string
+}
+
+// Session is what signing in or refreshing returns.
+type Session struct {
+	User             User
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// NewRefreshToken generates an opaque refresh token. Only its hash is stored.
+func NewRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "wr_" + hex.EncodeToString(b), nil
+}
+
+// HashRefreshToken is how refresh tokens are stored and looked up.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
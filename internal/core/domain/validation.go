@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError describes a single invalid field in a request.
+type ValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors collects every problem found in a request so the client can
+// fix a whole payroll file in one go instead of one error at a time.
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(v))
+	for _, e := range v {
+		msgs = append(msgs, e.Field+": "+e.Message)
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Add appends a field error.
+func (v *ValidationErrors) Add(field, format string, args ...any) {
+	*v = append(*v, ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Prefix returns a copy with every field nested under prefix, e.g. "items[3]".
+func (v ValidationErrors) Prefix(prefix string) ValidationErrors {
+	out := make(ValidationErrors, 0, len(v))
+	for _, e := range v {
+		out = append(out, ValidationError{Field: prefix + "." + e.Field, Message: e.Message})
+	}
+	return out
+}
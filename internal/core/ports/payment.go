@@ -2,36 +2,262 @@ package ports
 
 import (
 	"context"
+	"io"
+	"time"
+
 	"waya/internal/adapters/payments/afriex"
 	"waya/internal/core/domain"
 )
 
-// PaymentRepository defines how we store data (Database Port)
+// PaymentRepository defines how we store data (Database Port). Lookups are
+// scoped to the caller's tenant; updates take the ID of a payout or batch
+// already loaded through a scoped lookup.
 type PaymentRepository interface {
 	SavePayout(ctx context.Context, payout domain.Payout) error
-	GetPayout(ctx context.Context, id string) (*domain.Payout, error)
+	GetPayout(ctx context.Context, tenantID, id string) (*domain.Payout, error)
 	UpdatePayoutStatus(ctx context.Context, id string, status string, errMsg string) error
-	ListPayouts(ctx context.Context, limit int) ([]domain.Payout, error)
-	ListPayoutsByBatchID(ctx context.Context, batchID string) ([]domain.Payout, error)
+	// ReleaseHeldPayout fails with domain.ErrPayoutNotHeld unless the payout is held
+	ReleaseHeldPayout(ctx context.Context, id string, status string, errMsg string) error
+	SetResolvedAccountName(ctx context.Context, id string, name string, score float64) error
+	SetScreeningListVersion(ctx context.Context, id string, version string) error
+	SetPayoutTransactionID(ctx context.Context, id string, transactionID string) error
+	SetPayoutCost(ctx context.Context, id string, cost domain.PayoutCost) error
+	ListPayouts(ctx context.Context, tenantID string, limit int) ([]domain.Payout, error)
+	ListPayoutsByBatchID(ctx context.Context, tenantID, batchID string) ([]domain.Payout, error)
+	ListPayoutsByRecipient(ctx context.Context, tenantID string, recipient domain.RecipientLookup, limit int) ([]domain.Payout, error)
+
+	// Platform-wide lookups for what Afriex reports, which carries no tenant.
+	// Both return nil when there is no such payout.
+	FindPayoutByID(ctx context.Context, id string) (*domain.Payout, error)
+	FindPayoutByTransactionID(ctx context.Context, transactionID string) (*domain.Payout, error)
+
+	// Reversals
+	// ReversePayout fails with domain.ErrNotReversible unless the payout is SUCCESS
+	ReversePayout(ctx context.Context, id string, rev domain.Reversal) error
+	// ClaimReissue fails with domain.ErrAlreadyReissued unless the payout is REVERSED and not yet re-issued
+	ClaimReissue(ctx context.Context, id, reissueID string) error
+	ReleaseReissue(ctx context.Context, id, reissueID string) error
+
+	// Batches (maker-checker)
+	CreateBatch(ctx context.Context, batch domain.Batch, submitted domain.BatchEvent, guards ...BatchGuard) error // guards run in the same transaction
+	GetBatch(ctx context.Context, tenantID, id string) (*domain.Batch, error)
+	DecideBatch(ctx context.Context, id, approvalStatus, payoutStatus, payoutMsg string, event domain.BatchEvent) error
+}
+
+// LimitUsageReader sums live (not failed, rejected or reversed) payouts for limit checks
+type LimitUsageReader interface {
+	RecipientTotal(ctx context.Context, tenantID string, account domain.RecipientAccount, currency string, since time.Time) (int64, error)
+	CorridorTotal(ctx context.Context, tenantID, country, currency string, since time.Time) (int64, error)
+}
+
+// LedgerPoster writes journal entries and reads balances derived from postings
+type LedgerPoster interface {
+	// PostEntry fails with domain.ErrDuplicateEntry if the reference was already posted with that kind
+	PostEntry(ctx context.Context, entry domain.JournalEntry) error
+	AccountBalance(ctx context.Context, tenantID, currency, accountType string) (int64, error)
+}
+
+// LedgerStore is the double-entry ledger
+type LedgerStore interface {
+	LedgerPoster
+	ListBalances(ctx context.Context, tenantID string) ([]domain.AccountBalance, error)
+	ListEntriesByReference(ctx context.Context, reference string) ([]domain.JournalEntry, error)
+	// BalancesBefore sums each account type's postings made before the given time
+	BalancesBefore(ctx context.Context, tenantID, currency string, before time.Time) (map[string]int64, error)
+	// ListEntriesBetween returns entries in [from, to) with their postings in that currency
+	ListEntriesBetween(ctx context.Context, tenantID, currency string, from, to time.Time) ([]domain.JournalEntry, error)
+}
+
+// BillingStore reads the fees charged to a tenant
+type BillingStore interface {
+	// ListFeeCharges returns fees posted in [from, to), oldest first
+	ListFeeCharges(ctx context.Context, tenantID string, from, to time.Time) ([]domain.FeeCharge, error)
+}
+
+// ExportParser reads a provider transaction export ("csv", "json" or "" to sniff)
+type ExportParser func(r io.Reader, format string) ([]domain.ProviderRecord, error)
+
+// ReconciliationStore keeps reconciliation runs and finds the payouts to match
+type ReconciliationStore interface {
+	// SaveReconciliation stores the run and its exceptions together
+	SaveReconciliation(ctx context.Context, run domain.ReconciliationRun) error
+	GetReconciliation(ctx context.Context, id string) (*domain.ReconciliationRun, error)
+	ListReconciliations(ctx context.Context, limit int) ([]domain.ReconciliationRun, error)
+	// Reconciliation covers the whole Afriex account, so it sees every tenant's payouts
+	ListSuccessfulPayouts(ctx context.Context, from, to time.Time) ([]domain.Payout, error)
+	// FindPayoutByTransactionID returns nil when no payout has that Afriex transaction
+	FindPayoutByTransactionID(ctx context.Context, transactionID string) (*domain.Payout, error)
+	FindPayoutByID(ctx context.Context, id string) (*domain.Payout, error)
+}
+
+// QuotaUsageReader counts payout items submitted for daily quota checks
+type QuotaUsageReader interface {
+	// PayoutItemsSince counts items in the tenant's batches created since the
+	// given time; a keyID counts only batches that key submitted.
+	PayoutItemsSince(ctx context.Context, tenantID, keyID string, since time.Time) (int64, error)
+}
+
+// DuplicateFinder looks up an earlier payout with the same fingerprint
+type DuplicateFinder interface {
+	// FindRecentDuplicate returns nil when no live payout since the given time matches
+	FindRecentDuplicate(ctx context.Context, tenantID, fingerprint string, since time.Time) (*domain.Payout, error)
+}
+
+// BatchTx is what a guard may read and post inside the transaction that saves a batch
+type BatchTx interface {
+	LimitUsageReader
+	QuotaUsageReader
+	LedgerPoster
+	DuplicateFinder
+}
+
+// BatchGuard vets a batch, and may reserve funds for it, before it is saved
+type BatchGuard func(ctx context.Context, tx BatchTx) error
+
+// TenantStore keeps the tenants sharing the deployment
+type TenantStore interface {
+	// CreateTenant fails with domain.ErrTenantExists if the ID is taken
+	CreateTenant(ctx context.Context, tenant domain.Tenant) error
+	GetTenant(ctx context.Context, id string) (*domain.Tenant, error)
+	ListTenants(ctx context.Context) ([]domain.Tenant, error)
+	SetTenantWebhookURL(ctx context.Context, id, url string) error
+}
+
+// APIKeyStore keeps tenants' API keys by their hash, and the keys' request
+// signing secrets encrypted. Lookups by ID are scoped to the tenant and fail
+// with domain.ErrAPIKeyNotFound.
+type APIKeyStore interface {
+	CreateAPIKey(ctx context.Context, key domain.APIKey, keyHash string, signingKey []byte) error
+	FindAPIKeyByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	// FindAPIKeyByPrefix also returns the key's signing secret, nil when it has none
+	FindAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, []byte, error)
+	SetAPIKeySigningKey(ctx context.Context, id string, signingKey []byte) error
+	GetAPIKey(ctx context.Context, tenantID, id string) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context, tenantID string) ([]domain.APIKey, error)
+	TouchAPIKey(ctx context.Context, id string, usedAt time.Time) error
+	// RevokeAPIKey fails with domain.ErrAPIKeyRevoked if it already is
+	RevokeAPIKey(ctx context.Context, tenantID, id, by string) error
+	// RotateAPIKey stores next and has old expire at oldExpiresAt, atomically.
+	// A key can be rotated once; again fails with domain.ErrAPIKeyReplaced.
+	RotateAPIKey(ctx context.Context, old domain.APIKey, oldExpiresAt time.Time, next domain.APIKey, nextHash string, nextSigningKey []byte) error
+}
+
+// UserStore keeps dashboard users and their refresh tokens. Lookups by ID
+// are scoped to the tenant and fail with domain.ErrUserNotFound.
+type UserStore interface {
+	// CreateUser fails with domain.ErrUserExists if the email is taken
+	CreateUser(ctx context.Context, user domain.User, passwordHash string) error
+	GetUser(ctx context.Context, tenantID, id string) (*domain.User, error)
+	// FindUser looks a user up in any tenant, for refresh tokens
+	FindUser(ctx context.Context, id string) (*domain.User, error)
+	// FindUserByEmail also returns the user's password hash
+	FindUserByEmail(ctx context.Context, email string) (*domain.User, string, error)
+	UserPasswordHash(ctx context.Context, tenantID, id string) (string, error)
+	ListUsers(ctx context.Context, tenantID string) ([]domain.User, error)
+	SetUserRole(ctx context.Context, tenantID, id, role string) error
+	SetUserPassword(ctx context.Context, tenantID, id, passwordHash string) error
+	// DisableUser fails with domain.ErrUserDisabled if it already is
+	DisableUser(ctx context.Context, tenantID, id string) error
+	TouchUserLogin(ctx context.Context, id string, at time.Time) error
+
+	CreateRefreshToken(ctx context.Context, token domain.RefreshToken, tokenHash string) error
+	// FindRefreshToken fails with domain.ErrRefreshInvalid for unknown tokens
+	FindRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// RotateRefreshToken revokes old in favour of next, atomically. It fails
+	// with domain.ErrRefreshReused if old was already revoked.
+	RotateRefreshToken(ctx context.Context, oldID string, next domain.RefreshToken, nextHash string) error
+	RevokeRefreshToken(ctx context.Context, id string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+}
+
+// AccessTokens signs and checks the short-lived tokens signed-in users send
+type AccessTokens interface {
+	Issue(claims domain.AccessClaims) (string, error)
+	// Parse fails with domain.ErrAccessTokenInvalid for bad, forged or expired tokens
+	Parse(token string) (*domain.AccessClaims, error)
+}
+
+// AuditStore is the append-only, hash-chained audit log
+type AuditStore interface {
+	// AppendAudit numbers the entry, chains it to the last one and stores
+	// it, atomically
+	AppendAudit(ctx context.Context, entry domain.AuditEntry) (*domain.AuditEntry, error)
+	ListAudit(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+	// ScanAudit returns up to limit entries after afterID, oldest first
+	ScanAudit(ctx context.Context, afterID int64, limit int) ([]domain.AuditEntry, error)
+}
+
+// NonceStore remembers the nonces of signed requests until they expire
+type NonceStore interface {
+	// UseNonce fails with domain.ErrNonceReused if the key already used the nonce
+	UseNonce(ctx context.Context, keyID, nonce string, expiresAt time.Time) error
+	DeleteExpiredNonces(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RateLimitStore keeps the request limits and payout quotas set for tenants
+// and their keys. Rules are replaced by (tenant, key, route) and quotas by
+// (tenant, key); an empty key means the whole tenant.
+type RateLimitStore interface {
+	ListRateLimits(ctx context.Context, tenantID string) ([]domain.RateLimitRule, error)
+	SetRateLimit(ctx context.Context, rule domain.RateLimitRule) error
+	DeleteRateLimit(ctx context.Context, tenantID, keyID, route string) error
+	ListPayoutQuotas(ctx context.Context, tenantID string) ([]domain.PayoutQuota, error)
+	SetPayoutQuota(ctx context.Context, quota domain.PayoutQuota) error
+	DeletePayoutQuota(ctx context.Context, tenantID, keyID string) error
+}
+
+// ErasureStore blanks recipient details on payouts and their reviews,
+// keeping the rest of the record. Erased payouts are skipped.
+type ErasureStore interface {
+	GetPayout(ctx context.Context, tenantID, id string) (*domain.Payout, error)
+	// EraseRecipient erases payoutID and the tenant's other finished payouts
+	// to the same account (country, bank and number) or phone. It returns
+	// the IDs erased and those skipped because they are still live
+	EraseRecipient(ctx context.Context, tenantID, payoutID string, account domain.RecipientAccount, phone string, at time.Time) (erased, live []string, err error)
+	// EraseExpired erases up to limit finished payouts created before cutoff
+	// and returns how many per tenant
+	EraseExpired(ctx context.Context, cutoff, at time.Time, limit int) (map[string]int, error)
+}
+
+// ReviewRepository stores the manual review queue for held payouts
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review domain.Review) error
+	GetReview(ctx context.Context, tenantID, id string) (*domain.Review, error)
+	ListReviews(ctx context.Context, tenantID, status string, limit int) ([]domain.Review, error)
+	// DecideReview fails with domain.ErrReviewAlreadyDecided unless the review is still pending
+	DecideReview(ctx context.Context, id, status, reviewer, reason string) error
+	ApprovedReviewReasons(ctx context.Context, payoutID string) ([]string, error)
 }
 
 // AfriexGateway defines how we talk to the outside world (API Port)
 type AfriexGateway interface {
 	// Step 1: Onboard
 	CreateCustomer(ctx context.Context, req afriex.CreateCustomerRequest) (string, error)
-	
+
+	// Step 1b: Verify (name enquiry)
+	ResolveAccount(ctx context.Context, req afriex.ResolveAccountRequest) (string, error)
+
 	// Step 2: Link Bank/Wallet
 	CreatePaymentMethod(ctx context.Context, req afriex.CreatePaymentMethodRequest) (string, error)
-	
+
 	// Step 3: Pay
 	CreateTransaction(ctx context.Context, req afriex.CreateTransactionRequest) (*afriex.TransactionResponse, error)
-	
+
 	// Utils
 	GetRates(ctx context.Context, base, symbols string) (*afriex.RateResponse, error)
+	ListInstitutions(ctx context.Context, countryCode, channel string) ([]afriex.Institution, error)
 }
 
-type ExternalClientNotifier interface{
-	NotifyBatchCompletion(ctx context.Context, batchID string, payouts []domain.Payout) error
+// SanctionsScreener checks names against the loaded watchlists
+type SanctionsScreener interface {
+	Screen(ctx context.Context, name string) (domain.ScreeningResult, error)
+}
+
+// ExternalClientNotifier sends events to the webhook of the tenant they belong to
+type ExternalClientNotifier interface {
+	NotifyBatchCompletion(ctx context.Context, tenantID, batchID string, payouts []domain.Payout) error
+	// NotifyPayoutReversed tells the client a paid payout came back (event payout.reversed)
+	NotifyPayoutReversed(ctx context.Context, payout domain.Payout) error
 }
 
 // Data structures specifically for the Afriex Port
@@ -46,4 +272,4 @@ type AfriexTransferResponse struct {
 	TransactionID string
 	Status        string
 	Fee           int64
-}
\ No newline at end of file
+}
@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+	"waya/pkg/wayasign"
+)
+
+// OperatorKeyName names the key created from WAYA_API_KEY
+const OperatorKeyName = "WAYA_API_KEY"
+
+// lastUsedResolution bounds how often a busy key's last use is written
+const lastUsedResolution = time.Minute
+
+// APIKeyService issues, rotates and revokes tenants' API keys and resolves
+// the key on each request to the principal it acts as.
+type APIKeyService struct {
+	keys    ports.APIKeyStore
+	tenants ports.TenantStore
+	audit   *AuditService
+	logger  *slog.Logger
+}
+
+func NewAPIKeyService(keys ports.APIKeyStore, tenants ports.TenantStore, audit *AuditService, logger *slog.Logger) *APIKeyService {
+	return &APIKeyService{
+		keys:    keys,
+		tenants: tenants,
+		audit:   audit,
+		logger:  logger,
+	}
+}
+
+// Create issues a key for the tenant and returns it with its secret, which
+// is not kept. A zero expiresAt never expires. A signedOnly key works only
+// on requests signed with pkg/wayasign.
+func (s *APIKeyService) Create(ctx context.Context, tenantID, name string, scopes []string, expiresAt time.Time, signedOnly bool, createdBy string) (*domain.APIKey, string, error) {
+	var errs domain.ValidationErrors
+	name = strings.TrimSpace(name)
+	if name == "" {
+		errs.Add("name", "is required")
+	}
+	parsed, err := domain.ParseScopes(scopes)
+	if err != nil {
+		errs.Add("scopes", "%v", err)
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		errs.Add("expires_at", "must be in the future")
+	}
+	if len(errs) > 0 {
+		return nil, "", errs
+	}
+	if _, err := s.tenants.GetTenant(ctx, tenantID); err != nil {
+		return nil, "", err
+	}
+
+	k, secret, err := s.issue(ctx, domain.APIKey{
+		TenantID:   tenantID,
+		Name:       name,
+		Scopes:     parsed,
+		CreatedBy:  createdBy,
+		ExpiresAt:  expiresAt,
+		SignedOnly: signedOnly,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	s.logger.Info("🔑 API key created", "tenant_id", tenantID, "key_id", k.ID, "prefix", k.Prefix, "scopes", k.Scopes, "signed_only", k.SignedOnly, "by", createdBy)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: createdBy, Action: domain.AuditKeyCreated, TargetType: "api_key", TargetID: k.ID,
+		After: keyAuditState(*k),
+	})
+	return k, secret, nil
+}
+
+func (s *APIKeyService) issue(ctx context.Context, k domain.APIKey) (*domain.APIKey, string, error) {
+	secret, prefix, err := domain.NewAPIKey()
+	if err != nil {
+		return nil, "", fmt.Errorf("generate api key: %w", err)
+	}
+	k.ID = uuid.New().String()
+	k.Prefix = prefix
+	k.CreatedAt = time.Now().UTC()
+	if err := s.keys.CreateAPIKey(ctx, k, domain.HashAPIKey(secret), wayasign.SigningKey(secret)); err != nil {
+		return nil, "", err
+	}
+	return &k, secret, nil
+}
+
+// Authenticate resolves a presented key to its tenant. Unknown keys fail
+// with domain.ErrAPIKeyNotFound, revoked or expired ones with
+// domain.ErrAPIKeyRevoked or domain.ErrAPIKeyExpired, and signed-only ones
+// with domain.ErrSignatureRequired.
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (*domain.Principal, error) {
+	if secret == "" {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	k, err := s.keys.FindAPIKeyByHash(ctx, domain.HashAPIKey(secret))
+	if err != nil {
+		return nil, err
+	}
+	if k.SignedOnly {
+		return nil, domain.ErrSignatureRequired
+	}
+	return s.principal(ctx, k)
+}
+
+// principal checks the key is still usable and pairs it with its tenant.
+func (s *APIKeyService) principal(ctx context.Context, k *domain.APIKey) (*domain.Principal, error) {
+	now := time.Now()
+	if err := k.Check(now); err != nil {
+		return nil, err
+	}
+	t, err := s.tenants.GetTenant(ctx, k.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if now.Sub(k.LastUsedAt) >= lastUsedResolution {
+		k.LastUsedAt = now.UTC()
+		if err := s.keys.TouchAPIKey(ctx, k.ID, k.LastUsedAt); err != nil {
+			s.logger.Error("Failed to record API key use", "key_id", k.ID, "err", err)
+		}
+	}
+	return &domain.Principal{Tenant: *t, Key: *k}, nil
+}
+
+// Rotate issues a successor with the same name, scopes, lifetime and signing
+// requirement. The old key keeps working for grace so clients can switch
+// over; zero ends it now.
+func (s *APIKeyService) Rotate(ctx context.Context, tenantID, id string, grace time.Duration, by string) (*domain.APIKey, string, error) {
+	if grace < 0 {
+		var errs domain.ValidationErrors
+		errs.Add("grace", "must not be negative")
+		return nil, "", errs
+	}
+	old, err := s.keys.GetAPIKey(ctx, tenantID, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if !old.RevokedAt.IsZero() {
+		return nil, "", domain.ErrAPIKeyRevoked
+	}
+	if old.ReplacedBy != "" {
+		return nil, "", fmt.Errorf("%w by %s", domain.ErrAPIKeyReplaced, old.ReplacedBy)
+	}
+
+	now := time.Now().UTC()
+	next := domain.APIKey{
+		TenantID:   old.TenantID,
+		Name:       old.Name,
+		Scopes:     old.Scopes,
+		CreatedBy:  by,
+		SignedOnly: old.SignedOnly,
+	}
+	if !old.ExpiresAt.IsZero() {
+		next.ExpiresAt = now.Add(old.ExpiresAt.Sub(old.CreatedAt))
+	}
+	oldExpiresAt := now.Add(grace)
+	if !old.ExpiresAt.IsZero() && old.ExpiresAt.Before(oldExpiresAt) {
+		oldExpiresAt = old.ExpiresAt
+	}
+
+	secret, prefix, err := domain.NewAPIKey()
+	if err != nil {
+		return nil, "", fmt.Errorf("generate api key: %w", err)
+	}
+	next.ID, next.Prefix, next.CreatedAt = uuid.New().String(), prefix, now
+	if err := s.keys.RotateAPIKey(ctx, *old, oldExpiresAt, next, domain.HashAPIKey(secret), wayasign.SigningKey(secret)); err != nil {
+		return nil, "", err
+	}
+	s.logger.Info("🔑 API key rotated", "tenant_id", tenantID, "key_id", old.ID, "new_key_id", next.ID, "old_expires_at", oldExpiresAt, "by", by)
+	before := keyAuditState(*old)
+	old.ReplacedBy, old.ExpiresAt = next.ID, oldExpiresAt
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: by, Action: domain.AuditKeyRotated, TargetType: "api_key", TargetID: old.ID,
+		Before: before,
+		After:  keyAuditState(*old),
+	})
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: by, Action: domain.AuditKeyCreated, TargetType: "api_key", TargetID: next.ID,
+		After: keyAuditState(next),
+	})
+	return &next, secret, nil
+}
+
+// Revoke stops the key working immediately.
+func (s *APIKeyService) Revoke(ctx context.Context, tenantID, id, by string) (*domain.APIKey, error) {
+	old, err := s.keys.GetAPIKey(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keys.RevokeAPIKey(ctx, tenantID, id, by); err != nil {
+		return nil, err
+	}
+	s.logger.Warn("🔒 API key revoked", "tenant_id", tenantID, "key_id", id, "by", by)
+	k, err := s.keys.GetAPIKey(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: by, Action: domain.AuditKeyRevoked, TargetType: "api_key", TargetID: id,
+		Before: keyAuditState(*old),
+		After:  keyAuditState(*k),
+	})
+	return k, nil
+}
+
+// keyAuditState is what the audit log keeps of a key; never its secret.
+func keyAuditState(k domain.APIKey) map[string]any {
+	state := map[string]any{
+		"name":        k.Name,
+		"prefix":      k.Prefix,
+		"scopes":      k.Scopes,
+		"signed_only": k.SignedOnly,
+	}
+	if !k.ExpiresAt.IsZero() {
+		state["expires_at"] = k.ExpiresAt.UTC()
+	}
+	if !k.RevokedAt.IsZero() {
+		state["revoked_at"] = k.RevokedAt.UTC()
+	}
+	if k.ReplacedBy != "" {
+		state["replaced_by"] = k.ReplacedBy
+	}
+	return state
+}
+
+func (s *APIKeyService) List(ctx context.Context, tenantID string) ([]domain.APIKey, error) {
+	return s.keys.ListAPIKeys(ctx, tenantID)
+}
+
+// EnsureOperatorKey makes WAYA_API_KEY an admin key of the DefaultTenant, so
+// the operator keeps the key they already use. When the setting changes, the
+// key it used to hold is revoked.
+func (s *APIKeyService) EnsureOperatorKey(ctx context.Context, secret string) error {
+	if secret == "" {
+		return nil
+	}
+	hash := domain.HashAPIKey(secret)
+	k, err := s.keys.FindAPIKeyByHash(ctx, hash)
+	switch {
+	case err == nil && k.TenantID == domain.DefaultTenant:
+		if k.Check(time.Now()) != nil {
+			s.logger.Warn("⚠️ WAYA_API_KEY is revoked or expired; set a new one")
+		}
+		// Keys from before signing secrets were kept get theirs here
+		return s.keys.SetAPIKeySigningKey(ctx, k.ID, wayasign.SigningKey(secret))
+	case err == nil:
+		return fmt.Errorf("WAYA_API_KEY is already a key of tenant %s", k.TenantID)
+	case !errors.Is(err, domain.ErrAPIKeyNotFound):
+		return err
+	}
+
+	keys, err := s.keys.ListAPIKeys(ctx, domain.DefaultTenant)
+	if err != nil {
+		return err
+	}
+	for _, old := range keys {
+		if old.Name == OperatorKeyName && old.RevokedAt.IsZero() {
+			if err := s.keys.RevokeAPIKey(ctx, old.TenantID, old.ID, "config"); err != nil {
+				return err
+			}
+		}
+	}
+	return s.keys.CreateAPIKey(ctx, domain.APIKey{
+		ID:        uuid.New().String(),
+		TenantID:  domain.DefaultTenant,
+		Name:      OperatorKeyName,
+		Scopes:    []string{domain.ScopeAdmin},
+		CreatedBy: "config",
+	}, hash, wayasign.SigningKey(secret))
+}
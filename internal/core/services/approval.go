@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// ApprovalIdentities resolves makers and checkers to the keys and people
+// behind them, so a rotated or freshly minted key is not taken for a second
+// person.
+type ApprovalIdentities struct {
+	keys  ports.APIKeyStore
+	users ports.UserStore
+}
+
+func NewApprovalIdentities(keys ports.APIKeyStore, users ports.UserStore) *ApprovalIdentities {
+	return &ApprovalIdentities{keys: keys, users: users}
+}
+
+// Resolve describes actor, who acted through the tenant's key keyID or, with
+// no keyID, signed in as a user. An actor that is neither is taken as is.
+func (a *ApprovalIdentities) Resolve(ctx context.Context, tenantID, actor, keyID string) (domain.ApprovalIdentity, error) {
+	id := domain.ApprovalIdentity{Actor: actor}
+	if keyID == "" {
+		u, _, err := a.users.FindUserByEmail(ctx, domain.NormalizeEmail(actor))
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return id, nil
+		}
+		if err != nil {
+			return id, err
+		}
+		id.Creators = append(id.Creators, u.CreatedBy)
+		return id, nil
+	}
+
+	keys, err := a.keys.ListAPIKeys(ctx, tenantID)
+	if err != nil {
+		return id, err
+	}
+	byID := make(map[string]domain.APIKey, len(keys))
+	rotatedFrom := make(map[string]domain.APIKey)
+	for _, k := range keys {
+		byID[k.ID] = k
+		if k.ReplacedBy != "" {
+			rotatedFrom[k.ReplacedBy] = k
+		}
+	}
+	k, ok := byID[keyID]
+	for ok {
+		id.Creators = append(id.Creators, k.CreatedBy)
+		if k, ok = rotatedFrom[k.ID]; ok {
+			id.Lineage = append(id.Lineage, "key:"+k.Prefix)
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// Audit query page sizes
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+	auditVerifyPage   = 1000
+)
+
+// AuditService keeps the tamper-evident audit log: who did what to which
+// target, from which request and IP, with the state before and after.
+// Entries are only ever appended, each hash-chained to the one before.
+type AuditService struct {
+	store  ports.AuditStore
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+func NewAuditService(store ports.AuditStore, logger *slog.Logger) *AuditService {
+	return &AuditService{store: store, logger: logger, now: time.Now}
+}
+
+// Record appends the event, attributed to the request in ctx. It is called
+// after the action succeeded, so failing to record is logged rather than
+// undoing it. A nil *AuditService records nothing.
+func (s *AuditService) Record(ctx context.Context, ev domain.AuditEvent) {
+	if s == nil {
+		return
+	}
+	src := domain.AuditSourceFrom(ctx)
+	e := domain.AuditEntry{
+		At:         s.now().UTC(),
+		TenantID:   ev.TenantID,
+		Actor:      ev.Actor,
+		Principal:  src.Principal,
+		Action:     ev.Action,
+		TargetType: ev.TargetType,
+		TargetID:   ev.TargetID,
+		RequestID:  src.RequestID,
+		IP:         src.IP,
+	}
+	if e.Actor == "" {
+		e.Actor = src.Principal
+	}
+	if e.Actor == "" {
+		e.Actor = "system"
+	}
+	var err error
+	if e.Before, err = auditState(ev.Before); err == nil {
+		e.After, err = auditState(ev.After)
+	}
+	if err == nil {
+		// The action is done; finish recording it even if the caller gave up
+		_, err = s.store.AppendAudit(context.WithoutCancel(ctx), e)
+	}
+	if err != nil {
+		s.logger.Error("Failed to write audit log", "action", ev.Action, "target_type", ev.TargetType, "target_id", ev.TargetID, "actor", e.Actor, "err", err)
+	}
+}
+
+func auditState(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal audit state: %w", err)
+	}
+	return string(b), nil
+}
+
+// Query lists entries newest first. Page with BeforeID set to the last ID
+// of the previous page.
+func (s *AuditService) Query(ctx context.Context, f domain.AuditFilter) ([]domain.AuditEntry, error) {
+	var errs domain.ValidationErrors
+	switch {
+	case f.Limit == 0:
+		f.Limit = defaultAuditLimit
+	case f.Limit < 0 || f.Limit > maxAuditLimit:
+		errs.Add("limit", "must be between 1 and %d", maxAuditLimit)
+	}
+	if f.BeforeID < 0 {
+		errs.Add("before_id", "must not be negative")
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		errs.Add("to", "must be after from")
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return s.store.ListAudit(ctx, f)
+}
+
+// Verify walks the whole log, oldest first, and reports the first entry
+// whose hash, link to the previous entry or sequence number is wrong. The
+// chain alone cannot show entries cut off the end; knownHead, a HeadHash
+// kept from an earlier run, catches that when given.
+func (s *AuditService) Verify(ctx context.Context, knownHead string) (*domain.AuditVerification, error) {
+	v := &domain.AuditVerification{HeadHash: domain.GenesisHash}
+	seen := knownHead == "" || knownHead == domain.GenesisHash
+	for {
+		page, err := s.store.ScanAudit(ctx, v.HeadID, auditVerifyPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page {
+			switch {
+			case e.ID != v.HeadID+1:
+				v.BrokenAt, v.Problem = v.HeadID+1, fmt.Sprintf("entry missing; next entry is %d", e.ID)
+			case e.PrevHash != v.HeadHash:
+				v.BrokenAt, v.Problem = e.ID, "previous hash does not match the entry before"
+			case e.ComputeHash() != e.Hash:
+				v.BrokenAt, v.Problem = e.ID, "contents do not match its hash"
+			}
+			if v.BrokenAt != 0 {
+				s.logger.Error("🚨 Audit log tampered with", "entry", v.BrokenAt, "problem", v.Problem)
+				return v, nil
+			}
+			v.Checked++
+			v.HeadID, v.HeadHash = e.ID, e.Hash
+			seen = seen || e.Hash == knownHead
+		}
+		if len(page) < auditVerifyPage {
+			break
+		}
+	}
+	if !seen {
+		v.BrokenAt, v.Problem = v.HeadID+1, "known head hash is not in the chain; entries were cut from the end"
+		s.logger.Error("🚨 Audit log tampered with", "entry", v.BrokenAt, "problem", v.Problem)
+	}
+	return v, nil
+}
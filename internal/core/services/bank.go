@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// BankService serves the institution directory and keeps it fresh from Afriex.
+type BankService struct {
+	directory *domain.BankDirectory
+	corridors *domain.CorridorRegistry
+	gateway   ports.AfriexGateway
+	logger    *slog.Logger
+}
+
+func NewBankService(directory *domain.BankDirectory, corridors *domain.CorridorRegistry, gateway ports.AfriexGateway, logger *slog.Logger) *BankService {
+	return &BankService{
+		directory: directory,
+		corridors: corridors,
+		gateway:   gateway,
+		logger:    logger,
+	}
+}
+
+// ListBanks returns the institutions for a supported country.
+func (s *BankService) ListBanks(country, channel string) ([]domain.Bank, error) {
+	if _, ok := s.corridors.Get(country); !ok {
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnsupportedCorridor, country)
+	}
+	return s.directory.List(country, channel), nil
+}
+
+// Refresh pulls the institution list for every corridor channel from Afriex
+// and merges it into the directory. A failing country is logged and skipped so
+// one outage never empties the directory.
+func (s *BankService) Refresh(ctx context.Context) {
+	for _, c := range s.corridors.List() {
+		for _, ch := range c.Channels {
+			institutions, err := s.gateway.ListInstitutions(ctx, c.Country, ch.Type)
+			if err != nil {
+				s.logger.Warn("Bank directory refresh failed", "country", c.Country, "channel", ch.Type, "err", err)
+				continue
+			}
+
+			banks := make([]domain.Bank, 0, len(institutions))
+			for _, inst := range institutions {
+				if inst.InstitutionCode == "" {
+					continue
+				}
+				banks = append(banks, domain.Bank{
+					Country: c.Country,
+					Code:    inst.InstitutionCode,
+					Name:    inst.InstitutionName,
+					Channel: ch.Type,
+				})
+			}
+			s.directory.Merge(c.Country, banks)
+			s.logger.Info("🏦 Bank directory refreshed", "country", c.Country, "channel", ch.Type, "count", len(banks))
+		}
+	}
+}
+
+// RefreshEvery runs Refresh on a ticker until ctx is cancelled.
+func (s *BankService) RefreshEvery(ctx context.Context, interval time.Duration) {
+	s.Refresh(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Refresh(ctx)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// SourceCurrency is the currency every payout is funded in.
+const SourceCurrency = "USD"
+
+// CorridorService exposes the corridor registry and prices payouts into it.
+type CorridorService struct {
+	corridors *domain.CorridorRegistry
+	gateway   ports.AfriexGateway
+	pricing   *PricingEngine
+}
+
+// NewCorridorService builds the service; pricing may be nil when Waya charges
+// no fees.
+func NewCorridorService(corridors *domain.CorridorRegistry, gateway ports.AfriexGateway, pricing *PricingEngine) *CorridorService {
+	return &CorridorService{
+		corridors: corridors,
+		gateway:   gateway,
+		pricing:   pricing,
+	}
+}
+
+// ListCorridors returns every supported destination.
+func (s *CorridorService) ListCorridors() []domain.Corridor {
+	return s.corridors.List()
+}
+
+// Quote validates the amount against the corridor, prices it with the live
+// Afriex rate and adds the tenant's fee.
+func (s *CorridorService) Quote(ctx context.Context, tenantID, country, channel string, amount domain.Money) (*domain.Quote, error) {
+	if channel == "" {
+		channel = domain.ChannelBankAccount
+	}
+	probe := domain.Payout{CountryCode: country, Channel: channel, Amount: amount.Amount, Currency: amount.Currency}
+	if errs := s.corridors.Validate(probe); len(errs) > 0 {
+		// Quotes are for amounts, not recipients: only surface the money rules.
+		var amountErrs domain.ValidationErrors
+		for _, e := range errs {
+			switch e.Field {
+			case "country_code", "currency", "amount", "channel":
+				amountErrs = append(amountErrs, e)
+			}
+		}
+		if len(amountErrs) > 0 {
+			return nil, amountErrs
+		}
+	}
+
+	rates, err := s.gateway.GetRates(ctx, SourceCurrency, amount.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rates: %w", err)
+	}
+	rate, ok := rates.Rates[SourceCurrency][amount.Currency]
+	if !ok {
+		return nil, fmt.Errorf("%w: no %s/%s rate", domain.ErrInvalidCurrency, SourceCurrency, amount.Currency)
+	}
+
+	source, err := domain.SourceAmountFor(amount, rate, SourceCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := domain.NewMoney(0, amount.Currency)
+	if s.pricing != nil {
+		fee = s.pricing.Fee(tenantID, probe)
+	}
+
+	return &domain.Quote{
+		Country:     country,
+		Destination: amount,
+		Source:      source,
+		Rate:        rate,
+		Fee:         fee,
+	}, nil
+}
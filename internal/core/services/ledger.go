@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// LedgerService keeps tenant funds in the double-entry ledger. Top-ups credit
+// the tenant's AVAILABLE account; each accepted payout moves its amount and
+// Waya's fee to RESERVED, then to SETTLED and FEES when it succeeds or back to
+// AVAILABLE when it fails or is rejected. Balances are always summed from
+// postings.
+type LedgerService struct {
+	store        ports.LedgerStore
+	requireFunds bool
+	audit        *AuditService
+	logger       *slog.Logger
+}
+
+// NewLedgerService builds the ledger. With requireFunds, batches larger than
+// the available balance are refused; without it they still reserve and the
+// available balance may go negative.
+func NewLedgerService(store ports.LedgerStore, requireFunds bool, audit *AuditService, logger *slog.Logger) *LedgerService {
+	return &LedgerService{
+		store:        store,
+		requireFunds: requireFunds,
+		audit:        audit,
+		logger:       logger,
+	}
+}
+
+// Fund records money received from the tenant. The reference (bank transfer
+// ID, Afriex deposit ID...) is unique, so replaying a top-up fails with
+// domain.ErrDuplicateEntry instead of crediting twice.
+func (s *LedgerService) Fund(ctx context.Context, tenantID, reference string, amount domain.Money, description string) (*domain.JournalEntry, error) {
+	var errs domain.ValidationErrors
+	if strings.TrimSpace(reference) == "" {
+		errs.Add("reference", "is required")
+	}
+	if !amount.IsPositive() {
+		errs.Add("amount", "must be positive")
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	entry := domain.NewTransfer(uuid.New().String(), tenantID, domain.EntryFunding, strings.TrimSpace(reference), description,
+		domain.AccountFunding, domain.AccountAvailable, amount)
+	if err := s.store.PostEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+	entry.CreatedAt = time.Now().UTC()
+	s.logger.Info("🏦 Funds received", "tenant", tenantID, "reference", entry.Reference, "amount", amount.String(), "currency", amount.Currency)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Action: domain.AuditFundingRecorded, TargetType: "journal_entry", TargetID: entry.ID,
+		After: map[string]string{"reference": entry.Reference, "amount": amount.String(), "currency": amount.Currency, "description": description},
+	})
+	return &entry, nil
+}
+
+// Guard checks the batch against the available balance per currency and
+// reserves every payout, inside the transaction that saves the batch.
+func (s *LedgerService) Guard(tenantID string, payouts []domain.Payout) ports.BatchGuard {
+	return func(ctx context.Context, tx ports.BatchTx) error {
+		if s.requireFunds {
+			if err := s.checkFunds(ctx, tx, tenantID, payouts); err != nil {
+				return err
+			}
+		}
+		for _, p := range payouts {
+			entry := domain.NewTransfer(uuid.New().String(), tenantID, domain.EntryReserve, p.ID, "payout accepted in batch "+p.BatchID,
+				domain.AccountAvailable, domain.AccountReserved, domain.NewMoney(p.Amount+p.ServiceFee, p.Currency))
+			if err := tx.PostEntry(ctx, entry); err != nil {
+				return fmt.Errorf("reserve payout %s: %w", p.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func (s *LedgerService) checkFunds(ctx context.Context, tx ports.LedgerPoster, tenantID string, payouts []domain.Payout) error {
+	required := make(map[string]int64)
+	for _, p := range payouts {
+		required[p.Currency] += p.Amount + p.ServiceFee
+	}
+	currencies := make([]string, 0, len(required))
+	for cur := range required {
+		currencies = append(currencies, cur)
+	}
+	sort.Strings(currencies)
+
+	var shortfalls []domain.Shortfall
+	for _, cur := range currencies {
+		available, err := tx.AccountBalance(ctx, tenantID, cur, domain.AccountAvailable)
+		if err != nil {
+			return fmt.Errorf("available balance: %w", err)
+		}
+		if available < required[cur] {
+			shortfalls = append(shortfalls, domain.Shortfall{
+				Currency:  cur,
+				Available: domain.NewMoney(available, cur),
+				Required:  domain.NewMoney(required[cur], cur),
+			})
+		}
+	}
+	if len(shortfalls) > 0 {
+		return &domain.InsufficientFundsError{Shortfalls: shortfalls}
+	}
+	return nil
+}
+
+// Settle moves a successful payout's reservation to SETTLED, charging its fee
+// to FEES.
+func (s *LedgerService) Settle(ctx context.Context, tenantID string, p domain.Payout) error {
+	return s.close(ctx, tenantID, p, func(id string, reserved domain.Money) domain.JournalEntry {
+		return domain.NewSettlement(id, tenantID, p.ID, "payout succeeded", reserved, p.ServiceFee)
+	})
+}
+
+// Release returns a failed or rejected payout's reservation, fee included, to
+// AVAILABLE.
+func (s *LedgerService) Release(ctx context.Context, tenantID string, p domain.Payout) error {
+	return s.close(ctx, tenantID, p, func(id string, reserved domain.Money) domain.JournalEntry {
+		return domain.NewTransfer(id, tenantID, domain.EntryRelease, p.ID, "payout "+strings.ToLower(p.Status),
+			domain.AccountReserved, domain.AccountAvailable, reserved)
+	})
+}
+
+// Reverse credits a returned payout back to AVAILABLE. A settled payout has
+// its SETTLE entry undone, fee included; one still reserved is released.
+// Reversing twice is a no-op.
+func (s *LedgerService) Reverse(ctx context.Context, tenantID string, p domain.Payout) error {
+	entries, err := s.store.ListEntriesByReference(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+	var settled *domain.JournalEntry
+	for i, e := range entries {
+		switch e.Kind {
+		case domain.EntryReversal, domain.EntryRelease:
+			return nil
+		case domain.EntrySettle:
+			settled = &entries[i]
+		}
+	}
+	if settled == nil {
+		return s.Release(ctx, tenantID, p)
+	}
+
+	entry := domain.NewReversal(uuid.New().String(), tenantID, p.ID, "payout reversed", *settled)
+	if err := s.store.PostEntry(ctx, entry); err != nil && !errors.Is(err, domain.ErrDuplicateEntry) {
+		return err
+	}
+	s.logger.Info("↩️ Payout credited back", "tenant", tenantID, "id", p.ID)
+	return nil
+}
+
+// close ends a reservation. Payouts accepted before the ledger existed have
+// nothing to close, and closing twice is a no-op, so callers can retry freely.
+func (s *LedgerService) close(ctx context.Context, tenantID string, p domain.Payout, build func(id string, reserved domain.Money) domain.JournalEntry) error {
+	entries, err := s.store.ListEntriesByReference(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+	var reserved *domain.Money
+	for _, e := range entries {
+		switch e.Kind {
+		case domain.EntrySettle, domain.EntryRelease:
+			return nil
+		case domain.EntryReserve:
+			for _, posting := range e.Postings {
+				if posting.AccountType == domain.AccountReserved {
+					m := domain.NewMoney(-posting.Amount, posting.Currency)
+					reserved = &m
+				}
+			}
+		}
+	}
+	if reserved == nil {
+		return nil
+	}
+
+	entry := build(uuid.New().String(), *reserved)
+	if err := s.store.PostEntry(ctx, entry); err != nil && !errors.Is(err, domain.ErrDuplicateEntry) {
+		return err
+	}
+	return nil
+}
+
+// Balances sums a tenant's accounts into one line per currency.
+func (s *LedgerService) Balances(ctx context.Context, tenantID string) ([]domain.Balance, error) {
+	accounts, err := s.store.ListBalances(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	byCurrency := make(map[string]*domain.Balance)
+	var balances []*domain.Balance
+	for _, a := range accounts {
+		b, ok := byCurrency[a.Currency]
+		if !ok {
+			zero := domain.NewMoney(0, a.Currency)
+			b = &domain.Balance{Currency: a.Currency, Funded: zero, Available: zero, Reserved: zero, Settled: zero, Fees: zero}
+			byCurrency[a.Currency] = b
+			balances = append(balances, b)
+		}
+		m := domain.NewMoney(a.Balance, a.Currency)
+		switch a.AccountType {
+		case domain.AccountFunding:
+			b.Funded = m
+		case domain.AccountAvailable:
+			b.Available = m
+		case domain.AccountReserved:
+			b.Reserved = m
+		case domain.AccountSettled:
+			b.Settled = m
+		case domain.AccountFees:
+			b.Fees = m
+		}
+	}
+
+	out := make([]domain.Balance, 0, len(balances))
+	for _, b := range balances {
+		out = append(out, *b)
+	}
+	return out, nil
+}
+
+// Statement lists the tenant's entries in one currency over [from, to), with
+// opening and closing balances. Closing is opening plus every line.
+func (s *LedgerService) Statement(ctx context.Context, tenantID, currency string, from, to time.Time) (*domain.Statement, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	var errs domain.ValidationErrors
+	if _, ok := domain.MinorUnits(currency); !ok {
+		errs.Add("currency", "%q is not a supported currency", currency)
+	}
+	if !from.Before(to) {
+		errs.Add("to", "must be after from")
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	opening, err := s.store.BalancesBefore(ctx, tenantID, currency, from)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.store.ListEntriesBetween(ctx, tenantID, currency, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	running := make(map[string]int64, len(opening))
+	for k, v := range opening {
+		running[k] = v
+	}
+	st := &domain.Statement{
+		Currency: currency,
+		From:     from,
+		To:       to,
+		Opening:  balanceOf(currency, opening),
+		Lines:    make([]domain.StatementLine, 0, len(entries)),
+	}
+	for _, e := range entries {
+		delta := make(map[string]int64)
+		for _, p := range e.Postings {
+			change := domain.SignedBalance(p.AccountType, p.Amount)
+			delta[p.AccountType] += change
+			running[p.AccountType] += change
+		}
+		st.Lines = append(st.Lines, domain.StatementLine{
+			EntryID:     e.ID,
+			Kind:        e.Kind,
+			Reference:   e.Reference,
+			Description: e.Description,
+			CreatedAt:   e.CreatedAt,
+			Available:   domain.NewMoney(delta[domain.AccountAvailable], currency),
+			Reserved:    domain.NewMoney(delta[domain.AccountReserved], currency),
+			Settled:     domain.NewMoney(delta[domain.AccountSettled], currency),
+			Fees:        domain.NewMoney(delta[domain.AccountFees], currency),
+		})
+	}
+	st.Closing = balanceOf(currency, running)
+	return st, nil
+}
+
+func balanceOf(currency string, byType map[string]int64) domain.Balance {
+	return domain.Balance{
+		Currency:  currency,
+		Funded:    domain.NewMoney(byType[domain.AccountFunding], currency),
+		Available: domain.NewMoney(byType[domain.AccountAvailable], currency),
+		Reserved:  domain.NewMoney(byType[domain.AccountReserved], currency),
+		Settled:   domain.NewMoney(byType[domain.AccountSettled], currency),
+		Fees:      domain.NewMoney(byType[domain.AccountFees], currency),
+	}
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// LimitEngine enforces the per-payout, daily per-recipient and monthly
+// per-corridor limits. Each tenant's totals are its own: another tenant's
+// payouts to the same account or corridor never count against it. Usage is
+// summed from the payouts table, so anything not FAILED, REJECTED or REVERSED
+// counts, including batches still awaiting approval.
+type LimitEngine struct {
+	limits *domain.LimitRegistry
+	usage  ports.LimitUsageReader
+	now    func() time.Time
+}
+
+func NewLimitEngine(limits *domain.LimitRegistry, usage ports.LimitUsageReader) *LimitEngine {
+	return &LimitEngine{
+		limits: limits,
+		usage:  usage,
+		now:    time.Now,
+	}
+}
+
+// Guard returns a check for payouts that the repository runs in the same
+// transaction as the insert, so two batches cannot both squeeze under a limit.
+func (e *LimitEngine) Guard(tenantID string, payouts []domain.Payout) ports.BatchGuard {
+	return func(ctx context.Context, tx ports.BatchTx) error {
+		return e.Check(ctx, tx, tenantID, payouts)
+	}
+}
+
+// Check evaluates a tenant's payouts against every limit, counting earlier
+// payouts of the same batch as used. It returns a *domain.LimitError listing
+// every violation, or nil when the whole batch fits.
+func (e *LimitEngine) Check(ctx context.Context, usage ports.LimitUsageReader, tenantID string, payouts []domain.Payout) error {
+	now := e.now()
+	dayStart, _ := domain.DayWindow(now)
+	monthStart, _ := domain.MonthWindow(now)
+
+	recipientUsed := make(map[string]int64)
+	corridorUsed := make(map[string]int64)
+	var violations []domain.LimitViolation
+
+	for _, p := range payouts {
+		l, ok := e.limits.Get(p.CountryCode, p.Currency)
+		if !ok {
+			continue
+		}
+		violate := func(kind, scope string, limit domain.Money, used int64) {
+			violations = append(violations, domain.LimitViolation{
+				PayoutID:  p.ID,
+				Kind:      kind,
+				Scope:     scope,
+				Limit:     limit,
+				Used:      domain.NewMoney(used, p.Currency),
+				Requested: p.Money(),
+			})
+		}
+
+		if l.PerPayout.IsPositive() && p.Amount > l.PerPayout.Amount {
+			violate(domain.LimitPerPayout, l.Country+"/"+l.Currency, l.PerPayout, 0)
+		}
+
+		if l.DailyPerRecipient.IsPositive() {
+			account := p.Recipient()
+			key := account.String() + "/" + p.Currency
+			used, seen := recipientUsed[key]
+			if !seen {
+				total, err := usage.RecipientTotal(ctx, tenantID, account, p.Currency, dayStart)
+				if err != nil {
+					return fmt.Errorf("recipient usage: %w", err)
+				}
+				used = total
+			}
+			if used+p.Amount > l.DailyPerRecipient.Amount {
+				violate(domain.LimitDailyPerRecipient, account.String(), l.DailyPerRecipient, used)
+			}
+			recipientUsed[key] = used + p.Amount
+		}
+
+		if l.Monthly.IsPositive() {
+			scope := l.Country + "/" + l.Currency
+			used, seen := corridorUsed[scope]
+			if !seen {
+				total, err := usage.CorridorTotal(ctx, tenantID, l.Country, l.Currency, monthStart)
+				if err != nil {
+					return fmt.Errorf("corridor usage: %w", err)
+				}
+				used = total
+			}
+			if used+p.Amount > l.Monthly.Amount {
+				violate(domain.LimitMonthlyPerCorridor, scope, l.Monthly, used)
+			}
+			corridorUsed[scope] = used + p.Amount
+		}
+	}
+
+	if len(violations) > 0 {
+		return &domain.LimitError{Violations: violations}
+	}
+	return nil
+}
+
+// UsageQuery narrows the usage report to one tenant. Country and currency
+// pick corridors (all when empty); a bank code and account number add that
+// recipient's daily usage.
+type UsageQuery struct {
+	TenantID      string
+	Country       string
+	Currency      string
+	BankCode      string
+	AccountNumber string
+}
+
+// Usage reports current usage against each configured limit.
+func (e *LimitEngine) Usage(ctx context.Context, q UsageQuery) ([]domain.LimitUsage, error) {
+	q.Country = strings.ToUpper(strings.TrimSpace(q.Country))
+	q.Currency = strings.ToUpper(strings.TrimSpace(q.Currency))
+	if (q.BankCode == "") != (q.AccountNumber == "") {
+		var errs domain.ValidationErrors
+		errs.Add("account_number", "bank_code and account_number must be given together")
+		return nil, errs
+	}
+	if q.AccountNumber != "" && q.Country == "" {
+		var errs domain.ValidationErrors
+		errs.Add("country", "is required with an account")
+		return nil, errs
+	}
+
+	now := e.now()
+	dayStart, dayEnd := domain.DayWindow(now)
+	monthStart, monthEnd := domain.MonthWindow(now)
+
+	report := []domain.LimitUsage{}
+	for _, l := range e.limits.List() {
+		if (q.Country != "" && l.Country != q.Country) || (q.Currency != "" && l.Currency != q.Currency) {
+			continue
+		}
+		scope := l.Country + "/" + l.Currency
+
+		if l.PerPayout.IsPositive() {
+			report = append(report, domain.LimitUsage{
+				Kind:      domain.LimitPerPayout,
+				Scope:     scope,
+				Limit:     l.PerPayout,
+				Used:      domain.NewMoney(0, l.Currency),
+				Remaining: l.PerPayout,
+			})
+		}
+		if l.DailyPerRecipient.IsPositive() && q.AccountNumber != "" {
+			account := domain.RecipientAccount{Country: l.Country, BankCode: q.BankCode, AccountNumber: q.AccountNumber}
+			used, err := e.usage.RecipientTotal(ctx, q.TenantID, account, l.Currency, dayStart)
+			if err != nil {
+				return nil, fmt.Errorf("recipient usage: %w", err)
+			}
+			report = append(report, usageLine(domain.LimitDailyPerRecipient, account.String(), l.DailyPerRecipient, used, dayStart, dayEnd))
+		}
+		if l.Monthly.IsPositive() {
+			used, err := e.usage.CorridorTotal(ctx, q.TenantID, l.Country, l.Currency, monthStart)
+			if err != nil {
+				return nil, fmt.Errorf("corridor usage: %w", err)
+			}
+			report = append(report, usageLine(domain.LimitMonthlyPerCorridor, scope, l.Monthly, used, monthStart, monthEnd))
+		}
+	}
+	return report, nil
+}
+
+func usageLine(kind, scope string, limit domain.Money, used int64, start, end time.Time) domain.LimitUsage {
+	remaining := limit.Amount - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	return domain.LimitUsage{
+		Kind:        kind,
+		Scope:       scope,
+		Limit:       limit,
+		Used:        domain.NewMoney(used, limit.Currency),
+		Remaining:   domain.NewMoney(remaining, limit.Currency),
+		WindowStart: start,
+		WindowEnd:   end,
+	}
+}
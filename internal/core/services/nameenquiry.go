@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"waya/internal/adapters/payments/afriex"
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// NameCheck is the outcome of an account name enquiry.
+type NameCheck struct {
+	ResolvedName string
+	Score        float64
+	Matched      bool
+}
+
+// NameVerifier resolves the account holder name through Afriex and compares
+// it with the recipient name on the payroll file.
+type NameVerifier struct {
+	gateway   ports.AfriexGateway
+	threshold float64
+}
+
+func NewNameVerifier(gateway ports.AfriexGateway, threshold float64) *NameVerifier {
+	return &NameVerifier{
+		gateway:   gateway,
+		threshold: threshold,
+	}
+}
+
+// Verify resolves the account and scores it against RecipientName.
+func (v *NameVerifier) Verify(ctx context.Context, p domain.Payout) (NameCheck, error) {
+	name, err := v.gateway.ResolveAccount(ctx, afriex.ResolveAccountRequest{
+		Channel:         p.Channel,
+		AccountNumber:   p.AccountNumber,
+		CountryCode:     p.CountryCode,
+		InstitutionCode: p.BankCode,
+	})
+	if err != nil {
+		return NameCheck{}, fmt.Errorf("name enquiry failed: %w", err)
+	}
+	if name == "" {
+		return NameCheck{}, fmt.Errorf("name enquiry returned no account name")
+	}
+
+	score := domain.NameSimilarity(p.RecipientName, name)
+	return NameCheck{
+		ResolvedName: name,
+		Score:        score,
+		Matched:      score >= v.threshold,
+	}, nil
+}
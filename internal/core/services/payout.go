@@ -4,14 +4,18 @@ import (
 	// "context"
 	// "fmt"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
 	// "sync"
 	// "time"
 
+	"github.com/google/uuid"
+
 	// "waya/internal/core/domain"
 	"waya/internal/adapters/payments/afriex"
 	"waya/internal/core/domain"
@@ -23,32 +27,441 @@ type PayoutService struct {
 	gateway ports.AfriexGateway
 	notifier ports.ExternalClientNotifier
 	logger  *slog.Logger
+
+	corridors *domain.CorridorRegistry
+	banks     *domain.BankDirectory
+	names     *NameVerifier
+	screener  ports.SanctionsScreener
+	reviews   ports.ReviewRepository
+	approval  domain.ApprovalPolicy
+	dupes     domain.DuplicatePolicy
+	limits    *LimitEngine
+	ledger    *LedgerService
+	pricing   *PricingEngine
+	quotas    *RateLimiter
+	audit     *AuditService
+	approvers *ApprovalIdentities
+}
+
+// PayoutOption wires optional collaborators into the PayoutService.
+type PayoutOption func(*PayoutService)
+
+// WithCorridors enables corridor validation of every payout in a batch.
+func WithCorridors(corridors *domain.CorridorRegistry) PayoutOption {
+	return func(s *PayoutService) { s.corridors = corridors }
+}
+
+// WithBanks validates bank codes and resolves bank names from the directory.
+func WithBanks(banks *domain.BankDirectory) PayoutOption {
+	return func(s *PayoutService) { s.banks = banks }
+}
+
+// WithNameEnquiry verifies the account holder name before any money moves.
+func WithNameEnquiry(names *NameVerifier) PayoutOption {
+	return func(s *PayoutService) { s.names = names }
+}
+
+// WithScreening screens every recipient against the sanctions lists before execution.
+func WithScreening(screener ports.SanctionsScreener) PayoutOption {
+	return func(s *PayoutService) { s.screener = screener }
+}
+
+// WithReviews queues every held payout for a reviewer to approve or reject.
+func WithReviews(reviews ports.ReviewRepository) PayoutOption {
+	return func(s *PayoutService) { s.reviews = reviews }
+}
+
+// WithApproval makes batches the policy selects wait for a second person's approval.
+func WithApproval(policy domain.ApprovalPolicy) PayoutOption {
+	return func(s *PayoutService) { s.approval = policy }
 }
 
-func NewPayoutService(repo ports.PaymentRepository, gateway ports.AfriexGateway, externaClientNotifier ports.ExternalClientNotifier, logger *slog.Logger) *PayoutService {
-	return &PayoutService{
+// WithDuplicateCheck flags or blocks payouts that repeat a recent one.
+func WithDuplicateCheck(policy domain.DuplicatePolicy) PayoutOption {
+	return func(s *PayoutService) { s.dupes = policy }
+}
+
+// WithLimits refuses batches that would break a payout, recipient or corridor limit.
+func WithLimits(limits *LimitEngine) PayoutOption {
+	return func(s *PayoutService) { s.limits = limits }
+}
+
+// WithLedger reserves funds for every accepted payout and settles or releases
+// them as payouts finish.
+func WithLedger(ledger *LedgerService) PayoutOption {
+	return func(s *PayoutService) { s.ledger = ledger }
+}
+
+// WithPricing charges each payout the tenant's fee, priced when the batch is
+// submitted and collected through the ledger when the payout settles.
+func WithPricing(pricing *PricingEngine) PayoutOption {
+	return func(s *PayoutService) { s.pricing = pricing }
+}
+
+// WithQuotas refuses batches over the tenant's or the submitting key's daily
+// payout quota.
+func WithQuotas(quotas *RateLimiter) PayoutOption {
+	return func(s *PayoutService) { s.quotas = quotas }
+}
+
+// WithApprovalIdentities tells makers and checkers apart by the keys and
+// people behind them rather than by name alone.
+func WithApprovalIdentities(identities *ApprovalIdentities) PayoutOption {
+	return func(s *PayoutService) { s.approvers = identities }
+}
+
+// WithAudit records batch submissions, decisions, reversals and re-issues
+// in the audit log.
+func WithAudit(audit *AuditService) PayoutOption {
+	return func(s *PayoutService) { s.audit = audit }
+}
+
+func NewPayoutService(repo ports.PaymentRepository, gateway ports.AfriexGateway, externaClientNotifier ports.ExternalClientNotifier, logger *slog.Logger, opts ...PayoutOption) *PayoutService {
+	s := &PayoutService{
 		repo:    repo,
 		gateway: gateway,
 		notifier: externaClientNotifier,
 		logger:  logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-// ExecuteBatch is the "Money Maker" function.
-// It takes a list of requests, saves them to DB, and fires them in parallel.
-func (s *PayoutService) ExecuteBatch(ctx context.Context, batchID string, payouts []domain.Payout) error {
-	slog.Info("🚀 Starting Batch Execution", "batch_id", batchID, "count", len(payouts))
+// ValidateBatch checks every payout before anything is persisted or paid.
+// It returns domain.ValidationErrors with fields indexed like the request
+// ("items[2].currency") so clients can map errors back to their rows.
+func (s *PayoutService) ValidateBatch(ctx context.Context, payouts []domain.Payout) error {
+	var errs domain.ValidationErrors
+	for i, p := range payouts {
+		if s.corridors != nil {
+			errs = append(errs, s.corridors.Validate(p).Prefix(fmt.Sprintf("items[%d]", i))...)
+		}
+		if s.banks != nil {
+			errs = append(errs, s.banks.Validate(p).Prefix(fmt.Sprintf("items[%d]", i))...)
+		}
+		if p.RecipientPhone != "" && domain.HasNumberingPlan(p.CountryCode) {
+			if _, err := domain.NormalizePhone(p.RecipientPhone, p.CountryCode); err != nil {
+				errs.Add(fmt.Sprintf("items[%d].recipient_phone", i), "%v", err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// SubmitBatch saves a validated batch. When the approval policy wants a
+// second person to sign off, the payouts wait as AWAITING_APPROVAL and the
+// returned batch has ApprovalStatus PENDING; otherwise the caller should
+// ExecuteBatch straight away. A batch over any limit is refused with a
+// *domain.LimitError, one over a daily quota with a *domain.QuotaError, and
+// one larger than the available balance with a
+// *domain.InsufficientFundsError; nothing is saved in any of these cases. The
+// batch and its payouts belong to batch.TenantID.
+func (s *PayoutService) SubmitBatch(ctx context.Context, batch domain.Batch) (*domain.Batch, error) {
+	if batch.TenantID == "" {
+		batch.TenantID = domain.DefaultTenant
+	}
+	needsApproval, why := s.approval.Requires(batch.Payouts)
+
+	status := domain.StatusPending
+	batch.ApprovalStatus = domain.ApprovalNotRequired
+	if needsApproval {
+		status = domain.StatusAwaitingApproval
+		batch.ApprovalStatus = domain.ApprovalPending
+		batch.ApprovalReason = why
+	}
 
 	// 1. Validation & Persistence Loop
+	for i := range batch.Payouts {
+		batch.Payouts[i].TenantID = batch.TenantID
+		batch.Payouts[i].BatchID = batch.ID
+		batch.Payouts[i].Status = status
+		batch.Payouts[i].CreatedAt = time.Now()
+		s.enrich(&batch.Payouts[i])
+		batch.Payouts[i].Fingerprint = domain.PayoutFingerprint(batch.Payouts[i])
+		batch.TotalAmount += batch.Payouts[i].Amount
+	}
+	batch.TotalCount = len(batch.Payouts)
+	batch.Status, batch.StatusCounts = domain.AggregateStatus(batch.Payouts)
+	if s.pricing != nil {
+		s.pricing.Price(batch.TenantID, batch.Payouts)
+	}
+
+	actor := batch.SubmittedBy
+	if actor == "" {
+		actor = "api-key"
+	}
+	var guards []ports.BatchGuard
+	if s.dupes.Mode == domain.DuplicatesFlag || s.dupes.Mode == domain.DuplicatesBlock {
+		guards = append(guards, s.duplicateGuard(&batch))
+	}
+	if s.quotas != nil {
+		guards = append(guards, s.quotas.QuotaGuard(batch))
+	}
+	if s.limits != nil {
+		guards = append(guards, s.limits.Guard(batch.TenantID, batch.Payouts))
+	}
+	if s.ledger != nil {
+		guards = append(guards, s.ledger.Guard(batch.TenantID, batch.Payouts))
+	}
+	err := s.repo.CreateBatch(ctx, batch, domain.BatchEvent{
+		ID:      uuid.New().String(),
+		BatchID: batch.ID,
+		Actor:   actor,
+		Action:  domain.BatchEventSubmitted,
+		Comment: batch.ApprovalReason,
+	}, guards...)
+	if errors.Is(err, domain.ErrDuplicatePayouts) {
+		return nil, err
+	}
+	if errors.Is(err, domain.ErrQuotaExceeded) {
+		slog.Warn("🚦 Batch over payout quota", "batch_id", batch.ID, "api_key_id", batch.APIKeyID, "err", err)
+		return nil, err
+	}
+	var limitErr *domain.LimitError
+	if errors.As(err, &limitErr) {
+		slog.Warn("🚧 Batch over limits", "batch_id", batch.ID, "violations", len(limitErr.Violations))
+		return nil, err
+	}
+	if errors.Is(err, domain.ErrInsufficientFunds) {
+		slog.Warn("🪫 Batch exceeds available balance", "batch_id", batch.ID, "err", err)
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save batch %s: %w", batch.ID, err)
+	}
+	if needsApproval {
+		slog.Info("🔏 Batch awaiting approval", "batch_id", batch.ID, "submitted_by", batch.SubmittedBy, "reason", why)
+	}
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: batch.TenantID, Actor: batch.SubmittedBy, Action: domain.AuditBatchCreated, TargetType: "batch", TargetID: batch.ID,
+		After: batchAuditState(batch),
+	})
+	return &batch, nil
+}
+
+// batchAuditState summarises a batch for the audit log, without recipients'
+// details.
+func batchAuditState(b domain.Batch) map[string]any {
+	totals := make(map[string]int64)
+	for _, p := range b.Payouts {
+		totals[p.Currency] += p.Amount
+	}
+	amounts := make(map[string]string, len(totals))
+	for cur, amount := range totals {
+		amounts[cur] = domain.NewMoney(amount, cur).String()
+	}
+	return map[string]any{
+		"reference":       b.Reference,
+		"status":          b.Status,
+		"approval_status": b.ApprovalStatus,
+		"approval_reason": b.ApprovalReason,
+		"items":           b.TotalCount,
+		"totals":          amounts,
+	}
+}
+
+// duplicateGuard matches every fingerprinted payout against earlier rows of
+// the same batch and, inside the transaction that saves it, the tenant's
+// recent history, so two copies of one file submitted together cannot both
+// miss each other. Matches are marked on batch.Payouts, whose elements the
+// save shares. Under the block policy any match fails the whole batch with a
+// *domain.DuplicateError.
+func (s *PayoutService) duplicateGuard(batch *domain.Batch) ports.BatchGuard {
+	return func(ctx context.Context, tx ports.BatchTx) error {
+		since := time.Now().Add(-s.dupes.Window)
+		seen := make(map[string]domain.Payout)
+		batch.Duplicates = nil
+
+		for i := range batch.Payouts {
+			p := &batch.Payouts[i]
+			var match *domain.Payout
+			if first, ok := seen[p.Fingerprint]; ok {
+				match = &first // Same row twice in one file
+			} else {
+				seen[p.Fingerprint] = *p
+				prev, err := tx.FindRecentDuplicate(ctx, batch.TenantID, p.Fingerprint, since)
+				if err != nil {
+					return fmt.Errorf("duplicate check: %w", err)
+				}
+				match = prev
+			}
+			if match == nil {
+				continue
+			}
+
+			p.DuplicateOf = match.ID
+			batch.Duplicates = append(batch.Duplicates, domain.DuplicateMatch{
+				PayoutID:        p.ID,
+				MatchedPayoutID: match.ID,
+				MatchedBatchID:  match.BatchID,
+				MatchedAt:       match.CreatedAt,
+			})
+		}
+
+		if len(batch.Duplicates) == 0 {
+			return nil
+		}
+		slog.Warn("👯 Likely duplicate payouts", "batch_id", batch.ID, "count", len(batch.Duplicates), "policy", s.dupes.Mode)
+		if s.dupes.Mode == domain.DuplicatesBlock {
+			return &domain.DuplicateError{Matches: batch.Duplicates}
+		}
+		return nil
+	}
+}
+
+// ApproveBatch is the checker's half of maker-checker: a different,
+// authorized user releases the batch for execution. approverKeyID is the API
+// key the approver acts through, empty for a signed-in user.
+func (s *PayoutService) ApproveBatch(ctx context.Context, tenantID, batchID, approver, approverKeyID, comment string) (*domain.Batch, error) {
+	b, err := s.pendingBatch(ctx, tenantID, batchID, approver)
+	if err != nil {
+		return nil, err
+	}
+	maker := domain.ApprovalIdentity{Actor: b.SubmittedBy}
+	checker := domain.ApprovalIdentity{Actor: approver}
+	if s.approvers != nil {
+		if maker, err = s.approvers.Resolve(ctx, tenantID, b.SubmittedBy, b.APIKeyID); err != nil {
+			return nil, fmt.Errorf("resolve submitter: %w", err)
+		}
+		if checker, err = s.approvers.Resolve(ctx, tenantID, approver, approverKeyID); err != nil {
+			return nil, fmt.Errorf("resolve approver: %w", err)
+		}
+	}
+	if err := s.approval.CanApprove(maker, checker); err != nil {
+		return nil, err
+	}
+
+	event := domain.BatchEvent{ID: uuid.New().String(), BatchID: batchID, Actor: approver, Action: domain.BatchEventApproved, Comment: comment}
+	if err := s.repo.DecideBatch(ctx, batchID, domain.ApprovalApproved, domain.StatusPending, "", event); err != nil {
+		return nil, err
+	}
+	slog.Info("✅ Batch approved", "batch_id", batchID, "approver", approver)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: approver, Action: domain.AuditBatchApproved, TargetType: "batch", TargetID: batchID,
+		Before: map[string]string{"approval_status": b.ApprovalStatus},
+		After:  map[string]string{"approval_status": domain.ApprovalApproved, "comment": comment},
+	})
+
+	payouts, err := s.repo.ListPayoutsByBatchID(ctx, tenantID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	var pending []domain.Payout
 	for _, p := range payouts {
-		p.BatchID = batchID
-		p.Status = domain.StatusPending
-		p.CreatedAt = time.Now()
-		
-		if err := s.repo.SavePayout(ctx, p); err != nil {
-			return fmt.Errorf("failed to save payout %s: %w", p.ID, err)
+		if p.Status == domain.StatusPending {
+			pending = append(pending, p)
+		}
+	}
+	go func() {
+		_ = s.ExecuteBatch(context.Background(), tenantID, batchID, pending)
+	}()
+	return s.GetBatch(ctx, tenantID, batchID)
+}
+
+// RejectBatch cancels a batch waiting for approval; none of its payouts are
+// sent. Approvers can reject, and so can the submitter (to withdraw it).
+func (s *PayoutService) RejectBatch(ctx context.Context, tenantID, batchID, actor, comment string) (*domain.Batch, error) {
+	b, err := s.pendingBatch(ctx, tenantID, batchID, actor)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(comment) == "" {
+		var errs domain.ValidationErrors
+		errs.Add("comment", "is required when rejecting a batch")
+		return nil, errs
+	}
+	maker, checker := domain.ApprovalIdentity{Actor: b.SubmittedBy}, domain.ApprovalIdentity{Actor: actor}
+	if err := s.approval.CanApprove(maker, checker); err != nil && !errors.Is(err, domain.ErrSelfApproval) {
+		return nil, err
+	}
+
+	event := domain.BatchEvent{ID: uuid.New().String(), BatchID: batchID, Actor: actor, Action: domain.BatchEventRejected, Comment: comment}
+	msg := fmt.Sprintf("batch rejected by %s: %s", actor, comment)
+	if err := s.repo.DecideBatch(ctx, batchID, domain.ApprovalRejected, domain.StatusRejected, msg, event); err != nil {
+		return nil, err
+	}
+	slog.Info("⛔ Batch rejected", "batch_id", batchID, "by", actor)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: actor, Action: domain.AuditBatchRejected, TargetType: "batch", TargetID: batchID,
+		Before: map[string]string{"approval_status": b.ApprovalStatus},
+		After:  map[string]string{"approval_status": domain.ApprovalRejected, "comment": comment},
+	})
+	if payouts, err := s.repo.ListPayoutsByBatchID(ctx, tenantID, batchID); err == nil {
+		for _, p := range payouts {
+			if p.Status == domain.StatusRejected {
+				s.release(ctx, p)
+			}
 		}
 	}
+	go s.notifyBatch(tenantID, batchID, true)
+	return s.GetBatch(ctx, tenantID, batchID)
+}
+
+func (s *PayoutService) pendingBatch(ctx context.Context, tenantID, batchID, actor string) (*domain.Batch, error) {
+	if strings.TrimSpace(actor) == "" {
+		var errs domain.ValidationErrors
+		errs.Add("approver", "is required")
+		return nil, errs
+	}
+	b, err := s.repo.GetBatch(ctx, tenantID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if b.ApprovalStatus != domain.ApprovalPending {
+		return nil, fmt.Errorf("%w: approval status is %s", domain.ErrBatchNotAwaitingApproval, b.ApprovalStatus)
+	}
+	return b, nil
+}
+
+// GetBatch returns the batch with its payouts, aggregate status and approval
+// trail. Batches created before the batches table existed only have payouts.
+// Another tenant's batch is reported as not found.
+func (s *PayoutService) GetBatch(ctx context.Context, tenantID, batchID string) (*domain.Batch, error) {
+	payouts, err := s.ListPayoutsByBatchID(ctx, tenantID, batchID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return nil, domain.ErrBatchNotFound
+		}
+		return nil, err
+	}
+
+	b, err := s.repo.GetBatch(ctx, tenantID, batchID)
+	if errors.Is(err, domain.ErrBatchNotFound) {
+		b = &domain.Batch{ID: batchID, TenantID: tenantID, ApprovalStatus: domain.ApprovalNotRequired}
+	} else if err != nil {
+		return nil, err
+	}
+
+	b.Payouts = payouts
+	b.TotalCount = len(payouts)
+	for _, p := range payouts {
+		b.TotalAmount += p.Amount
+	}
+	b.Status, b.StatusCounts = domain.AggregateStatus(payouts)
+
+	for _, p := range payouts {
+		if p.DuplicateOf == "" {
+			continue
+		}
+		m := domain.DuplicateMatch{PayoutID: p.ID, MatchedPayoutID: p.DuplicateOf}
+		if prev, err := s.repo.GetPayout(ctx, tenantID, p.DuplicateOf); err == nil && prev != nil {
+			m.MatchedBatchID, m.MatchedAt = prev.BatchID, prev.CreatedAt
+		}
+		b.Duplicates = append(b.Duplicates, m)
+	}
+	b.Costs = domain.SummarizeCosts(payouts)
+	return b, nil
+}
+
+// ExecuteBatch is the "Money Maker" function.
+// It takes saved PENDING payouts and fires them in parallel.
+func (s *PayoutService) ExecuteBatch(ctx context.Context, tenantID, batchID string, payouts []domain.Payout) error {
+	slog.Info("🚀 Starting Batch Execution", "batch_id", batchID, "count", len(payouts))
 
 	// 2. Parallel Execution (The "Orchestration")
 	// We use a WaitGroup to handle concurrency
@@ -65,7 +478,7 @@ func (s *PayoutService) ExecuteBatch(ctx context.Context, batchID string, payout
 			semaphore <- struct{}{} 
 			defer func() { <-semaphore }()
 
-			s.processSinglePayout(context.Background(), payout)
+			s.execute(context.Background(), payout, nil)
 		}(p)
 	}
 
@@ -77,30 +490,133 @@ func (s *PayoutService) ExecuteBatch(ctx context.Context, batchID string, payout
 
 	// --- ASYNCHRONOUS CLIENT NOTIFICATION ---
 	// This should not block the main process, so run it in a new goroutine
-	go func() {
-		// 1. Fetch the final state of all payouts in the batch
-		finalPayouts, err := s.repo.ListPayoutsByBatchID(context.Background(), batchID)
-		if err != nil {
-			slog.Error("Failed to fetch final batch state for notification", "batch_id", batchID, "err", err)
+	go s.notifyBatch(tenantID, batchID, false)
+	return nil
+}
+
+// notifyBatch sends the current state of a batch to the client system. With
+// onlySettled it stays quiet while payouts are still in flight or held.
+func (s *PayoutService) notifyBatch(tenantID, batchID string, onlySettled bool) {
+	// 1. Fetch the final state of all payouts in the batch
+	finalPayouts, err := s.repo.ListPayoutsByBatchID(context.Background(), tenantID, batchID)
+	if err != nil {
+		slog.Error("Failed to fetch final batch state for notification", "batch_id", batchID, "err", err)
+		return
+	}
+	if status, _ := domain.AggregateStatus(finalPayouts); onlySettled && (status == domain.BatchProcessing || status == domain.BatchAwaitingReview) {
+		return
+	}
+
+	// 2. Notify the client system
+	if s.notifier != nil {
+		_ = s.notifier.NotifyBatchCompletion(context.Background(), tenantID, batchID, finalPayouts)
+	}
+}
+
+// execute runs the pre-payment checks and pays the payout if none of them
+// wants a human decision. cleared lists the review reasons a reviewer has
+// already approved for this payout; those checks are skipped.
+func (s *PayoutService) execute(ctx context.Context, p domain.Payout, cleared map[string]bool) {
+	if !cleared[domain.ReviewReasonSanctions] {
+		if h, held := s.screen(ctx, p); held {
+			s.hold(ctx, p, h)
 			return
 		}
-		
-		// 2. Notify the client system
-		if s.notifier != nil {
-			_ = s.notifier.NotifyBatchCompletion(context.Background(), batchID, finalPayouts)
+	}
+	if !cleared[domain.ReviewReasonLargeAmount] {
+		if h, held := s.checkAmount(p); held {
+			s.hold(ctx, p, h)
+			return
 		}
-	}()
-	return nil
+	}
+	s.processSinglePayout(ctx, p, !cleared[domain.ReviewReasonNameMismatch])
+}
+
+// enrich fills in the derived fields we store alongside what the client sent.
+func (s *PayoutService) enrich(p *domain.Payout) {
+	if s.banks != nil && p.BankName == "" {
+		if b, ok := s.banks.Lookup(p.CountryCode, p.BankCode); ok {
+			p.BankName = b.Name
+		}
+	}
+	if p.RecipientPhoneE164 == "" && domain.HasNumberingPlan(p.CountryCode) {
+		if e164, err := domain.NormalizePhone(p.RecipientPhone, p.CountryCode); err == nil {
+			p.RecipientPhoneE164 = e164
+		}
+	}
 }
 
-func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout) {
+// screen checks the recipient against the sanctions lists. A hit (or a
+// screening failure) holds the payout for compliance so it never reaches Afriex.
+func (s *PayoutService) screen(ctx context.Context, p domain.Payout) (domain.Hold, bool) {
+	if s.screener == nil {
+		return domain.Hold{}, false
+	}
+	result, err := s.screener.Screen(ctx, p.RecipientName)
+	if err == nil {
+		if verr := s.repo.SetScreeningListVersion(ctx, p.ID, result.ListVersion); verr != nil {
+			slog.Error("Failed to record screening list version", "id", p.ID, "err", verr)
+		}
+	}
+	hold := domain.Hold{Status: domain.StatusHeldCompliance, Reason: domain.ReviewReasonSanctions}
+	switch {
+	case err != nil:
+		hold.Detail = fmt.Sprintf("sanctions screening failed: %v", err)
+	case result.Hit():
+		hold.Detail = result.Summary()
+	default:
+		return domain.Hold{}, false
+	}
+	return hold, true
+}
+
+// checkAmount holds payouts above the corridor's review threshold.
+func (s *PayoutService) checkAmount(p domain.Payout) (domain.Hold, bool) {
+	if s.corridors == nil {
+		return domain.Hold{}, false
+	}
+	limit, over := s.corridors.NeedsReview(p)
+	if !over {
+		return domain.Hold{}, false
+	}
+	return domain.Hold{
+		Status: domain.StatusHeldReview,
+		Reason: domain.ReviewReasonLargeAmount,
+		Detail: fmt.Sprintf("amount %s %s is above the review threshold of %s %s", p.Money(), p.Currency, limit, limit.Currency),
+	}, true
+}
+
+func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout, verifyName bool) {
 	s.repo.UpdatePayoutStatus(ctx, p.ID, domain.StatusProcessing, "")
 
+	// --- STEP 0: NAME ENQUIRY (optional) ---
+	// A typo in an account number pays a stranger; hold anything that doesn't match.
+	if s.names != nil && verifyName {
+		check, err := s.names.Verify(ctx, p)
+		if err != nil {
+			s.hold(ctx, p, domain.Hold{Status: domain.StatusHeldReview, Reason: domain.ReviewReasonNameMismatch, Detail: err.Error()})
+			return
+		}
+		s.repo.SetResolvedAccountName(ctx, p.ID, check.ResolvedName, check.Score)
+		if !check.Matched {
+			s.hold(ctx, p, domain.Hold{
+				Status: domain.StatusHeldReview,
+				Reason: domain.ReviewReasonNameMismatch,
+				Detail: fmt.Sprintf("name mismatch: bank has %q for %q (score %.2f)", check.ResolvedName, p.RecipientName, check.Score),
+			})
+			return
+		}
+	}
+
 	// --- STEP 1: CREATE CUSTOMER ---
+	phone := p.RecipientPhoneE164
+	if phone == "" {
+		phone = p.RecipientPhone // No numbering plan for this country
+	}
 	custID, err := s.gateway.CreateCustomer(ctx, afriex.CreateCustomerRequest{
 		FullName:    p.RecipientName,
 		Email:       "temp_" + p.RecipientTag + "@waya.com", // Fake email if not provided
-		Phone:       p.RecipientPhone,
+		Phone:       phone,
 		CountryCode: p.CountryCode,
 	})
 	if err != nil {
@@ -112,13 +628,14 @@ func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout
 	
 
 	pmID, err := s.gateway.CreatePaymentMethod(ctx, afriex.CreatePaymentMethodRequest{
-		Channel:       "BANK_ACCOUNT", // Or MOBILE_MONEY based on logic
+		Channel:       p.Channel,
 		CustomerID:    custID,
 		AccountName:   p.RecipientName,
 		AccountNumber: p.AccountNumber, // Add to Domain
 		CountryCode:   p.CountryCode,
 		Institution: afriex.Institution{
-			InstitutionCode: p.BankCode, // Add to Domain
+			InstitutionCode: p.BankCode,
+			InstitutionName: p.BankName,
 		},
 	})
 	if err != nil {
@@ -127,8 +644,8 @@ func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout
 	}
 
 	// --- STEP 3: SEND MONEY ---
-	// Convert int64 cents to string "100.50"
-	amountStr := fmt.Sprintf("%.2f", float64(p.Amount)/100.0)
+	// Render minor units exactly, e.g. 10050 NGN -> "100.50", 5000 XOF -> "5000"
+	amountStr := p.Money().String()
 
 	txResp, err := s.gateway.CreateTransaction(ctx, afriex.CreateTransactionRequest{
 		CustomerID:          custID,
@@ -138,6 +655,7 @@ func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout
 		DestinationAmount:   amountStr,
 		Meta: map[string]string{
 			"narration": "Waya Payout - " + p.BatchID,
+			"reference": p.ID, // Lets reconciliation match Afriex exports back to the payout
 		},
 	})
 
@@ -148,17 +666,143 @@ func (s *PayoutService) processSinglePayout(ctx context.Context, p domain.Payout
 
 	// Success!
 	slog.Info("💰 Paid!", "tx_id", txResp.Data.TransactionID)
+	if err := s.repo.SetPayoutTransactionID(ctx, p.ID, txResp.Data.TransactionID); err != nil {
+		slog.Error("Failed to record Afriex transaction ID", "id", p.ID, "tx_id", txResp.Data.TransactionID, "err", err)
+	}
+	s.recordCost(ctx, p, txResp)
 	s.repo.UpdatePayoutStatus(ctx, p.ID, domain.StatusSuccess, "")
+	p.Status = domain.StatusSuccess
+	s.settle(ctx, p)
 }
 
 func (s *PayoutService) handleError(ctx context.Context, p domain.Payout, msg string, err error) {
 	slog.Error(msg, "id", p.ID, "err", err)
 	s.repo.UpdatePayoutStatus(ctx, p.ID, domain.StatusFailed, fmt.Sprintf("%s: %v", msg, err))
+	p.Status = domain.StatusFailed
+	s.release(ctx, p)
+}
+
+// recordCost stores what Afriex actually debited for a paid payout. The money
+// has moved either way, so an unreadable amount is logged, not a failure.
+func (s *PayoutService) recordCost(ctx context.Context, p domain.Payout, tx *afriex.TransactionResponse) {
+	source, err := domain.ParseMoneyRounded(tx.Data.SourceAmount, SourceCurrency)
+	if err != nil {
+		slog.Warn("Afriex returned an unreadable source amount", "id", p.ID, "source_amount", tx.Data.SourceAmount, "err", err)
+		return
+	}
+	fee := domain.NewMoney(0, SourceCurrency)
+	if tx.Data.Fee != "" {
+		if fee, err = domain.ParseMoneyRounded(tx.Data.Fee, SourceCurrency); err != nil {
+			slog.Warn("Afriex returned an unreadable fee", "id", p.ID, "fee", tx.Data.Fee, "err", err)
+			fee = domain.NewMoney(0, SourceCurrency)
+		}
+	}
+	cost := domain.PayoutCost{Source: source, Fee: fee, EffectiveRate: domain.EffectiveRate(p.Money(), source)}
+	if err := s.repo.SetPayoutCost(ctx, p.ID, cost); err != nil {
+		slog.Error("Failed to record payout cost", "id", p.ID, "err", err)
+	}
+}
+
+// settle and release close the payout's ledger reservation. The payout's
+// status is already final, so a ledger failure is logged rather than returned.
+func (s *PayoutService) settle(ctx context.Context, p domain.Payout) {
+	if s.ledger == nil {
+		return
+	}
+	if err := s.ledger.Settle(ctx, p.TenantID, p); err != nil {
+		slog.Error("Failed to settle payout in ledger", "id", p.ID, "err", err)
+	}
+}
+
+func (s *PayoutService) release(ctx context.Context, p domain.Payout) {
+	if s.ledger == nil {
+		return
+	}
+	if err := s.ledger.Release(ctx, p.TenantID, p); err != nil {
+		slog.Error("Failed to release payout in ledger", "id", p.ID, "err", err)
+	}
+}
+
+// hold parks a payout for manual review instead of paying it. The detail
+// can quote the recipient's and resolved account names, so it goes only on
+// the review, which is encrypted; the payout's error message names the
+// reason alone.
+func (s *PayoutService) hold(ctx context.Context, p domain.Payout, h domain.Hold) {
+	slog.Warn("✋ Payout held for review", "id", p.ID, "status", h.Status, "reason", h.Reason, "detail", h.Detail)
+	if s.reviews != nil {
+		err := s.reviews.CreateReview(ctx, domain.Review{
+			ID:       uuid.New().String(),
+			PayoutID: p.ID,
+			BatchID:  p.BatchID,
+			Reason:   h.Reason,
+			Detail:   h.Detail,
+			Status:   domain.ReviewPending,
+		})
+		if err != nil {
+			slog.Error("Failed to queue review", "id", p.ID, "err", err)
+		}
+	}
+	s.repo.UpdatePayoutStatus(ctx, p.ID, h.Status, "held for review: "+h.Reason)
+}
+
+// Resume sends a held payout back through the pipeline after a reviewer
+// approved it. Checks a reviewer has already signed off are not re-run, so a
+// payout held twice (say, large amount then name mismatch) needs two approvals.
+func (s *PayoutService) Resume(ctx context.Context, tenantID, payoutID string) error {
+	p, err := s.repo.GetPayout(ctx, tenantID, payoutID)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("payout %s not found", payoutID)
+	}
+	if !p.IsHeld() {
+		return fmt.Errorf("%w: payout %s is %s", domain.ErrPayoutNotHeld, payoutID, p.Status)
+	}
+
+	cleared := make(map[string]bool)
+	if s.reviews != nil {
+		reasons, err := s.reviews.ApprovedReviewReasons(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		for _, r := range reasons {
+			cleared[r] = true
+		}
+	}
+
+	if err := s.repo.ReleaseHeldPayout(ctx, p.ID, domain.StatusPending, ""); err != nil {
+		return fmt.Errorf("resume payout %s: %w", p.ID, err)
+	}
+	slog.Info("▶️ Resuming reviewed payout", "id", p.ID, "cleared", len(cleared))
+	p.Status = domain.StatusPending
+	s.execute(ctx, *p, cleared)
+	s.notifyBatch(tenantID, p.BatchID, true)
+	return nil
+}
+
+// Reject ends a held payout without paying it. A payout that is no longer
+// held fails with domain.ErrPayoutNotHeld.
+func (s *PayoutService) Reject(ctx context.Context, tenantID, payoutID, reviewer, reason string) error {
+	p, err := s.repo.GetPayout(ctx, tenantID, payoutID)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("payout %s not found", payoutID)
+	}
+	if err := s.repo.ReleaseHeldPayout(ctx, p.ID, domain.StatusRejected, fmt.Sprintf("rejected by %s: %s", reviewer, reason)); err != nil {
+		return err
+	}
+	p.Status = domain.StatusRejected
+	s.release(ctx, *p)
+	go s.notifyBatch(tenantID, p.BatchID, true)
+	return nil
 }
 
 // ListPayoutsByBatchID fetches all payouts belonging to a single batch.
-func (s *PayoutService) ListPayoutsByBatchID(ctx context.Context, batchID string) ([]domain.Payout, error) {
-	allPayouts, err := s.repo.ListPayoutsByBatchID(ctx, batchID)
+func (s *PayoutService) ListPayoutsByBatchID(ctx context.Context, tenantID, batchID string) ([]domain.Payout, error) {
+	allPayouts, err := s.repo.ListPayoutsByBatchID(ctx, tenantID, batchID)
 	if err != nil {
 		return nil, err
 	}
@@ -177,26 +821,47 @@ func (s *PayoutService) ListPayoutsByBatchID(ctx context.Context, batchID string
 	return batchPayouts, nil
 }
 
-func (s *PayoutService) ListPayouts(ctx context.Context, limit int) ([]domain.Payout, error) {
-    // NOTE: If your SQLC query does not accept 'limit', this might return all rows.
-    rows, err := s.repo.ListPayouts(ctx, limit) 
-    
-    var payouts []domain.Payout
-	for _, r := range rows {
-		payouts = append(payouts, domain.Payout{	
-			ID:             r.ID,
-			BatchID:        r.BatchID,
-			RecipientName:   r.RecipientName,
-			RecipientEmail:  r.RecipientEmail,
-			RecipientPhone:  r.RecipientPhone,
-			AccountNumber:   r.AccountNumber,
-			Currency:        r.Currency,
-			Amount:          r.Amount,
-			Status:          r.Status,
-			ErrorMessage:    r.ErrorMessage,
-			CreatedAt:       r.CreatedAt,
-		})
-	}
+func (s *PayoutService) ListPayouts(ctx context.Context, tenantID string, limit int) ([]domain.Payout, error) {
+	return s.repo.ListPayouts(ctx, tenantID, limit)
+}
+
+// Most payouts a recipient search returns
+const maxRecipientSearch = 500
 
-	return payouts, err
-}
\ No newline at end of file
+// FindPayoutsByRecipient lists the tenant's payouts to an account number or
+// phone, newest first. Recipient details are stored encrypted, so both
+// match exactly; a phone without its + country code is read with the
+// numbering plan of country.
+func (s *PayoutService) FindPayoutsByRecipient(ctx context.Context, tenantID, accountNumber, phone, country string, limit int) ([]domain.Payout, error) {
+	var errs domain.ValidationErrors
+	lookup := domain.RecipientLookup{AccountNumber: strings.TrimSpace(accountNumber)}
+	phone = strings.TrimSpace(phone)
+	switch {
+	case phone == "":
+	case country != "":
+		e164, err := domain.NormalizePhone(phone, strings.ToUpper(strings.TrimSpace(country)))
+		if err != nil {
+			errs.Add("phone", "%s", err.Error())
+		}
+		lookup.Phone = e164
+	case strings.HasPrefix(phone, "+"):
+		lookup.Phone = "+" + strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, phone)
+	default:
+		errs.Add("country", "is required for a phone number without its + country code")
+	}
+	if lookup.AccountNumber == "" && phone == "" {
+		errs.Add("account_number", "or phone is required")
+	}
+	if limit <= 0 || limit > maxRecipientSearch {
+		errs.Add("limit", "must be between 1 and %d", maxRecipientSearch)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return s.repo.ListPayoutsByRecipient(ctx, tenantID, lookup, limit)
+}
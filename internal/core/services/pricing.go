@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// PricingEngine prices Waya's own fee per payout from the tenant's fee
+// schedule. Fees are charged in the payout currency.
+type PricingEngine struct {
+	schedules *domain.FeeSchedules
+}
+
+func NewPricingEngine(schedules *domain.FeeSchedules) *PricingEngine {
+	return &PricingEngine{schedules: schedules}
+}
+
+// Fee is what the tenant pays for one payout; zero when no rule applies.
+func (e *PricingEngine) Fee(tenantID string, p domain.Payout) domain.Money {
+	rule, ok := e.schedules.Rule(tenantID, p.CountryCode, p.Currency)
+	if !ok {
+		return domain.NewMoney(0, p.Currency)
+	}
+	return rule.Fee(p.Money())
+}
+
+// Price sets ServiceFee on every payout of a batch about to be saved.
+func (e *PricingEngine) Price(tenantID string, payouts []domain.Payout) {
+	for i := range payouts {
+		payouts[i].ServiceFee = e.Fee(tenantID, payouts[i]).Amount
+	}
+}
+
+// Schedule returns the rules a tenant is billed by.
+func (e *PricingEngine) Schedule(tenantID string) domain.FeeSchedule {
+	return e.schedules.Schedule(tenantID)
+}
+
+// BillingService turns the fees posted to the ledger into monthly invoices.
+type BillingService struct {
+	store ports.BillingStore
+}
+
+func NewBillingService(store ports.BillingStore) *BillingService {
+	return &BillingService{store: store}
+}
+
+// Invoice bills a tenant for a calendar month given as YYYY-MM. Fees are
+// charged when payouts settle, so the invoice only ever grows until the month
+// is over.
+func (s *BillingService) Invoice(ctx context.Context, tenantID, month string) (*domain.Invoice, error) {
+	start, err := time.Parse("2006-01", strings.TrimSpace(month))
+	if err != nil {
+		var errs domain.ValidationErrors
+		errs.Add("month", "must be YYYY-MM")
+		return nil, errs
+	}
+	from, to := domain.MonthWindow(start)
+	charges, err := s.store.ListFeeCharges(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("list fee charges: %w", err)
+	}
+	inv := domain.NewInvoice(tenantID, start, charges, time.Now().UTC())
+	return &inv, nil
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// ruleCacheTTL is how long a tenant's limits are used before being re-read,
+// so changes made by the tenants command apply without a restart.
+const ruleCacheTTL = 30 * time.Second
+
+// bucketSweepInterval is how often buckets that have refilled are dropped
+const bucketSweepInterval = 5 * time.Minute
+
+// RateLimiter meters each API key's requests per route with token buckets
+// and caps the payout items a tenant, and each of its keys, submits per UTC
+// day. Buckets are kept in memory, so every server instance enforces the
+// request limits on its own; quotas are counted in the database.
+type RateLimiter struct {
+	store      ports.RateLimitStore
+	defaults   []domain.RateLimitRule
+	dailyQuota int // Per tenant without a quota of its own; 0 is unlimited
+	audit      *AuditService
+	logger     *slog.Logger
+	now        func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*domain.TokenBucket // By key ID and route
+	tenants   map[string]tenantLimits
+	lastSweep time.Time
+}
+
+type tenantLimits struct {
+	rules    []domain.RateLimitRule
+	quotas   []domain.PayoutQuota
+	loadedAt time.Time
+}
+
+// NewRateLimiter applies defaults, rules without a TenantID, to tenants
+// and keys without limits of their own.
+func NewRateLimiter(store ports.RateLimitStore, defaults []domain.RateLimitRule, dailyQuota int, audit *AuditService, logger *slog.Logger) *RateLimiter {
+	return &RateLimiter{
+		store:      store,
+		defaults:   defaults,
+		dailyQuota: dailyQuota,
+		audit:      audit,
+		logger:     logger,
+		now:        time.Now,
+		buckets:    make(map[string]*domain.TokenBucket),
+		tenants:    make(map[string]tenantLimits),
+	}
+}
+
+// Allow takes a token from the bucket of the principal's key, or signed-in
+// user, for the route, sized by the closest matching rule. When no rule applies the request is
+// allowed with a zero Limit.
+func (s *RateLimiter) Allow(ctx context.Context, p *domain.Principal, route string) (domain.RateDecision, error) {
+	limits, err := s.limitsFor(ctx, p.Tenant.ID)
+	if err != nil {
+		return domain.RateDecision{}, err
+	}
+	subject := p.Subject()
+	rule, ok := domain.MatchRateLimit(slices.Concat(limits.rules, s.defaults), subject, route)
+	if !ok {
+		return domain.RateDecision{Allowed: true}, nil
+	}
+
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+
+	id := subject + " " + route
+	b, ok := s.buckets[id]
+	if !ok {
+		b = domain.NewTokenBucket(rule.RateLimit, now)
+		s.buckets[id] = b
+	} else {
+		b.SetLimit(rule.RateLimit)
+	}
+	d := b.Take(now)
+	if !d.Allowed {
+		s.logger.Warn("🚦 Rate limited", "tenant_id", p.Tenant.ID, "subject", subject, "route", route, "per_minute", rule.PerMinute, "burst", rule.Burst)
+	}
+	return d, nil
+}
+
+// sweep forgets full buckets, which a new bucket would reproduce exactly.
+// Callers hold s.mu.
+func (s *RateLimiter) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < bucketSweepInterval {
+		return
+	}
+	for id, b := range s.buckets {
+		if b.Full(now) {
+			delete(s.buckets, id)
+		}
+	}
+	s.lastSweep = now
+}
+
+// QuotaGuard refuses a batch that would take its tenant, or the key that
+// submits it, over the daily payout quota, with a *domain.QuotaError. It
+// runs in the transaction that saves the batch, so two batches cannot both
+// squeeze under a quota.
+func (s *RateLimiter) QuotaGuard(batch domain.Batch) ports.BatchGuard {
+	return func(ctx context.Context, tx ports.BatchTx) error {
+		limits, err := s.limitsFor(ctx, batch.TenantID)
+		if err != nil {
+			return err
+		}
+		tenantQuota, keyQuota := s.dailyQuota, 0
+		for _, q := range limits.quotas {
+			switch {
+			case q.KeyID == "":
+				tenantQuota = q.DailyItems
+			case q.KeyID == batch.APIKeyID:
+				keyQuota = q.DailyItems
+			}
+		}
+
+		dayStart, dayEnd := domain.DayWindow(s.now())
+		check := func(scope, keyID string, quota int) error {
+			if quota <= 0 {
+				return nil
+			}
+			used, err := tx.PayoutItemsSince(ctx, batch.TenantID, keyID, dayStart)
+			if err != nil {
+				return err
+			}
+			if int(used)+len(batch.Payouts) > quota {
+				return &domain.QuotaError{Scope: scope, Quota: quota, Used: int(used), Requested: len(batch.Payouts), ResetAt: dayEnd}
+			}
+			return nil
+		}
+		if err := check(domain.QuotaScopeTenant, "", tenantQuota); err != nil {
+			return err
+		}
+		if batch.APIKeyID != "" {
+			return check(domain.QuotaScopeAPIKey, batch.APIKeyID, keyQuota)
+		}
+		return nil
+	}
+}
+
+func (s *RateLimiter) limitsFor(ctx context.Context, tenantID string) (tenantLimits, error) {
+	s.mu.Lock()
+	cached, ok := s.tenants[tenantID]
+	s.mu.Unlock()
+	if ok && s.now().Sub(cached.loadedAt) < ruleCacheTTL {
+		return cached, nil
+	}
+
+	rules, err := s.store.ListRateLimits(ctx, tenantID)
+	if err != nil {
+		return tenantLimits{}, err
+	}
+	quotas, err := s.store.ListPayoutQuotas(ctx, tenantID)
+	if err != nil {
+		return tenantLimits{}, err
+	}
+	limits := tenantLimits{rules: rules, quotas: quotas, loadedAt: s.now()}
+	s.mu.Lock()
+	s.tenants[tenantID] = limits
+	s.mu.Unlock()
+	return limits, nil
+}
+
+// Rules lists the limits set for the tenant and its keys, without the defaults.
+func (s *RateLimiter) Rules(ctx context.Context, tenantID string) ([]domain.RateLimitRule, error) {
+	return s.store.ListRateLimits(ctx, tenantID)
+}
+
+// Quotas lists the payout quotas set for the tenant and its keys.
+func (s *RateLimiter) Quotas(ctx context.Context, tenantID string) ([]domain.PayoutQuota, error) {
+	return s.store.ListPayoutQuotas(ctx, tenantID)
+}
+
+// SetRateLimit sets a tenant's limit, or with a keyID one key's. An empty
+// route means RouteAny; a zero limit removes the rule.
+func (s *RateLimiter) SetRateLimit(ctx context.Context, rule domain.RateLimitRule) error {
+	rule.Route = strings.TrimSpace(rule.Route)
+	if rule.Route == "" {
+		rule.Route = domain.RouteAny
+	}
+	removing := rule.RateLimit == (domain.RateLimit{})
+	if !removing {
+		if err := rule.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	rules, err := s.store.ListRateLimits(ctx, rule.TenantID)
+	if err != nil {
+		return err
+	}
+	var before any
+	for _, r := range rules {
+		if r.KeyID == rule.KeyID && r.Route == rule.Route {
+			before = map[string]int{"per_minute": r.PerMinute, "burst": r.Burst}
+		}
+	}
+
+	var after any
+	if removing {
+		if err := s.store.DeleteRateLimit(ctx, rule.TenantID, rule.KeyID, rule.Route); err != nil {
+			return err
+		}
+		s.logger.Info("🚦 Rate limit removed", "tenant_id", rule.TenantID, "key_id", rule.KeyID, "route", rule.Route)
+	} else {
+		if err := s.store.SetRateLimit(ctx, rule); err != nil {
+			return err
+		}
+		s.logger.Info("🚦 Rate limit set", "tenant_id", rule.TenantID, "key_id", rule.KeyID, "route", rule.Route, "per_minute", rule.PerMinute, "burst", rule.Burst)
+		after = map[string]int{"per_minute": rule.PerMinute, "burst": rule.Burst}
+	}
+	s.forget(rule.TenantID)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: rule.TenantID, Action: domain.AuditRateLimitSet, TargetType: "rate_limit", TargetID: limitTarget(rule.TenantID, rule.KeyID, rule.Route),
+		Before: before,
+		After:  after,
+	})
+	return nil
+}
+
+// SetPayoutQuota caps the tenant's daily payout items, or with a keyID one
+// key's. Zero removes the quota, leaving the tenant on the default.
+func (s *RateLimiter) SetPayoutQuota(ctx context.Context, quota domain.PayoutQuota) error {
+	if quota.DailyItems < 0 {
+		var errs domain.ValidationErrors
+		errs.Add("daily_items", "must not be negative")
+		return errs
+	}
+	quotas, err := s.store.ListPayoutQuotas(ctx, quota.TenantID)
+	if err != nil {
+		return err
+	}
+	var before, after any
+	for _, q := range quotas {
+		if q.KeyID == quota.KeyID {
+			before = map[string]int{"daily_items": q.DailyItems}
+		}
+	}
+
+	if quota.DailyItems == 0 {
+		if err := s.store.DeletePayoutQuota(ctx, quota.TenantID, quota.KeyID); err != nil {
+			return err
+		}
+	} else if err := s.store.SetPayoutQuota(ctx, quota); err != nil {
+		return err
+	} else {
+		after = map[string]int{"daily_items": quota.DailyItems}
+	}
+	s.logger.Info("🚦 Payout quota set", "tenant_id", quota.TenantID, "key_id", quota.KeyID, "daily_items", quota.DailyItems)
+	s.forget(quota.TenantID)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: quota.TenantID, Action: domain.AuditPayoutQuotaSet, TargetType: "payout_quota", TargetID: limitTarget(quota.TenantID, quota.KeyID, ""),
+		Before: before,
+		After:  after,
+	})
+	return nil
+}
+
+// limitTarget names a limit or quota in the audit log: the tenant or key it
+// applies to, and the route if any, e.g. "payroll-ng POST /api/v1/payouts".
+func limitTarget(tenantID, keyID, route string) string {
+	target := tenantID
+	if keyID != "" {
+		target = keyID
+	}
+	if route != "" {
+		target += " " + route
+	}
+	return target
+}
+
+func (s *RateLimiter) forget(tenantID string) {
+	s.mu.Lock()
+	delete(s.tenants, tenantID)
+	s.mu.Unlock()
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// ReconciliationService proves SUCCESS payouts really settled by matching
+// them against Afriex transaction exports. Payouts the export shows as
+// returned by the bank are reversed.
+type ReconciliationService struct {
+	store   ports.ReconciliationStore
+	parse   ports.ExportParser
+	payouts *PayoutService
+	logger  *slog.Logger
+}
+
+func NewReconciliationService(store ports.ReconciliationStore, parse ports.ExportParser, payouts *PayoutService, logger *slog.Logger) *ReconciliationService {
+	return &ReconciliationService{
+		store:   store,
+		parse:   parse,
+		payouts: payouts,
+		logger:  logger,
+	}
+}
+
+// Import reconciles one export and stores the run. format is "csv", "json" or
+// empty to sniff. A zero from or to is taken from the export's dates, widened
+// to whole UTC days.
+func (s *ReconciliationService) Import(ctx context.Context, source, format string, r io.Reader, from, to time.Time) (*domain.ReconciliationRun, error) {
+	records, err := s.parse(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.IsZero() || to.IsZero() {
+		first, last := exportSpan(records)
+		if first.IsZero() {
+			var errs domain.ValidationErrors
+			errs.Add("from", "is required when the export has no dates")
+			return nil, errs
+		}
+		if from.IsZero() {
+			from = first.Truncate(24 * time.Hour)
+		}
+		if to.IsZero() {
+			to = last.Truncate(24*time.Hour).AddDate(0, 0, 1)
+		}
+	}
+	if !from.Before(to) {
+		var errs domain.ValidationErrors
+		errs.Add("to", "must be after from")
+		return nil, errs
+	}
+
+	payouts, err := s.payoutsFor(ctx, records, from, to)
+	if err != nil {
+		return nil, err
+	}
+	matched, exceptions := domain.Reconcile(records, payouts, from, to)
+
+	run := domain.ReconciliationRun{
+		ID:         uuid.New().String(),
+		Source:     source,
+		From:       from.UTC(),
+		To:         to.UTC(),
+		Records:    len(records),
+		Matched:    matched,
+		CreatedAt:  time.Now().UTC(),
+		Exceptions: exceptions,
+	}
+	for i := range run.Exceptions {
+		run.Exceptions[i].ID = uuid.New().String()
+		run.Exceptions[i].RunID = run.ID
+	}
+	run.Count()
+	if err := s.store.SaveReconciliation(ctx, run); err != nil {
+		return nil, fmt.Errorf("save reconciliation: %w", err)
+	}
+	s.reverse(ctx, run)
+
+	log := s.logger.Info
+	if len(exceptions) > 0 {
+		log = s.logger.Warn
+	}
+	log("🧾 Reconciliation finished", "run_id", run.ID, "source", source, "records", run.Records, "matched", run.Matched,
+		"missing_ours", run.MissingOurs, "missing_theirs", run.MissingTheirs, "amount_mismatches", run.AmountMismatches, "reversed", run.Reversed)
+	return &run, nil
+}
+
+// reverse applies the reversals the run found. The exception stays on the
+// run as the record of where the reversal came from.
+func (s *ReconciliationService) reverse(ctx context.Context, run domain.ReconciliationRun) {
+	if s.payouts == nil {
+		return
+	}
+	for _, e := range run.Exceptions {
+		if e.Result != domain.ReconReversed {
+			continue
+		}
+		_, err := s.payouts.ReversePayout(ctx, e.PayoutID, domain.Reversal{
+			Source: domain.ReversalSourceReconciliation,
+			Reason: e.Detail,
+			Actor:  "reconciliation " + run.ID,
+		})
+		if err != nil {
+			s.logger.Error("Failed to reverse payout from reconciliation", "run_id", run.ID, "payout_id", e.PayoutID, "err", err)
+		}
+	}
+}
+
+// payoutsFor loads our SUCCESS payouts in the window plus any payout outside
+// it that a record points at.
+func (s *ReconciliationService) payoutsFor(ctx context.Context, records []domain.ProviderRecord, from, to time.Time) ([]domain.Payout, error) {
+	payouts, err := s.store.ListSuccessfulPayouts(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("list payouts: %w", err)
+	}
+	known := make(map[string]bool, 2*len(payouts))
+	for _, p := range payouts {
+		known[p.ID] = true
+		if p.TransactionID != "" {
+			known[p.TransactionID] = true
+		}
+	}
+
+	for _, r := range records {
+		if known[r.TransactionID] || known[r.Reference] {
+			continue
+		}
+		var p *domain.Payout
+		if r.TransactionID != "" {
+			if p, err = s.store.FindPayoutByTransactionID(ctx, r.TransactionID); err != nil {
+				return nil, err
+			}
+		}
+		if p == nil && r.Reference != "" {
+			if p, err = s.store.FindPayoutByID(ctx, r.Reference); err != nil {
+				return nil, err
+			}
+		}
+		if p == nil || known[p.ID] {
+			continue
+		}
+		known[p.ID] = true
+		if p.TransactionID != "" {
+			known[p.TransactionID] = true
+		}
+		payouts = append(payouts, *p)
+	}
+	return payouts, nil
+}
+
+func exportSpan(records []domain.ProviderRecord) (first, last time.Time) {
+	for _, r := range records {
+		if r.CreatedAt.IsZero() {
+			continue
+		}
+		if first.IsZero() || r.CreatedAt.Before(first) {
+			first = r.CreatedAt
+		}
+		if r.CreatedAt.After(last) {
+			last = r.CreatedAt
+		}
+	}
+	return first, last
+}
+
+func (s *ReconciliationService) Get(ctx context.Context, id string) (*domain.ReconciliationRun, error) {
+	return s.store.GetReconciliation(ctx, id)
+}
+
+func (s *ReconciliationService) List(ctx context.Context, limit int) ([]domain.ReconciliationRun, error) {
+	return s.store.ListReconciliations(ctx, limit)
+}
+
+// WatchDir imports every .csv and .json export dropped into dir, then moves
+// it to dir/processed (or dir/failed). It runs until ctx is cancelled.
+func (s *ReconciliationService) WatchDir(ctx context.Context, dir string, interval time.Duration) {
+	s.importDir(ctx, dir)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.importDir(ctx, dir)
+		}
+	}
+}
+
+func (s *ReconciliationService) importDir(ctx context.Context, dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		s.logger.Error("Failed to read reconciliation directory", "dir", dir, "err", err)
+		return
+	}
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if e.IsDir() || (ext != ".csv" && ext != ".json") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		dest := "processed"
+		if err := s.importFile(ctx, path, ext[1:]); err != nil {
+			s.logger.Error("Failed to import Afriex export", "file", e.Name(), "err", err)
+			dest = "failed"
+		}
+		if err := moveInto(path, filepath.Join(dir, dest)); err != nil {
+			s.logger.Error("Failed to move Afriex export", "file", e.Name(), "err", err)
+		}
+	}
+}
+
+func (s *ReconciliationService) importFile(ctx context.Context, path, format string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = s.Import(ctx, filepath.Base(path), format, f, time.Time{}, time.Time{})
+	return err
+}
+
+func moveInto(path, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.Rename(path, filepath.Join(dir, filepath.Base(path)))
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// Payouts erased per transaction by the retention job
+const retentionPage = 200
+
+// RetentionService erases recipient details from payouts, when a recipient
+// asks or once the retention period has passed. The payouts themselves stay:
+// amounts, statuses, references and ledger postings are the financial
+// record.
+type RetentionService struct {
+	store     ports.ErasureStore
+	retention time.Duration // 0 keeps details until a recipient asks
+	audit     *AuditService
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+func NewRetentionService(store ports.ErasureStore, retention time.Duration, audit *AuditService, logger *slog.Logger) *RetentionService {
+	return &RetentionService{
+		store:     store,
+		retention: retention,
+		audit:     audit,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// EraseRecipient erases the details of the recipient of payoutID from that
+// payout and from every other payout of the tenant to the same account
+// (country, bank and account number) or phone. Payouts not yet finished
+// keep their details, which they still need, and are listed as live.
+// Erasing a recipient already erased erases nothing and is not an error.
+func (s *RetentionService) EraseRecipient(ctx context.Context, tenantID, payoutID, actor string) (*domain.Erasure, error) {
+	p, err := s.store.GetPayout(ctx, tenantID, strings.TrimSpace(payoutID))
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, domain.ErrPayoutNotFound
+	}
+
+	// The phone as its blind index was computed, see PayoutService.FindPayoutsByRecipient
+	phone := p.RecipientPhoneE164
+	if phone == "" {
+		phone = p.RecipientPhone
+	}
+	at := s.now().UTC()
+	ids, live, err := s.store.EraseRecipient(ctx, tenantID, p.ID, p.Recipient(), phone, at)
+	if err != nil {
+		return nil, err
+	}
+	erasure := &domain.Erasure{TenantID: tenantID, PayoutID: p.ID, PayoutIDs: ids, LivePayoutIDs: live, ErasedAt: p.PIIErasedAt}
+	if slices.Contains(ids, p.ID) {
+		erasure.ErasedAt = at
+	}
+	if len(live) > 0 {
+		s.logger.Warn("🧹 Recipient has payouts still in flight; their details are kept", "tenant_id", tenantID, "payout_id", p.ID, "live", len(live))
+	}
+	if len(ids) == 0 {
+		return erasure, nil
+	}
+
+	s.logger.Info("🧹 Recipient details erased", "tenant_id", tenantID, "payout_id", p.ID, "payouts", len(ids), "by", actor)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: actor, Action: domain.AuditRecipientErased, TargetType: "recipient", TargetID: p.ID,
+		After: map[string]any{"payout_ids": ids, "live_payout_ids": live},
+	})
+	return erasure, nil
+}
+
+// EraseExpired erases the recipient details of finished payouts older than
+// the retention period, and records one audit entry per tenant. It returns
+// how many payouts were erased.
+func (s *RetentionService) EraseExpired(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	at := s.now().UTC()
+	cutoff := at.Add(-s.retention)
+
+	perTenant := make(map[string]int)
+	total := 0
+	var err error
+	for {
+		var erased map[string]int
+		if erased, err = s.store.EraseExpired(ctx, cutoff, at, retentionPage); err != nil {
+			break
+		}
+		n := 0
+		for tenant, count := range erased {
+			perTenant[tenant] += count
+			n += count
+		}
+		total += n
+		if n < retentionPage {
+			break
+		}
+	}
+
+	// Whatever was erased before a failure is recorded all the same
+	for tenant, count := range perTenant {
+		s.audit.Record(ctx, domain.AuditEvent{
+			TenantID: tenant, Actor: "retention", Action: domain.AuditRetentionErased, TargetType: "retention", TargetID: cutoff.Format(time.RFC3339),
+			After: map[string]any{"payouts": count, "created_before": cutoff.Format(time.RFC3339)},
+		})
+	}
+	if total > 0 {
+		s.logger.Info("🧹 Expired recipient details erased", "payouts", total, "created_before", cutoff)
+	}
+	return total, err
+}
+
+// RunEvery erases expired details now and then at every interval, until
+// ctx is cancelled.
+func (s *RetentionService) RunEvery(ctx context.Context, interval time.Duration) {
+	s.run(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+func (s *RetentionService) run(ctx context.Context) {
+	if _, err := s.EraseExpired(ctx); err != nil {
+		s.logger.Error("Failed to erase expired recipient details", "err", err)
+	}
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"waya/internal/core/domain"
+)
+
+// ReversePayout marks a SUCCESS payout REVERSED after the bank returned it,
+// credits the tenant back and tells the client. Reporting the same reversal
+// again (a webhook retry, then the reconciliation import) returns the payout
+// unchanged, but retries the credit if it failed the first time; a failed
+// credit is returned, so the webhook asks Afriex to deliver again. Reversals
+// come from Afriex or the operator, so the payout is looked up across tenants.
+func (s *PayoutService) ReversePayout(ctx context.Context, payoutID string, rev domain.Reversal) (*domain.Payout, error) {
+	if rev.Source == domain.ReversalSourceAdmin && strings.TrimSpace(rev.Actor) == "" {
+		var errs domain.ValidationErrors
+		errs.Add("actor", "is required")
+		return nil, errs
+	}
+	p, err := s.repo.FindPayoutByID(ctx, payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, domain.ErrPayoutNotFound
+	}
+	if p.Status == domain.StatusReversed {
+		if err := s.creditReversal(ctx, *p); err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+
+	if err := s.repo.ReversePayout(ctx, p.ID, rev); err != nil {
+		if errors.Is(err, domain.ErrNotReversible) {
+			return nil, fmt.Errorf("%w: payout %s is %s", err, p.ID, p.Status)
+		}
+		return nil, err
+	}
+	slog.Warn("↩️ Payout reversed", "id", p.ID, "batch_id", p.BatchID, "source", rev.Source, "reason", rev.Reason, "by", rev.Actor)
+	actor := rev.Actor
+	if rev.Source != domain.ReversalSourceAdmin {
+		actor = strings.ToLower(rev.Source)
+	}
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: p.TenantID, Actor: actor, Action: domain.AuditPayoutReversed, TargetType: "payout", TargetID: p.ID,
+		Before: map[string]string{"status": p.Status},
+		After:  map[string]string{"status": domain.StatusReversed, "source": rev.Source, "reason": rev.Reason},
+	})
+
+	p.Status = domain.StatusReversed
+	p.ReversalSource, p.ReversalReason, p.ReversedBy = rev.Source, rev.Reason, rev.Actor
+	p.ReversedAt = time.Now().UTC()
+	if s.notifier != nil {
+		go func(p domain.Payout) {
+			_ = s.notifier.NotifyPayoutReversed(context.Background(), p)
+		}(*p)
+	}
+	if err := s.creditReversal(ctx, *p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// creditReversal credits a reversed payout back to the tenant. The ledger
+// skips payouts already credited, so it runs on every report of the reversal.
+func (s *PayoutService) creditReversal(ctx context.Context, p domain.Payout) error {
+	if s.ledger == nil {
+		return nil
+	}
+	if err := s.ledger.Reverse(ctx, p.TenantID, p); err != nil {
+		slog.Error("Failed to credit reversed payout in ledger", "id", p.ID, "err", err)
+		return fmt.Errorf("credit reversed payout: %w", err)
+	}
+	return nil
+}
+
+// ReverseByTransaction reverses the payout paid by an Afriex transaction. It
+// returns domain.ErrPayoutNotFound when no payout has that transaction.
+func (s *PayoutService) ReverseByTransaction(ctx context.Context, transactionID string, rev domain.Reversal) (*domain.Payout, error) {
+	p, err := s.repo.FindPayoutByTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, domain.ErrPayoutNotFound
+	}
+	return s.ReversePayout(ctx, p.ID, rev)
+}
+
+// ReissuePayout re-sends a reversed payout to corrected bank details. The
+// replacement goes through the same checks, approval, limits and funding as
+// any new batch and links back to the original through ReissueOf. A reversed
+// payout can be re-issued once, into a batch of the original's tenant.
+func (s *PayoutService) ReissuePayout(ctx context.Context, tenantID, payoutID string, fix domain.Reissue) (*domain.Batch, error) {
+	original, err := s.repo.GetPayout(ctx, tenantID, payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if original == nil {
+		return nil, domain.ErrPayoutNotFound
+	}
+	if original.Status != domain.StatusReversed {
+		return nil, fmt.Errorf("%w: payout %s is %s", domain.ErrNotReissuable, original.ID, original.Status)
+	}
+	if original.ReissuedAs != "" {
+		return nil, fmt.Errorf("%w as %s", domain.ErrAlreadyReissued, original.ReissuedAs)
+	}
+	if !original.PIIErasedAt.IsZero() {
+		return nil, fmt.Errorf("%w: payout %s cannot be re-issued", domain.ErrRecipientErased, original.ID)
+	}
+
+	replacement := fix.Apply(uuid.New().String(), *original)
+	if err := s.ValidateBatch(ctx, []domain.Payout{replacement}); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ClaimReissue(ctx, original.ID, replacement.ID); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.SubmitBatch(ctx, domain.Batch{
+		ID:          uuid.New().String(),
+		TenantID:    original.TenantID,
+		SubmittedBy: fix.RequestedBy,
+		APIKeyID:    fix.APIKeyID,
+		Payouts:     []domain.Payout{replacement},
+	})
+	if err != nil {
+		if rerr := s.repo.ReleaseReissue(ctx, original.ID, replacement.ID); rerr != nil {
+			slog.Error("Failed to release re-issue claim", "id", original.ID, "err", rerr)
+		}
+		return nil, err
+	}
+	slog.Info("🔁 Reversed payout re-issued", "id", original.ID, "reissue_id", replacement.ID, "batch_id", batch.ID, "by", fix.RequestedBy)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: original.TenantID, Actor: fix.RequestedBy, Action: domain.AuditPayoutReissued, TargetType: "payout", TargetID: original.ID,
+		After: map[string]string{"reissued_as": replacement.ID, "batch_id": batch.ID},
+	})
+
+	if batch.ApprovalStatus != domain.ApprovalPending {
+		go func() {
+			_ = s.ExecuteBatch(context.Background(), batch.TenantID, batch.ID, batch.Payouts)
+		}()
+	}
+	return batch, nil
+}
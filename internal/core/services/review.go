@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// ReviewService lets a human approve or reject payouts held by a pre-payment check.
+type ReviewService struct {
+	reviews ports.ReviewRepository
+	payouts *PayoutService
+	audit   *AuditService
+	logger  *slog.Logger
+}
+
+func NewReviewService(reviews ports.ReviewRepository, payouts *PayoutService, audit *AuditService, logger *slog.Logger) *ReviewService {
+	return &ReviewService{
+		reviews: reviews,
+		payouts: payouts,
+		audit:   audit,
+		logger:  logger,
+	}
+}
+
+// ListReviews returns the tenant's reviews in the given state, oldest first.
+func (s *ReviewService) ListReviews(ctx context.Context, tenantID, status string, limit int) ([]domain.Review, error) {
+	if status == "" {
+		status = domain.ReviewPending
+	}
+	switch status {
+	case domain.ReviewPending, domain.ReviewApproved, domain.ReviewRejected:
+	default:
+		var errs domain.ValidationErrors
+		errs.Add("status", "must be one of %s, %s, %s", domain.ReviewPending, domain.ReviewApproved, domain.ReviewRejected)
+		return nil, errs
+	}
+	return s.reviews.ListReviews(ctx, tenantID, status, limit)
+}
+
+// Approve clears the hold and sends the payout back into the execution
+// pipeline in the background.
+func (s *ReviewService) Approve(ctx context.Context, tenantID, id, reviewer, reason string) (*domain.Review, error) {
+	rv, err := s.decide(ctx, tenantID, id, domain.ReviewApproved, reviewer, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := s.payouts.Resume(context.Background(), tenantID, rv.PayoutID); err != nil {
+			s.logger.Error("Failed to resume approved payout", "review_id", rv.ID, "payout_id", rv.PayoutID, "err", err)
+		}
+	}()
+	return rv, nil
+}
+
+// Reject ends the held payout as REJECTED.
+func (s *ReviewService) Reject(ctx context.Context, tenantID, id, reviewer, reason string) (*domain.Review, error) {
+	rv, err := s.decide(ctx, tenantID, id, domain.ReviewRejected, reviewer, reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.payouts.Reject(ctx, tenantID, rv.PayoutID, rv.Reviewer, rv.DecisionReason); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (s *ReviewService) decide(ctx context.Context, tenantID, id, status, reviewer, reason string) (*domain.Review, error) {
+	reviewer, reason = strings.TrimSpace(reviewer), strings.TrimSpace(reason)
+	var errs domain.ValidationErrors
+	if reviewer == "" {
+		errs.Add("reviewer", "is required")
+	}
+	if reason == "" {
+		errs.Add("reason", "is required")
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	old, err := s.reviews.GetReview(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reviews.DecideReview(ctx, id, status, reviewer, reason); err != nil {
+		return nil, err
+	}
+	s.logger.Info("📝 Review decided", "review_id", id, "status", status, "reviewer", reviewer)
+	action := domain.AuditReviewApproved
+	if status == domain.ReviewRejected {
+		action = domain.AuditReviewRejected
+	}
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Actor: reviewer, Action: action, TargetType: "review", TargetID: id,
+		Before: map[string]string{"status": old.Status, "payout_id": old.PayoutID, "hold_reason": old.Reason},
+		After:  map[string]string{"status": status, "reason": reason},
+	})
+	return s.reviews.GetReview(ctx, tenantID, id)
+}
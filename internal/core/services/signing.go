@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+	"waya/pkg/wayasign"
+)
+
+// noncePruneInterval is how often expired nonces are deleted
+const noncePruneInterval = time.Minute
+
+// SignatureService authenticates requests signed with pkg/wayasign. A
+// signature is only accepted within maxSkew of the server's clock, and each
+// nonce only once per key, so a captured request cannot be replayed.
+type SignatureService struct {
+	keys    ports.APIKeyStore
+	nonces  ports.NonceStore
+	apiKeys *APIKeyService
+	maxSkew time.Duration
+	logger  *slog.Logger
+	now     func() time.Time
+
+	mu        sync.Mutex
+	lastPrune time.Time
+}
+
+func NewSignatureService(keys ports.APIKeyStore, nonces ports.NonceStore, apiKeys *APIKeyService, maxSkew time.Duration, logger *slog.Logger) *SignatureService {
+	return &SignatureService{
+		keys:    keys,
+		nonces:  nonces,
+		apiKeys: apiKeys,
+		maxSkew: maxSkew,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Authenticate checks a signed request and resolves its key to a principal.
+// It fails with domain.ValidationErrors for missing headers,
+// domain.ErrSignatureExpired, domain.ErrAPIKeyNotFound,
+// domain.ErrSignatureInvalid, domain.ErrNonceReused, or the key's own
+// revoked or expired error.
+func (s *SignatureService) Authenticate(ctx context.Context, req domain.SignedRequest) (*domain.Principal, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if skew := now.Sub(req.Timestamp); skew > s.maxSkew || skew < -s.maxSkew {
+		return nil, domain.ErrSignatureExpired
+	}
+
+	k, signingKey, err := s.keys.FindAPIKeyByPrefix(ctx, req.KeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	if signingKey == nil {
+		s.logger.Warn("🔏 Signed request with a key that has no signing secret; rotate it with PII_MASTER_KEY set", "prefix", req.KeyPrefix)
+		return nil, domain.ErrSignatureInvalid
+	}
+	want := wayasign.Signature(signingKey, wayasign.StringToSign(req.Method, req.RequestURI, req.Timestamp.Unix(), req.Nonce, req.BodyHash))
+	if !hmac.Equal([]byte(want), []byte(req.Signature)) {
+		s.logger.Warn("🔏 Request signature mismatch", "prefix", req.KeyPrefix, "method", req.Method, "uri", req.RequestURI)
+		return nil, domain.ErrSignatureInvalid
+	}
+
+	// Only a valid signature spends the nonce, so forgeries cannot burn them
+	s.prune(ctx, now)
+	if err := s.nonces.UseNonce(ctx, k.ID, req.Nonce, req.Timestamp.Add(s.maxSkew)); err != nil {
+		if errors.Is(err, domain.ErrNonceReused) {
+			s.logger.Warn("🔏 Replayed request refused", "prefix", req.KeyPrefix, "nonce", req.Nonce)
+		}
+		return nil, err
+	}
+	return s.apiKeys.principal(ctx, k)
+}
+
+// prune deletes nonces whose requests would now be refused as expired.
+func (s *SignatureService) prune(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	due := now.Sub(s.lastPrune) >= noncePruneInterval
+	if due {
+		s.lastPrune = now
+	}
+	s.mu.Unlock()
+	if !due {
+		return
+	}
+	if _, err := s.nonces.DeleteExpiredNonces(ctx, now); err != nil {
+		s.logger.Error("Failed to prune request nonces", "err", err)
+	}
+}
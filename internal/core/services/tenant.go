@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// TenantService manages the business units sharing the deployment.
+type TenantService struct {
+	store  ports.TenantStore
+	audit  *AuditService
+	logger *slog.Logger
+}
+
+func NewTenantService(store ports.TenantStore, audit *AuditService, logger *slog.Logger) *TenantService {
+	return &TenantService{
+		store:  store,
+		audit:  audit,
+		logger: logger,
+	}
+}
+
+// Create adds a tenant. It has no API key until one is issued for it.
+func (s *TenantService) Create(ctx context.Context, id, name, webhookURL string) (*domain.Tenant, error) {
+	t := domain.Tenant{
+		ID:         strings.TrimSpace(id),
+		Name:       strings.TrimSpace(name),
+		WebhookURL: strings.TrimSpace(webhookURL),
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateTenant(ctx, t); err != nil {
+		return nil, err
+	}
+	s.logger.Info("🏢 Tenant created", "tenant_id", t.ID, "name", t.Name)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: t.ID, Action: domain.AuditTenantCreated, TargetType: "tenant", TargetID: t.ID,
+		After: map[string]string{"name": t.Name, "webhook_url": t.WebhookURL},
+	})
+	return s.store.GetTenant(ctx, t.ID)
+}
+
+func (s *TenantService) Get(ctx context.Context, id string) (*domain.Tenant, error) {
+	return s.store.GetTenant(ctx, id)
+}
+
+func (s *TenantService) List(ctx context.Context) ([]domain.Tenant, error) {
+	return s.store.ListTenants(ctx)
+}
+
+// SetWebhook changes where the tenant's events are sent. An empty URL stops
+// them.
+func (s *TenantService) SetWebhook(ctx context.Context, id, url string) error {
+	old, err := s.store.GetTenant(ctx, id)
+	if err != nil {
+		return err
+	}
+	url = strings.TrimSpace(url)
+	if err := s.store.SetTenantWebhookURL(ctx, id, url); err != nil {
+		return err
+	}
+	s.logger.Info("🏢 Tenant webhook changed", "tenant_id", id)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: id, Action: domain.AuditTenantWebhookChanged, TargetType: "tenant", TargetID: id,
+		Before: map[string]string{"webhook_url": old.WebhookURL},
+		After:  map[string]string{"webhook_url": url},
+	})
+	return nil
+}
@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"waya/internal/core/domain"
+	"waya/internal/core/ports"
+)
+
+// passwordCost is the bcrypt work factor of stored passwords
+const passwordCost = 12
+
+// UserService signs dashboard users in and out, manages their accounts and
+// resolves their access tokens to principals. Access tokens are short-lived
+// and checked against the stored user on every request, so disabling a
+// user or changing their role applies at once.
+type UserService struct {
+	users      ports.UserStore
+	tenants    ports.TenantStore
+	tokens     ports.AccessTokens
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	audit      *AuditService
+	logger     *slog.Logger
+	now        func() time.Time
+
+	dummyOnce sync.Once
+	dummyHash []byte
+}
+
+func NewUserService(users ports.UserStore, tenants ports.TenantStore, tokens ports.AccessTokens, accessTTL, refreshTTL time.Duration, audit *AuditService, logger *slog.Logger) *UserService {
+	return &UserService{
+		users:      users,
+		tenants:    tenants,
+		tokens:     tokens,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		audit:      audit,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Create adds a user to the tenant with the given password.
+func (s *UserService) Create(ctx context.Context, tenantID, email, name, role, password, createdBy string) (*domain.User, error) {
+	u := domain.User{
+		ID:        uuid.New().String(),
+		TenantID:  tenantID,
+		Email:     domain.NormalizeEmail(email),
+		Name:      strings.TrimSpace(name),
+		Role:      role,
+		CreatedBy: createdBy,
+	}
+	var errs domain.ValidationErrors
+	if err := u.Validate(); err != nil {
+		errs = err.(domain.ValidationErrors)
+	}
+	if err := domain.ValidatePassword(password); err != nil {
+		errs.Add("password", "%v", err)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	if _, err := s.tenants.GetTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+	if err := s.users.CreateUser(ctx, u, string(hash)); err != nil {
+		return nil, err
+	}
+	s.logger.Info("👤 User created", "tenant_id", tenantID, "user_id", u.ID, "email", u.Email, "role", u.Role, "by", createdBy)
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Action: domain.AuditUserCreated, TargetType: "user", TargetID: u.ID,
+		After: map[string]string{"email": u.Email, "name": u.Name, "role": u.Role},
+	})
+	return s.users.GetUser(ctx, tenantID, u.ID)
+}
+
+// Login checks an email and password and opens a session. Unknown emails
+// and wrong passwords both fail with domain.ErrInvalidCredentials, taking
+// the same time; disabled users fail with domain.ErrUserDisabled.
+func (s *UserService) Login(ctx context.Context, email, password string) (*domain.Session, error) {
+	u, hash, err := s.users.FindUserByEmail(ctx, domain.NormalizeEmail(email))
+	if errors.Is(err, domain.ErrUserNotFound) {
+		// Compare anyway so response times do not reveal which emails exist
+		bcrypt.CompareHashAndPassword(s.dummy(), []byte(password))
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		s.logger.Warn("👤 Failed login", "user_id", u.ID, "tenant_id", u.TenantID)
+		return nil, domain.ErrInvalidCredentials
+	}
+	if !u.DisabledAt.IsZero() {
+		return nil, domain.ErrUserDisabled
+	}
+
+	now := s.now().UTC()
+	if err := s.users.TouchUserLogin(ctx, u.ID, now); err != nil {
+		s.logger.Error("Failed to record login", "user_id", u.ID, "err", err)
+	}
+	u.LastLoginAt = now
+	refresh, refreshToken, err := s.newRefreshToken(u.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.users.CreateRefreshToken(ctx, refresh, domain.HashRefreshToken(refreshToken)); err != nil {
+		return nil, err
+	}
+	s.logger.Info("👤 User signed in", "tenant_id", u.TenantID, "user_id", u.ID)
+	return s.session(*u, refresh, refreshToken, now)
+}
+
+func (s *UserService) dummy() []byte {
+	s.dummyOnce.Do(func() {
+		s.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("waya-no-such-user"), passwordCost)
+	})
+	return s.dummyHash
+}
+
+// Refresh exchanges a refresh token for a new session and a new refresh
+// token. Presenting a token that was already exchanged means it leaked, so
+// every session of the user is ended and it fails with
+// domain.ErrRefreshReused.
+func (s *UserService) Refresh(ctx context.Context, refreshToken string) (*domain.Session, error) {
+	if refreshToken == "" {
+		return nil, domain.ErrRefreshInvalid
+	}
+	old, err := s.users.FindRefreshToken(ctx, domain.HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	if !old.RevokedAt.IsZero() {
+		if old.ReplacedBy == "" {
+			return nil, domain.ErrRefreshInvalid
+		}
+		return nil, s.reused(ctx, old.UserID)
+	}
+	if !now.Before(old.ExpiresAt) {
+		return nil, domain.ErrRefreshInvalid
+	}
+	u, err := s.activeUser(ctx, old.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	next, nextToken, err := s.newRefreshToken(u.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.users.RotateRefreshToken(ctx, old.ID, next, domain.HashRefreshToken(nextToken)); err != nil {
+		if errors.Is(err, domain.ErrRefreshReused) {
+			return nil, s.reused(ctx, u.ID)
+		}
+		return nil, err
+	}
+	return s.session(*u, next, nextToken, now)
+}
+
+func (s *UserService) reused(ctx context.Context, userID string) error {
+	s.logger.Warn("👤 Refresh token reused; signing the user out everywhere", "user_id", userID)
+	if err := s.users.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+	return domain.ErrRefreshReused
+}
+
+// activeUser loads a token's user, who must still exist and be enabled.
+func (s *UserService) activeUser(ctx context.Context, userID string) (*domain.User, error) {
+	u, err := s.users.FindUser(ctx, userID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrRefreshInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !u.DisabledAt.IsZero() {
+		return nil, domain.ErrUserDisabled
+	}
+	return u, nil
+}
+
+// Logout ends the session of a refresh token. Unknown tokens are ignored.
+func (s *UserService) Logout(ctx context.Context, refreshToken string) error {
+	t, err := s.users.FindRefreshToken(ctx, domain.HashRefreshToken(refreshToken))
+	if errors.Is(err, domain.ErrRefreshInvalid) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.users.RevokeRefreshToken(ctx, t.ID)
+}
+
+// Authenticate resolves an access token to the principal of its user. The
+// user's current role applies, not the one in the token.
+func (s *UserService) Authenticate(ctx context.Context, accessToken string) (*domain.Principal, error) {
+	claims, err := s.tokens.Parse(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.users.GetUser(ctx, claims.TenantID, claims.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrAccessTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !u.DisabledAt.IsZero() {
+		return nil, domain.ErrUserDisabled
+	}
+	t, err := s.tenants.GetTenant(ctx, u.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Principal{Tenant: *t, User: u}, nil
+}
+
+func (s *UserService) newRefreshToken(userID string, now time.Time) (domain.RefreshToken, string, error) {
+	token, err := domain.NewRefreshToken()
+	if err != nil {
+		return domain.RefreshToken{}, "", fmt.Errorf("generate refresh token: %w", err)
+	}
+	return domain.RefreshToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.refreshTTL),
+	}, token, nil
+}
+
+func (s *UserService) session(u domain.User, refresh domain.RefreshToken, refreshToken string, now time.Time) (*domain.Session, error) {
+	expiresAt := now.Add(s.accessTTL)
+	access, err := s.tokens.Issue(domain.AccessClaims{
+		UserID:    u.ID,
+		TenantID:  u.TenantID,
+		Role:      u.Role,
+		IssuedAt:  now,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("issue access token: %w", err)
+	}
+	return &domain.Session{
+		User:             u,
+		AccessToken:      access,
+		AccessExpiresAt:  expiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
+
+func (s *UserService) Get(ctx context.Context, tenantID, id string) (*domain.User, error) {
+	return s.users.GetUser(ctx, tenantID, id)
+}
+
+func (s *UserService) List(ctx context.Context, tenantID string) ([]domain.User, error) {
+	return s.users.ListUsers(ctx, tenantID)
+}
+
+// SetRole changes a user's role; it applies to their next request. Users
+// cannot change their own.
+func (s *UserService) SetRole(ctx context.Context, tenantID, id, role string, by *domain.Principal) (*domain.User, error) {
+	if err := domain.ValidateRole(role); err != nil {
+		var errs domain.ValidationErrors
+		errs.Add("role", "%v", err)
+		return nil, errs
+	}
+	if self(by, id) {
+		return nil, domain.ErrSelfChange
+	}
+	old, err := s.users.GetUser(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.users.SetUserRole(ctx, tenantID, id, role); err != nil {
+		return nil, err
+	}
+	s.logger.Info("👤 User role changed", "tenant_id", tenantID, "user_id", id, "role", role, "by", by.Actor())
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Action: domain.AuditUserRoleChanged, TargetType: "user", TargetID: id,
+		Before: map[string]string{"role": old.Role},
+		After:  map[string]string{"role": role},
+	})
+	return s.users.GetUser(ctx, tenantID, id)
+}
+
+// Disable stops the user signing in and ends their sessions. Users cannot
+// disable themselves.
+func (s *UserService) Disable(ctx context.Context, tenantID, id string, by *domain.Principal) (*domain.User, error) {
+	if self(by, id) {
+		return nil, domain.ErrSelfChange
+	}
+	if _, err := s.users.GetUser(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	if err := s.users.DisableUser(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	if err := s.users.RevokeUserRefreshTokens(ctx, id); err != nil {
+		return nil, err
+	}
+	s.logger.Warn("🔒 User disabled", "tenant_id", tenantID, "user_id", id, "by", by.Actor())
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Action: domain.AuditUserDisabled, TargetType: "user", TargetID: id,
+		Before: map[string]bool{"disabled": false},
+		After:  map[string]bool{"disabled": true},
+	})
+	return s.users.GetUser(ctx, tenantID, id)
+}
+
+// SetPassword resets a user's password and ends their sessions.
+func (s *UserService) SetPassword(ctx context.Context, tenantID, id, password string, by *domain.Principal) error {
+	if err := domain.ValidatePassword(password); err != nil {
+		var errs domain.ValidationErrors
+		errs.Add("password", "%v", err)
+		return errs
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordCost)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+	if err := s.users.SetUserPassword(ctx, tenantID, id, string(hash)); err != nil {
+		return err
+	}
+	if err := s.users.RevokeUserRefreshTokens(ctx, id); err != nil {
+		return err
+	}
+	s.logger.Warn("🔒 User password reset", "tenant_id", tenantID, "user_id", id, "by", by.Actor())
+	s.audit.Record(ctx, domain.AuditEvent{
+		TenantID: tenantID, Action: domain.AuditUserPasswordReset, TargetType: "user", TargetID: id,
+	})
+	return nil
+}
+
+// ChangePassword changes a signed-in user's own password, which they must
+// confirm. Like a reset it revokes all of the user's refresh tokens, the
+// caller's included, so every session ends when its access token expires.
+func (s *UserService) ChangePassword(ctx context.Context, u *domain.User, current, password string) error {
+	hash, err := s.users.UserPasswordHash(ctx, u.TenantID, u.ID)
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(current)) != nil {
+		return domain.ErrInvalidCredentials
+	}
+	return s.SetPassword(ctx, u.TenantID, u.ID, password, &domain.Principal{User: u})
+}
+
+func self(p *domain.Principal, userID string) bool {
+	return p != nil && p.User != nil && p.User.ID == userID
+}
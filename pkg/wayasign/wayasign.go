@@ -0,0 +1,148 @@
+// Package wayasign signs requests to the Waya API so the API key itself is
+// never sent. Each request carries the key's prefix, a timestamp, a random
+// nonce and an HMAC-SHA256 over the method, path and query, timestamp,
+// nonce and the SHA-256 of the body:
+//
+//	client := &http.Client{Transport: &wayasign.Transport{APIKey: os.Getenv("WAYA_KEY")}}
+//	resp, err := client.Post("https://waya.example/api/v1/payouts", "application/json", body)
+//
+// Waya refuses signatures older or newer than its clock skew allowance
+// (5 minutes by default) and nonces it has already seen for the key.
+package wayasign
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Algorithm opens every string to sign, so the scheme can be versioned.
+const Algorithm = "WAYA-HMAC-SHA256"
+
+// Headers of a signed request
+const (
+	HeaderKey       = "X-Waya-Key"       // Key prefix, e.g. wk_3f9a01bc
+	HeaderTimestamp = "X-Waya-Timestamp" // Unix seconds
+	HeaderNonce     = "X-Waya-Nonce"     // Unique per request, 16 to 128 characters
+	HeaderSignature = "X-Waya-Signature" // Hex HMAC-SHA256 of StringToSign
+)
+
+// KeyPrefix returns the public part of an API key, "wk_" and eight hex
+// digits, which names the key in HeaderKey.
+func KeyPrefix(apiKey string) (string, error) {
+	parts := strings.SplitN(apiKey, "_", 3)
+	if len(parts) != 3 || parts[0] != "wk" || parts[1] == "" || parts[2] == "" {
+		return "", errors.New("wayasign: not a Waya API key")
+	}
+	return parts[0] + "_" + parts[1], nil
+}
+
+// signingKeyInfo labels the HKDF derivation, so the signing key shares
+// nothing with the SHA-256 Waya looks keys up by.
+const signingKeyInfo = "waya request signing v1"
+
+// SigningKey is the HMAC key of an API key, derived from it with
+// HKDF-SHA256. Waya keeps its copy encrypted; the key's lookup hash cannot
+// produce it.
+func SigningKey(apiKey string) []byte {
+	// Only an over-long output can fail, and 32 bytes is well within
+	key, _ := hkdf.Key(sha256.New, []byte(apiKey), nil, signingKeyInfo, sha256.Size)
+	return key
+}
+
+// HashBody is the hex SHA-256 of a request body; an empty body hashes too.
+func HashBody(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// StringToSign is the canonical form of a request. requestURI is the path
+// with its query string as sent, e.g. "/api/v1/keys?tenant_id=acme".
+func StringToSign(method, requestURI string, timestamp int64, nonce, bodyHash string) string {
+	return strings.Join([]string{
+		Algorithm,
+		strings.ToUpper(method),
+		requestURI,
+		strconv.FormatInt(timestamp, 10),
+		nonce,
+		bodyHash,
+	}, "\n")
+}
+
+// Signature is the hex HMAC-SHA256 of stringToSign.
+func Signature(signingKey []byte, stringToSign string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign adds the signing headers to req, reading its body and putting it
+// back. It removes any x-api-key header so the key is not sent as well.
+func Sign(req *http.Request, apiKey string, now time.Time) error {
+	prefix, err := KeyPrefix(apiKey)
+	if err != nil {
+		return err
+	}
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return err
+	}
+
+	ts := now.Unix()
+	sts := StringToSign(req.Method, req.URL.RequestURI(), ts, nonce, HashBody(body))
+	req.Header.Del("x-api-key")
+	req.Header.Set(HeaderKey, prefix)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderNonce, nonce)
+	req.Header.Set(HeaderSignature, Signature(SigningKey(apiKey), sts))
+	return nil
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Transport signs every request with APIKey before handing it to Base, or
+// to http.DefaultTransport when Base is nil.
+type Transport struct {
+	APIKey string
+	Base   http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request
+	signed := req.Clone(req.Context())
+	if err := Sign(signed, t.APIKey, time.Now()); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(signed)
+}